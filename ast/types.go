@@ -43,13 +43,17 @@ func (s *String) Get() string {
 }
 
 type Model struct {
-	Pos        scanner.Position
-	Name       *String
-	Table      *String
-	Fields     []*Field
-	PrimaryKey *RelativeFieldRefs
-	Unique     []*RelativeFieldRefs
-	Indexes    []*Index
+	Pos         scanner.Position
+	Doc         string
+	Name        *String
+	Table       *String
+	Fields      []*Field
+	PrimaryKey  *RelativeFieldRefs
+	Unique      []*RelativeFieldRefs
+	Indexes     []*Index
+	Checks      []*Check
+	ForeignKeys []*ForeignKey
+	NoLint      *NoLint
 }
 
 type Bool struct {
@@ -85,24 +89,45 @@ type Suffix struct {
 	Parts []*String
 }
 
+// NoLint lists the lint rules to suppress for a model or operation.
+type NoLint struct {
+	Pos   scanner.Position
+	Rules []*String
+}
+
 type Field struct {
 	Pos  scanner.Position
+	Doc  string
 	Name *String
 
 	// Common to both regular and relation fields
-	Column    *String
-	Nullable  *Bool
-	Updatable *Bool
+	Column      *String
+	Nullable    *Bool
+	Updatable   *Bool
+	ProtoNumber *Int
 
 	// Only make sense on a regular field
 	Type       *FieldType
 	AutoInsert *Bool
 	AutoUpdate *Bool
 	Length     *Int
+	Check      *Check
 
 	// Only make sense on a relation
 	Relation     *FieldRef
 	RelationKind *RelationKind
+	OnUpdate     *RelationKind
+}
+
+// ForeignKey references a unique set of fields on another model from a set
+// of fields on the model it is declared in.
+type ForeignKey struct {
+	Pos        scanner.Position
+	Fields     *RelativeFieldRefs
+	Model      *String
+	References *RelativeFieldRefs
+	OnDelete   *RelationKind
+	OnUpdate   *RelationKind
 }
 
 type RelationKind struct {
@@ -110,6 +135,13 @@ type RelationKind struct {
 	Value consts.RelationKind
 }
 
+func (r *RelationKind) Get() consts.RelationKind {
+	if r == nil {
+		return consts.Restrict
+	}
+	return r.Value
+}
+
 type FieldType struct {
 	Pos   scanner.Position
 	Value consts.FieldType
@@ -169,42 +201,60 @@ func (m *ModelRef) String() string {
 }
 
 type Index struct {
-	Pos    scanner.Position
-	Name   *String
-	Fields *RelativeFieldRefs
-	Unique *Bool
+	Pos     scanner.Position
+	Name    *String
+	Columns []*IndexColumn
+	Unique  *Bool
+	Where   []*Where
+	Using   *String
+}
+
+// IndexColumn is either a field of the model or an expression over them.
+type IndexColumn struct {
+	Pos        scanner.Position
+	Field      *RelativeFieldRef
+	Expr       *Expr
+	Descending *Bool
 }
 
 type Read struct {
 	Pos     scanner.Position
+	Doc     string
 	Select  *FieldRefs
 	Joins   []*Join
 	Where   []*Where
 	OrderBy *OrderBy
 	GroupBy *GroupBy
+	Lock    *Lock
 	View    *View
 	Suffix  *Suffix
+	NoLint  *NoLint
 }
 
 type Delete struct {
 	Pos    scanner.Position
+	Doc    string
 	Model  *ModelRef
 	Joins  []*Join
 	Where  []*Where
 	Suffix *Suffix
+	NoLint *NoLint
 }
 
 type Update struct {
 	Pos      scanner.Position
+	Doc      string
 	Model    *ModelRef
 	Joins    []*Join
 	Where    []*Where
 	NoReturn *Bool
 	Suffix   *Suffix
+	NoLint   *NoLint
 }
 
 type Create struct {
 	Pos      scanner.Position
+	Doc      string
 	Model    *ModelRef
 	Raw      *Bool
 	NoReturn *Bool
@@ -221,6 +271,8 @@ type View struct {
 	Scalar      *Bool
 	One         *Bool
 	First       *Bool
+	Iterate     *Bool
+	Dynamic     *Bool
 }
 
 type FieldRefs struct {
@@ -247,14 +299,27 @@ func (j *JoinType) Get() consts.JoinType {
 	return j.Value
 }
 
+// Check is a list of where clauses that every row must satisfy. The clauses
+// of a field check leave off the left hand side, which is the field.
+type Check struct {
+	Pos    scanner.Position
+	Wheres []*Where
+}
+
 type Where struct {
 	Pos   scanner.Position
 	Left  *Expr
 	Op    *Operator
 	Right *Expr
+
+	// Upper is the upper bound of a between operator
+	Upper *Expr
 }
 
 func (w *Where) String() string {
+	if w.Upper != nil {
+		return fmt.Sprintf("%s %s %s and %s", w.Left, w.Op, w.Right, w.Upper)
+	}
 	return fmt.Sprintf("%s %s %s", w.Left, w.Op, w.Right)
 }
 
@@ -329,12 +394,34 @@ type Operator struct {
 func (o *Operator) String() string { return string(o.Value) }
 
 type OrderBy struct {
+	Pos     scanner.Position
+	Entries []*OrderByEntry
+}
+
+type OrderByEntry struct {
 	Pos        scanner.Position
-	Fields     *FieldRefs
+	Field      *FieldRef
 	Descending *Bool
+	Nulls      *NullsOrder
+}
+
+type NullsOrder struct {
+	Pos   scanner.Position
+	Value consts.NullsOrder
 }
 
 type GroupBy struct {
 	Pos    scanner.Position
 	Fields *FieldRefs
 }
+
+type Lock struct {
+	Pos        scanner.Position
+	Mode       *LockMode
+	SkipLocked *Bool
+}
+
+type LockMode struct {
+	Pos   scanner.Position
+	Value consts.LockMode
+}
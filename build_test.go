@@ -57,11 +57,13 @@ func testBuildFile(t *testutil.T, file string) {
 	type options struct {
 		rx       bool
 		userdata bool
+		prepared bool
 	}
 
 	runBuild := func(opts options) {
 		t.Logf("[%s] generating... %+v", file, opts)
-		err = golangCmd("", dialects, "", opts.rx, opts.userdata, file, dir)
+		err = golangCmd("", dialects, "", opts.rx, opts.userdata,
+			opts.prepared, "", file, dir)
 		if d.has("fail_gen") {
 			t.AssertError(err, d.get("fail_gen"))
 			return
@@ -94,4 +96,5 @@ func testBuildFile(t *testutil.T, file string) {
 	runBuild(options{rx: false, userdata: true})
 	runBuild(options{rx: true, userdata: false})
 	runBuild(options{rx: true, userdata: true})
+	runBuild(options{rx: true, userdata: true, prepared: true})
 }
@@ -25,6 +25,7 @@ import (
 type RawCreate struct {
 	Info              sqlembedgo.Info
 	Suffix            string
+	Table             string
 	Return            *Var
 	Arg               *Var
 	Fields            []*Var
@@ -36,6 +37,7 @@ func RawCreateFromIR(ir_cre *ir.Create, dialect sql.Dialect) *RawCreate {
 	ins := &RawCreate{
 		Info:              sqlembedgo.Embed("__", insert_sql),
 		Suffix:            convertSuffix(ir_cre.Suffix),
+		Table:             ir_cre.Model.Table,
 		SupportsReturning: dialect.Features().Returning,
 	}
 	if !ir_cre.NoReturn {
@@ -67,6 +69,7 @@ func RawCreateFromIR(ir_cre *ir.Create, dialect sql.Dialect) *RawCreate {
 type Create struct {
 	Info              sqlembedgo.Info
 	Suffix            string
+	Table             string
 	Return            *Var
 	Args              []*Var
 	Fields            []*Var
@@ -79,6 +82,7 @@ func CreateFromIR(ir_cre *ir.Create, dialect sql.Dialect) *Create {
 	ins := &Create{
 		Info:              sqlembedgo.Embed("__", insert_sql),
 		Suffix:            convertSuffix(ir_cre.Suffix),
+		Table:             ir_cre.Model.Table,
 		SupportsReturning: dialect.Features().Returning,
 	}
 	if !ir_cre.NoReturn {
@@ -24,6 +24,7 @@ type Delete struct {
 	PartitionedArgs
 	Info   sqlembedgo.Info
 	Suffix string
+	Table  string
 	Result *Var
 }
 
@@ -33,6 +34,7 @@ func DeleteFromIR(ir_del *ir.Delete, dialect sql.Dialect) *Delete {
 		PartitionedArgs: PartitionedArgsFromWheres(ir_del.Where),
 		Info:            sqlembedgo.Embed("__", delete_sql),
 		Suffix:          convertSuffix(ir_del.Suffix),
+		Table:           ir_del.Model.Table,
 	}
 
 	if ir_del.Distinct() {
@@ -0,0 +1,149 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"fmt"
+	"strings"
+
+	"bitbucket.org/pkg/inflect"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlembedgo"
+)
+
+// DynamicOptions describes the types generated once for a dynamic read: the
+// options struct with a field for every where argument and the sort enum.
+type DynamicOptions struct {
+	Name   string
+	Sort   string
+	Fields []*Var
+	Sorts  []string
+}
+
+func DynamicOptionsFromIR(ir_read *ir.Read) *DynamicOptions {
+	suffix := convertSuffix(ir_read.Suffix)
+	opts := &DynamicOptions{
+		Name: suffix + "_Options",
+		Sort: suffix + "_Sort",
+	}
+	for _, where := range ir_read.Where {
+		opts.Fields = append(opts.Fields, dynamicArgsFromWhere(where)...)
+	}
+	for _, entry := range ir_read.OrderBy.Entries {
+		name := dynamicSortName(opts.Sort, entry.Field,
+			len(ir_read.Joins) > 0)
+		opts.Sorts = append(opts.Sorts, name+"_Asc", name+"_Desc")
+	}
+	return opts
+}
+
+func dynamicArgsFromWhere(where *ir.Where) (args []*Var) {
+	for _, arg := range ArgsFromWhere(where) {
+		arg.Name = inflect.Camelize(arg.Name)
+		args = append(args, arg)
+	}
+	return args
+}
+
+func dynamicSortName(sort string, field *ir.Field, full bool) string {
+	if full {
+		return sort + "_" + structName(field.Model) + "_" + fieldName(field)
+	}
+	return sort + "_" + fieldName(field)
+}
+
+type Dynamic struct {
+	Info           sqlembedgo.Info
+	Suffix         string
+	Table          string
+	Row            *Var
+	Options        *DynamicOptions
+	Filters        []*DynamicFilter
+	Sorts          []*DynamicSort
+	DefaultOrderBy string
+	NoLimitToken   string
+}
+
+// DynamicFilter is a where of a dynamic read. It is applied when every
+// options field in Check is set, and always if it has no arguments.
+type DynamicFilter struct {
+	Info      sqlembedgo.Info
+	Check     string
+	Values    string
+	Condition string
+	IsNull    string
+}
+
+type DynamicSort struct {
+	Name string
+	SQL  string
+}
+
+func DynamicFromIR(ir_read *ir.Read, dialect sql.Dialect) *Dynamic {
+	dyn := &Dynamic{
+		Info:    sqlembedgo.Embed("__", sql.SelectSQL(ir_read, dialect)),
+		Suffix:  convertSuffix(ir_read.Suffix),
+		Table:   ir_read.From.Table,
+		Row:     GetRowFromIR(ir_read),
+		Options: DynamicOptionsFromIR(ir_read),
+		DefaultOrderBy: strings.Join(
+			sql.OrderByFromIROrderBy(ir_read.OrderBy, dialect).Terms, ", "),
+		NoLimitToken: dialect.Features().NoLimitToken,
+	}
+
+	where_sqls := sql.DynamicWhereSQL(ir_read.Where, dialect)
+	for i, where := range ir_read.Where {
+		prefix := fmt.Sprintf("__filter_%d_", i)
+		filter := &DynamicFilter{
+			Info: sqlembedgo.Embed(prefix, where_sqls[i]),
+		}
+
+		var checks, values []string
+		for _, arg := range dynamicArgsFromWhere(where) {
+			if arg.Plain {
+				checks = append(checks, fmt.Sprintf("opts.%s != nil",
+					arg.Name))
+				values = append(values, fmt.Sprintf("*opts.%s", arg.Name))
+			} else {
+				checks = append(checks, fmt.Sprintf("opts.%s._set",
+					arg.Name))
+				values = append(values, fmt.Sprintf("opts.%s.value()",
+					arg.Name))
+				filter.IsNull = fmt.Sprintf("opts.%s.isnull()", arg.Name)
+			}
+		}
+		filter.Check = strings.Join(checks, " && ")
+		filter.Values = strings.Join(values, ", ")
+		if where.NeedsCondition() {
+			filter.Condition = prefix + "cond"
+		}
+		dyn.Filters = append(dyn.Filters, filter)
+	}
+
+	for i, entry := range ir_read.OrderBy.Entries {
+		dyn.Sorts = append(dyn.Sorts,
+			&DynamicSort{
+				Name: dyn.Options.Sorts[2*i],
+				SQL:  sql.DynamicOrderBySQL(entry, false, dialect),
+			},
+			&DynamicSort{
+				Name: dyn.Options.Sorts[2*i+1],
+				SQL:  sql.DynamicOrderBySQL(entry, true, dialect),
+			})
+	}
+
+	return dyn
+}
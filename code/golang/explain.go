@@ -0,0 +1,145 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
+)
+
+// Explanation describes the method generated for an operation and the SQL it
+// runs.
+type Explanation struct {
+	// Op is the *ir.Create, *ir.Read, *ir.Update or *ir.Delete explained.
+	Op interface{}
+
+	// Name is the name of the generated method and Doc the doc comment of
+	// the operation.
+	Name      string
+	Signature string
+	Doc       string
+
+	// Kind is one of "create", "read", "update" or "delete". Reads and
+	// deletes also record if they affect at most one row.
+	Kind     string
+	Distinct bool
+
+	// Queries has the SQL for each combination of nullable conditions being
+	// null or not, or just the SQL if it has no conditions.
+	Queries []*ExplainedQuery
+}
+
+// ExplainedQuery is the SQL run when the conditions render as given.
+// Placeholders filled in at runtime, like the columns set by an update, are
+// rendered as <name>.
+type ExplainedQuery struct {
+	Conditions []string
+	SQL        string
+}
+
+// Explain returns an Explanation for every operation in root, in the order
+// their methods are generated.
+func (r *Renderer) Explain(root *ir.Root, dialect sql.Dialect) (
+	explanations []*Explanation, err error) {
+
+	add := func(op interface{}, doc, kind string, distinct bool,
+		sql sqlgen.SQL) error {
+
+		signature, err := r.Signature(op, dialect)
+		if err != nil {
+			return err
+		}
+		explanations = append(explanations, &Explanation{
+			Op:        op,
+			Name:      signature[:strings.Index(signature, "(")],
+			Signature: signature,
+			Doc:       doc,
+			Kind:      kind,
+			Distinct:  distinct,
+			Queries:   explainQueries(dialect, sql),
+		})
+		return nil
+	}
+
+	for _, cre := range root.Creates {
+		err := add(cre, cre.Doc, "create", true, sql.InsertSQL(cre, dialect))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, read := range root.Reads {
+		if err := checkLock(read, dialect); err != nil {
+			return nil, err
+		}
+		err := add(read, read.Doc, "read", read.Distinct(),
+			sql.SelectSQL(read, dialect))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, upd := range root.Updates {
+		err := add(upd, upd.Doc, "update", true, sql.UpdateSQL(upd, dialect))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, del := range root.Deletes {
+		err := add(del, del.Doc, "delete", del.Distinct(),
+			sql.DeleteSQL(del, dialect))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return explanations, nil
+}
+
+func explainQueries(dialect sql.Dialect, stmt sqlgen.SQL) (
+	queries []*ExplainedQuery) {
+
+	var conds []*sqlgen.Condition
+	explainWalk(stmt, &conds)
+
+	for mask := 0; mask < 1<<uint(len(conds)); mask++ {
+		query := new(ExplainedQuery)
+		for i, cond := range conds {
+			cond.Null = mask&(1<<uint(i)) != 0
+			query.Conditions = append(query.Conditions, cond.Render())
+		}
+		query.SQL = sqlgen.Render(dialect, stmt)
+		queries = append(queries, query)
+	}
+
+	return queries
+}
+
+// explainWalk collects the conditions in sql and fills in its holes.
+func explainWalk(sql sqlgen.SQL, conds *[]*sqlgen.Condition) {
+	switch sql := sql.(type) {
+	case sqlgen.Literals:
+		for _, child := range sql.SQLs {
+			explainWalk(child, conds)
+		}
+	case *sqlgen.Condition:
+		*conds = append(*conds, sql)
+	case *sqlgen.Hole:
+		if sql.SQL == nil {
+			sql.SQL = sqlgen.Literal("<" + sql.Name + ">")
+		}
+	}
+}
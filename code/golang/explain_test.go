@@ -0,0 +1,73 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"testing"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+	"gopkg.in/spacemonkeygo/dbx.v1/templates"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/tmplutil"
+)
+
+func TestExplain(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	ast_root, err := syntax.Parse("explain.dbx", []byte(`
+model user (
+	key pk
+	field pk   serial64
+	field nick text ( nullable, updatable )
+)
+
+read all (
+	select user
+	where user.nick = ?
+)
+
+update user ( where user.pk = ? )
+`))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+
+	renderer, err := New(tmplutil.BinLoader(templates.Asset), &Options{})
+	tw.AssertNoError(err)
+	explanations, err := renderer.Explain(root, sql.SQLite3())
+	tw.AssertNoError(err)
+
+	if len(explanations) != 2 {
+		tw.Fatalf("expected 2 explanations, got %d", len(explanations))
+	}
+
+	read := explanations[0]
+	if read.Name != "All_User_By_Nick" || read.Kind != "read" ||
+		read.Distinct || len(read.Queries) != 2 {
+		tw.Fatalf("unexpected read explanation: %+v", read)
+	}
+	tw.AssertContains(read.Queries[0].SQL, "WHERE users.nick = ?")
+	tw.AssertContains(read.Queries[1].SQL, "WHERE users.nick is null")
+	tw.AssertContains(read.Queries[1].Conditions[0], "users.nick is null")
+
+	update := explanations[1]
+	if update.Kind != "update" || len(update.Queries) != 1 {
+		tw.Fatalf("unexpected update explanation: %+v", update)
+	}
+	tw.AssertContains(update.Queries[0].SQL, "UPDATE users SET <sets>")
+}
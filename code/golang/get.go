@@ -26,6 +26,7 @@ type Get struct {
 	PartitionedArgs
 	Info   sqlembedgo.Info
 	Suffix string
+	Table  string
 	Row    *Var
 	LastPk *Var
 }
@@ -36,6 +37,7 @@ func GetFromIR(ir_read *ir.Read, dialect sql.Dialect) *Get {
 		PartitionedArgs: PartitionedArgsFromWheres(ir_read.Where),
 		Info:            sqlembedgo.Embed("__", select_sql),
 		Suffix:          convertSuffix(ir_read.Suffix),
+		Table:           ir_read.From.Table,
 	}
 
 	get.Row = GetRowFromIR(ir_read)
@@ -25,7 +25,8 @@ import (
 )
 
 func cleanSignature(in string) (out string) {
-	return reCollapseSpace.ReplaceAllString(strings.TrimSpace(in), " ")
+	out = reCollapseSpace.ReplaceAllString(strings.TrimSpace(in), " ")
+	return strings.Replace(out, "( ", "(", -1)
 }
 
 func sliceofFn(intf interface{}) (string, error) {
@@ -101,6 +102,10 @@ func flattenFn(intf interface{}) (flattened []*Var, err error) {
 func fieldvalueFn(vars []*Var) string {
 	var values []string
 	for _, v := range vars {
+		if v.Plain {
+			values = append(values, v.Name)
+			continue
+		}
 		values = append(values, fmt.Sprintf("%s.value()", v.Name))
 	}
 	return strings.Join(values, ", ")
@@ -150,6 +155,19 @@ func forVars(intf interface{}, fn func(v *Var) string) ([]string, error) {
 	return elems, nil
 }
 
+// docComment returns doc as Go // comment lines ending in a newline, or the
+// empty string if there is no doc.
+func docComment(doc string) string {
+	if doc == "" {
+		return ""
+	}
+	var out []string
+	for _, line := range strings.Split(doc, "\n") {
+		out = append(out, strings.TrimRight("// "+line, " "))
+	}
+	return strings.Join(out, "\n") + "\n"
+}
+
 func structName(m *ir.Model) string {
 	return inflect.Camelize(m.Name)
 }
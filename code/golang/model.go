@@ -24,6 +24,7 @@ import (
 // Struct is used for generating go structures
 type ModelStruct struct {
 	Name   string
+	Doc    string
 	Table  string
 	Fields []*ModelField
 }
@@ -33,6 +34,7 @@ func ModelStructFromIR(model *ir.Model) *ModelStruct {
 
 	return &ModelStruct{
 		Name:   name,
+		Doc:    docComment(model.Doc),
 		Table:  model.Table,
 		Fields: ModelFieldsFromIR(model.Fields),
 	}
@@ -73,6 +75,7 @@ func (s *ModelStruct) CreateStructName() string {
 
 type ModelField struct {
 	Name       string
+	Doc        string
 	ModelName  string
 	Type       string
 	CtorValue  string
@@ -89,6 +92,7 @@ type ModelField struct {
 func ModelFieldFromIR(field *ir.Field) *ModelField {
 	return &ModelField{
 		Name:       fieldName(field),
+		Doc:        docComment(field.Doc),
 		ModelName:  structName(field.Model),
 		Type:       valueType(field.Type, field.Nullable),
 		CtorValue:  valueType(field.Type, false),
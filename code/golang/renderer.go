@@ -26,7 +26,10 @@ import (
 	"unicode/utf8"
 
 	"gopkg.in/spacemonkeygo/dbx.v1/code"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/introspect"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/migrations"
 	"gopkg.in/spacemonkeygo/dbx.v1/sql"
 	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
 	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlbundle"
@@ -41,12 +44,17 @@ var (
 type publicMethod struct {
 	Signature string
 	Invoke    string
+	Doc       string
 }
 
 type Options struct {
 	Package         string
 	SupportRx       bool
 	SupportUserdata bool
+	SupportPrepared bool
+
+	// Migrations are the ordered steps applied by the generated Migrate.
+	Migrations []*migrations.Step
 }
 
 type Renderer struct {
@@ -67,6 +75,8 @@ type Renderer struct {
 	get_one         *template.Template
 	get_one_all     *template.Template
 	get_first       *template.Template
+	get_iterate     *template.Template
+	get_dynamic     *template.Template
 	upd             *template.Template
 	del             *template.Template
 	del_all         *template.Template
@@ -184,6 +194,16 @@ func New(loader tmplutil.Loader, options *Options) (
 		return nil, err
 	}
 
+	r.get_iterate, err = loader.Load("golang.get-iterate.tmpl", funcs)
+	if err != nil {
+		return nil, err
+	}
+
+	r.get_dynamic, err = loader.Load("golang.get-dynamic.tmpl", funcs)
+	if err != nil {
+		return nil, err
+	}
+
 	r.upd, err = loader.Load("golang.update.tmpl", funcs)
 	if err != nil {
 		return nil, err
@@ -244,6 +264,23 @@ func (r *Renderer) RenderCode(root *ir.Root, dialects []sql.Dialect) (
 		}
 	}
 
+	// Render the options and sort types for dynamic reads
+	dynamic_options := map[string]bool{}
+	for _, read := range root.Reads {
+		if read.View != ir.Dynamic {
+			continue
+		}
+		opts := DynamicOptionsFromIR(read)
+		if dynamic_options[opts.Name] {
+			continue
+		}
+		dynamic_options[opts.Name] = true
+		err := tmplutil.Render(r.get_dynamic, &buf, "types", opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, dialect := range dialects {
 		var gets []*ir.Model
 		for _, cre := range root.Creates {
@@ -313,30 +350,49 @@ func (r *Renderer) RenderCode(root *ir.Root, dialects []sql.Dialect) (
 func (r *Renderer) renderHeader(w io.Writer, root *ir.Root,
 	dialects []sql.Dialect) error {
 
+	type headerMigration struct {
+		Version int64
+		Name    string
+		SQL     string
+	}
+
 	type headerDialect struct {
-		Name      string
-		SchemaSQL string
+		Name                   string
+		SchemaSQL              string
+		SchemaTables           []introspect.SchemaTable
+		Migrations             []headerMigration
+		CreateStmts            []string
+		CreateIfNotExistsStmts []string
+		DropStmts              []string
 	}
 
 	type headerParams struct {
-		Package      string
-		ExtraImports []string
-		Dialects     []headerDialect
-		Structs      []*ModelStruct
-		Options      Options
-		SQLSupport   string
+		Package       string
+		ExtraImports  []string
+		Dialects      []headerDialect
+		Structs       []*ModelStruct
+		Options       Options
+		SQLSupport    string
+		SchemaSupport string
 	}
 
 	params := headerParams{
-		Package:    r.options.Package,
-		Structs:    ModelStructsFromIR(root.Models),
-		Options:    r.options,
-		SQLSupport: sqlbundle.Source,
+		Package:       r.options.Package,
+		Structs:       ModelStructsFromIR(root.Models),
+		Options:       r.options,
+		SQLSupport:    sqlbundle.Source,
+		SchemaSupport: introspect.SchemaDiffSource,
 	}
 
 	for _, dialect := range dialects {
+		err := sql.CheckSchemaSupport(root.Models, dialect)
+		if err != nil {
+			return err
+		}
+
+		schema := sql.SchemaFromIRModels(root.Models, dialect)
 		dialect_schema := sqlgen.Render(dialect,
-			sql.SchemaSQL(root, dialect),
+			sql.SQLFromSchema(schema),
 			sqlgen.NoFlatten, sqlgen.NoTerminate)
 
 		dialect_tmpl, err := r.loadDialect(dialect)
@@ -350,11 +406,38 @@ func (r *Renderer) renderHeader(w io.Writer, root *ir.Root,
 			return err
 		}
 
+		render := func(stmts []sqlgen.SQL) (rendered []string) {
+			for _, stmt := range stmts {
+				rendered = append(rendered, sqlgen.Render(dialect, stmt,
+					sqlgen.NoFlatten, sqlgen.NoTerminate))
+			}
+			return rendered
+		}
+
+		header_dialect := headerDialect{
+			Name:                   dialect.Name(),
+			SchemaSQL:              dialect_schema,
+			SchemaTables:           introspect.SchemaTablesFromSQL(schema, dialect),
+			CreateStmts:            render(sql.CreateSchemaSQL(schema, false)),
+			CreateIfNotExistsStmts: render(sql.CreateSchemaSQL(schema, true)),
+			DropStmts:              render(sql.DropSchemaSQL(schema)),
+		}
+		for _, step := range r.options.Migrations {
+			step_sql, ok := step.SQLFor(dialect.Name())
+			if !ok {
+				return Error.New("migration %d_%s has no SQL for dialect %q",
+					step.Version, step.Name, dialect.Name())
+			}
+			header_dialect.Migrations = append(header_dialect.Migrations,
+				headerMigration{
+					Version: step.Version,
+					Name:    step.Name,
+					SQL:     step_sql,
+				})
+		}
+
 		params.ExtraImports = append(params.ExtraImports, dialect_import)
-		params.Dialects = append(params.Dialects, headerDialect{
-			Name:      dialect.Name(),
-			SchemaSQL: dialect_schema,
-		})
+		params.Dialects = append(params.Dialects, header_dialect)
 	}
 
 	return tmplutil.Render(r.header, w, "", params)
@@ -363,21 +446,48 @@ func (r *Renderer) renderHeader(w io.Writer, root *ir.Root,
 func (r *Renderer) renderCreate(w io.Writer, ir_cre *ir.Create,
 	dialect sql.Dialect) (err error) {
 
+	tmpl, data := r.createFunc(ir_cre, dialect)
+	return r.renderFunc(tmpl, w, data, dialect, ir_cre.Doc)
+}
+
+func (r *Renderer) createFunc(ir_cre *ir.Create, dialect sql.Dialect) (
+	tmpl *template.Template, data interface{}) {
+
 	if ir_cre.Raw {
-		cre := RawCreateFromIR(ir_cre, dialect)
-		return r.renderFunc(r.cre_raw, w, cre, dialect)
+		return r.cre_raw, RawCreateFromIR(ir_cre, dialect)
 	} else {
-		cre := CreateFromIR(ir_cre, dialect)
-		return r.renderFunc(r.cre, w, cre, dialect)
+		return r.cre, CreateFromIR(ir_cre, dialect)
 	}
 }
 
 func (r *Renderer) renderRead(w io.Writer, ir_read *ir.Read,
 	dialect sql.Dialect) error {
 
-	get := GetFromIR(ir_read, dialect)
+	if err := checkLock(ir_read, dialect); err != nil {
+		return err
+	}
+
+	tmpl, data := r.readFunc(ir_read, dialect)
+	return r.renderFunc(tmpl, w, data, dialect, ir_read.Doc)
+}
+
+// checkLock returns an error if the read locks rows and the dialect has no
+// syntax for it.
+func checkLock(ir_read *ir.Read, dialect sql.Dialect) error {
+	if ir_read.Lock != nil && !dialect.Features().RowLocking {
+		return errutil.New(ir_read.Lock.Pos,
+			"dialect %q does not support row locking", dialect.Name())
+	}
+	return nil
+}
+
+func (r *Renderer) readFunc(ir_read *ir.Read, dialect sql.Dialect) (
+	tmpl *template.Template, data interface{}) {
+
+	if ir_read.View == ir.Dynamic {
+		return r.get_dynamic, DynamicFromIR(ir_read, dialect)
+	}
 
-	var tmpl *template.Template
 	switch ir_read.View {
 	case ir.All:
 		tmpl = r.get_all
@@ -403,37 +513,84 @@ func (r *Renderer) renderRead(w io.Writer, ir_read *ir.Read,
 		}
 	case ir.First:
 		tmpl = r.get_first
+	case ir.Iterate:
+		tmpl = r.get_iterate
 	default:
 		panic(fmt.Sprintf("unhandled read view %s", ir_read.View))
 	}
 
-	return r.renderFunc(tmpl, w, get, dialect)
+	return tmpl, GetFromIR(ir_read, dialect)
 }
 
 func (r *Renderer) renderUpdate(w io.Writer, ir_upd *ir.Update,
 	dialect sql.Dialect) error {
 
-	upd := UpdateFromIR(ir_upd, dialect)
-	return r.renderFunc(r.upd, w, upd, dialect)
+	tmpl, data := r.updateFunc(ir_upd, dialect)
+	return r.renderFunc(tmpl, w, data, dialect, ir_upd.Doc)
+}
+
+func (r *Renderer) updateFunc(ir_upd *ir.Update, dialect sql.Dialect) (
+	tmpl *template.Template, data interface{}) {
+
+	return r.upd, UpdateFromIR(ir_upd, dialect)
 }
 
 func (r *Renderer) renderDelete(w io.Writer, ir_del *ir.Delete,
 	dialect sql.Dialect) error {
 
-	del := DeleteFromIR(ir_del, dialect)
+	tmpl, data := r.deleteFunc(ir_del, dialect)
+	return r.renderFunc(tmpl, w, data, dialect, ir_del.Doc)
+}
+
+func (r *Renderer) deleteFunc(ir_del *ir.Delete, dialect sql.Dialect) (
+	tmpl *template.Template, data interface{}) {
+
 	if ir_del.Distinct() {
-		return r.renderFunc(r.del, w, del, dialect)
+		return r.del, DeleteFromIR(ir_del, dialect)
 	} else {
-		return r.renderFunc(r.del_all, w, del, dialect)
+		return r.del_all, DeleteFromIR(ir_del, dialect)
 	}
 }
 
+// Signature returns the signature of the method generated for a *ir.Create,
+// *ir.Read, *ir.Update or *ir.Delete.
+func (r *Renderer) Signature(op interface{}, dialect sql.Dialect) (
+	signature string, err error) {
+
+	var tmpl *template.Template
+	var data interface{}
+	switch op := op.(type) {
+	case *ir.Create:
+		tmpl, data = r.createFunc(op, dialect)
+	case *ir.Read:
+		tmpl, data = r.readFunc(op, dialect)
+	case *ir.Update:
+		tmpl, data = r.updateFunc(op, dialect)
+	case *ir.Delete:
+		tmpl, data = r.deleteFunc(op, dialect)
+	default:
+		panic(fmt.Sprintf("unhandled operation %T", op))
+	}
+
+	var buf bytes.Buffer
+	err = tmplutil.Render(tmpl, &buf, "signature", data)
+	if err != nil {
+		return "", err
+	}
+	return cleanSignature(buf.String()), nil
+}
+
 func (r *Renderer) renderDeleteWorld(w io.Writer, ir_models []*ir.Model,
 	dialect sql.Dialect) error {
 
+	type deleteTable struct {
+		Table string
+		SQL   string
+	}
+
 	type deleteWorld struct {
 		Dialect string
-		SQLs    []string
+		Deletes []deleteTable
 	}
 
 	del := deleteWorld{
@@ -443,14 +600,17 @@ func (r *Renderer) renderDeleteWorld(w io.Writer, ir_models []*ir.Model,
 		sql := sqlgen.Render(dialect, sql.DeleteSQL(&ir.Delete{
 			Model: ir_models[i],
 		}, dialect))
-		del.SQLs = append(del.SQLs, sql)
+		del.Deletes = append(del.Deletes, deleteTable{
+			Table: ir_models[i].Table,
+			SQL:   sql,
+		})
 	}
 
-	return r.renderFunc(r.del_world, w, del, dialect)
+	return r.renderFunc(r.del_world, w, del, dialect, "")
 }
 
 func (r *Renderer) renderFunc(tmpl *template.Template, w io.Writer,
-	data interface{}, dialect sql.Dialect) (err error) {
+	data interface{}, dialect sql.Dialect, doc string) (err error) {
 
 	var signature bytes.Buffer
 	err = tmplutil.Render(tmpl, &signature, "signature", data)
@@ -460,6 +620,7 @@ func (r *Renderer) renderFunc(tmpl *template.Template, w io.Writer,
 
 	method := publicMethod{
 		Signature: signature.String(),
+		Doc:       docComment(doc),
 	}
 
 	if isExported(method.Signature) {
@@ -512,16 +673,18 @@ func (r *Renderer) renderGetLast(w io.Writer, model *ir.Model,
 
 	type getLast struct {
 		Info   sqlembedgo.Info
+		Table  string
 		Return *Var
 	}
 
 	get_last_sql := sql.GetLastSQL(model, dialect)
 	get_last := getLast{
 		Info:   sqlembedgo.Embed("__", get_last_sql),
+		Table:  model.Table,
 		Return: VarFromModel(model),
 	}
 
-	return r.renderFunc(r.get_last, w, get_last, dialect)
+	return r.renderFunc(r.get_last, w, get_last, dialect, "")
 }
 
 func (r *Renderer) renderFooter(w io.Writer) error {
@@ -532,12 +695,14 @@ func (r *Renderer) renderFooter(w io.Writer) error {
 	sort.Sort(sort.StringSlice(keys))
 
 	type footerData struct {
-		SupportRx bool
-		Methods   []publicMethod
+		SupportRx       bool
+		SupportPrepared bool
+		Methods         []publicMethod
 	}
 
 	data := footerData{
-		SupportRx: r.options.SupportRx,
+		SupportRx:       r.options.SupportRx,
+		SupportPrepared: r.options.SupportPrepared,
 	}
 
 	for _, key := range keys {
@@ -563,7 +728,17 @@ func (r *Renderer) renderDialectFuncs(w io.Writer, dialect sql.Dialect) (
 		return err
 	}
 
-	return tmplutil.Render(tmpl, w, "is-constraint-error", dialect_func)
+	err = tmplutil.Render(tmpl, w, "is-constraint-error", dialect_func)
+	if err != nil {
+		return err
+	}
+
+	err = tmplutil.Render(tmpl, w, "schema-catalog", dialect_func)
+	if err != nil {
+		return err
+	}
+
+	return tmplutil.Render(tmpl, w, "migrate", dialect_func)
 }
 
 func (r *Renderer) renderDialectOpens(w io.Writer, dialects []sql.Dialect) (
@@ -25,6 +25,7 @@ type Update struct {
 	Info              sqlembedgo.Info
 	InfoGet           sqlembedgo.Info
 	Suffix            string
+	Table             string
 	Struct            *ModelStruct
 	Return            *Var
 	AutoFields        []*Var
@@ -38,6 +39,7 @@ func UpdateFromIR(ir_upd *ir.Update, dialect sql.Dialect) *Update {
 		PartitionedArgs:   PartitionedArgsFromWheres(ir_upd.Where),
 		Info:              sqlembedgo.Embed("__", update_sql),
 		Suffix:            convertSuffix(ir_upd.Suffix),
+		Table:             ir_upd.Model.Table,
 		Struct:            ModelStructFromIR(ir_upd.Model),
 		SupportsReturning: dialect.Features().Returning,
 	}
@@ -111,27 +111,75 @@ func ArgFromField(field *ir.Field) *Var {
 	}
 }
 
-func ArgFromWhere(where *ir.Where) *Var {
-	// TODO: clean this up when we do full expression type evaluation.
-	// assume for now that the left hand side evaluates eventually to a single
-	// field wrapped in zero or more function calls since that is all that is
-	// possible via the xform package.
-	expr := where.Left
-	for expr.Field == nil {
-		expr = expr.FuncCall.Args[0]
-	}
-
-	name := expr.Field.UnderRef()
-	if where.Op != consts.EQ {
-		name += "_" + where.Op.Suffix()
+// ArgsFromWhere returns an argument for every operand of the where that has a
+// placeholder, in the order they are bound in the SQL.
+func ArgsFromWhere(where *ir.Where) (args []*Var) {
+	operands := where.Operands()
+	for i, operand := range operands {
+		if !operand.HasPlaceholder() {
+			continue
+		}
+		arg := ArgFromWhere(where)
+		if len(operands) > 1 {
+			if i == 0 {
+				arg.Name += "_low"
+			} else {
+				arg.Name += "_high"
+			}
+		}
+		args = append(args, arg)
 	}
+	return args
+}
 
+func ArgFromWhere(where *ir.Where) *Var {
 	// we don't set ZeroVal or InitVal because these args should only be used
 	// as incoming arguments to function calls.
+
+	// if the left hand side evaluates to values of a field, like the field
+	// itself or lower(field), the argument uses the field type. the name
+	// includes any function so that it does not clash with an argument
+	// compared against the field itself.
+	if field := where.Left.ResultField(); field != nil {
+		name := field.UnderRef()
+		if where.Left.FuncCall != nil {
+			name = funcArgName(where.Left.FuncCall)
+		}
+		return &Var{
+			Name: whereArgName(name, where.Op),
+			Type: ModelFieldFromIR(field).StructName(),
+		}
+	}
+
+	// otherwise the argument is a plain value of the result type of the
+	// function on the left hand side, like the int64 of length(field).
+	typ, _ := where.Left.Type()
 	return &Var{
-		Name: name,
-		Type: ModelFieldFromIR(expr.Field).StructName(),
+		Name:  whereArgName(funcArgName(where.Left.FuncCall), where.Op),
+		Type:  valueType(typ, false),
+		Plain: true,
+	}
+}
+
+func whereArgName(name string, op consts.Operator) string {
+	if op != consts.EQ {
+		name += "_" + op.Suffix()
 	}
+	return name
+}
+
+// funcArgName names an argument compared against the function call after
+// the first field it is called on, if any, and the function.
+func funcArgName(call *ir.FuncCall) string {
+	for _, arg := range call.Args {
+		switch {
+		case arg.Field != nil:
+			return arg.Field.UnderRef() + "_" + call.Name
+		case arg.FuncCall != nil:
+			return funcArgName(arg.FuncCall) + "_" + call.Name
+		}
+	}
+	return call.Name
 }
 
 func StructVar(name string, typ string, vars []*Var) *Var {
@@ -150,6 +198,10 @@ type Var struct {
 	ZeroVal string
 	InitVal string
 	Fields  []*Var
+
+	// Plain is set on arguments that are values of Type rather than one of
+	// the generated field types.
+	Plain bool
 }
 
 func (v *Var) Value() string {
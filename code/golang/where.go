@@ -14,7 +14,11 @@
 
 package golang
 
-import "gopkg.in/spacemonkeygo/dbx.v1/ir"
+import (
+	"fmt"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
 
 type PartitionedArgs struct {
 	AllArgs      []*Var
@@ -23,18 +27,23 @@ type PartitionedArgs struct {
 }
 
 func PartitionedArgsFromWheres(wheres []*ir.Where) (out PartitionedArgs) {
+	// two wheres can compare expressions that name their arguments the
+	// same, like substr(field, 1, 2) = ? and substr(field, 3, 4) = ?, so
+	// later arguments with a name already taken are numbered.
+	names := map[string]int{}
 	for _, where := range wheres {
-		if !where.Right.HasPlaceholder() {
-			continue
-		}
-
-		arg := ArgFromWhere(where)
-		out.AllArgs = append(out.AllArgs, arg)
+		for _, arg := range ArgsFromWhere(where) {
+			names[arg.Name]++
+			if count := names[arg.Name]; count > 1 {
+				arg.Name = fmt.Sprintf("%s_%d", arg.Name, count)
+			}
+			out.AllArgs = append(out.AllArgs, arg)
 
-		if where.NeedsCondition() {
-			out.NullableArgs = append(out.NullableArgs, arg)
-		} else {
-			out.StaticArgs = append(out.StaticArgs, arg)
+			if where.NeedsCondition() {
+				out.NullableArgs = append(out.NullableArgs, arg)
+			} else {
+				out.StaticArgs = append(out.StaticArgs, arg)
+			}
 		}
 	}
 	return out
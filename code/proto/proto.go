@@ -0,0 +1,350 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+
+	"bitbucket.org/pkg/inflect"
+	"github.com/spacemonkeygo/errors"
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/tmplutil"
+)
+
+var (
+	Error = errors.NewClass("proto")
+)
+
+type Options struct {
+	// Package is the protobuf package of the messages.
+	Package string
+
+	// GoPackage is the import path of the Go code generated from the
+	// messages. It is written as the go_package option, if set, and is
+	// required for the conversions.
+	GoPackage string
+
+	// ConvertPackage is the package of the dbx generated Go code the
+	// conversions are written in.
+	ConvertPackage string
+}
+
+type Renderer struct {
+	proto   *template.Template
+	convert *template.Template
+	options Options
+}
+
+func New(loader tmplutil.Loader, options *Options) (r *Renderer, err error) {
+	r = &Renderer{
+		options: *options,
+	}
+
+	funcs := template.FuncMap{
+		"toproto":   toProtoFn,
+		"fromproto": fromProtoFn,
+	}
+
+	r.proto, err = loader.Load("proto.tmpl", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r.convert, err = loader.Load("golang.proto-convert.tmpl", funcs)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Message is a protobuf message mirroring the struct generated for a model.
+type Message struct {
+	Name   string
+	Doc    []string
+	Fields []*MessageField
+}
+
+type MessageField struct {
+	Name   string
+	Number int
+	Type   string
+	Doc    []string
+
+	// GoName is the name of the field in the Go code generated from the
+	// message and StructName the name of the field in the dbx struct.
+	GoName     string
+	StructName string
+
+	field *ir.Field
+}
+
+func MessagesFromIR(models []*ir.Model) (messages []*Message) {
+	for _, model := range models {
+		message := &Message{
+			Name: inflect.Camelize(model.Name),
+			Doc:  docLines(model.Doc),
+		}
+		for i, field := range model.Fields {
+			number := field.ProtoNumber
+			if number == 0 {
+				number = i + 1
+			}
+			message.Fields = append(message.Fields, &MessageField{
+				Name:       field.Name,
+				Number:     number,
+				Type:       protoType(field),
+				Doc:        docLines(field.Doc),
+				GoName:     goCamelCase(field.Name),
+				StructName: inflect.Camelize(field.Name),
+				field:      field,
+			})
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// RenderProto returns the .proto file with a message for every model.
+func (r *Renderer) RenderProto(root *ir.Root) (rendered []byte, err error) {
+	type protoFile struct {
+		Package   string
+		GoPackage string
+		Imports   []string
+		Messages  []*Message
+	}
+
+	timestamps, wrappers := wellKnownTypes(root.Models)
+	file := protoFile{
+		Package:   r.options.Package,
+		GoPackage: r.options.GoPackage,
+		Messages:  MessagesFromIR(root.Models),
+	}
+	if timestamps {
+		file.Imports = append(file.Imports, "google/protobuf/timestamp.proto")
+	}
+	if wrappers {
+		file.Imports = append(file.Imports, "google/protobuf/wrappers.proto")
+	}
+
+	var buf bytes.Buffer
+	if err := tmplutil.Render(r.proto, &buf, "", file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderConvert returns Go code converting between the dbx generated structs
+// and the Go code generated from the messages.
+func (r *Renderer) RenderConvert(root *ir.Root) (rendered []byte, err error) {
+	if r.options.GoPackage == "" {
+		return nil, Error.New("the Go package of the messages is required " +
+			"to convert to and from them")
+	}
+	if r.options.ConvertPackage == "" {
+		return nil, Error.New("the package of the dbx Go code is required " +
+			"to convert to and from the messages")
+	}
+
+	type convertFile struct {
+		Package    string
+		GoPackage  string
+		Timestamps bool
+		Wrappers   bool
+		Messages   []*Message
+	}
+
+	file := convertFile{
+		Package:   r.options.ConvertPackage,
+		GoPackage: r.options.GoPackage,
+		Messages:  MessagesFromIR(root.Models),
+	}
+	file.Timestamps, file.Wrappers = wellKnownTypes(root.Models)
+
+	var buf bytes.Buffer
+	if err := tmplutil.Render(r.convert, &buf, "", file); err != nil {
+		return nil, err
+	}
+	rendered, err = format.Source(buf.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return rendered, nil
+}
+
+// wellKnownTypes returns if any field is a Timestamp or a wrapper.
+func wellKnownTypes(models []*ir.Model) (timestamps, wrappers bool) {
+	for _, model := range models {
+		for _, field := range model.Fields {
+			switch {
+			case isTime(field.Type):
+				timestamps = true
+			case field.Nullable:
+				wrappers = true
+			}
+		}
+	}
+	return timestamps, wrappers
+}
+
+func isTime(t consts.FieldType) bool {
+	switch t {
+	case consts.TimestampField, consts.TimestampUTCField, consts.DateField:
+		return true
+	default:
+		return false
+	}
+}
+
+// scalarType returns the protobuf scalar type of t, the Go type of the field
+// in the dbx struct if it needs a conversion, and the name of its wrapper.
+func scalarType(t consts.FieldType) (scalar, cast, wrapper string) {
+	switch t {
+	case consts.SerialField, consts.IntField:
+		return "int32", "int", "Int32"
+	case consts.Serial64Field, consts.Int64Field:
+		return "int64", "", "Int64"
+	case consts.UintField:
+		return "uint32", "uint", "UInt32"
+	case consts.Uint64Field:
+		return "uint64", "", "UInt64"
+	case consts.FloatField:
+		return "float", "", "Float"
+	case consts.Float64Field:
+		return "double", "", "Double"
+	case consts.TextField:
+		return "string", "", "String"
+	case consts.BoolField:
+		return "bool", "", "Bool"
+	case consts.BlobField:
+		return "bytes", "", "Bytes"
+	default:
+		panic(fmt.Sprintf("unhandled field type %q", t))
+	}
+}
+
+func protoType(field *ir.Field) string {
+	if isTime(field.Type) {
+		return "google.protobuf.Timestamp"
+	}
+	scalar, _, wrapper := scalarType(field.Type)
+	if field.Nullable {
+		return "google.protobuf." + wrapper + "Value"
+	}
+	return scalar
+}
+
+// toProtoFn returns the statement setting the field of msg from obj.
+func toProtoFn(f *MessageField) string {
+	from := "obj." + f.StructName
+	to := "msg." + f.GoName
+	if isTime(f.field.Type) {
+		if f.field.Nullable {
+			return fmt.Sprintf("if %s != nil {\n%s = timestamppb.New(*%s)\n}",
+				from, to, from)
+		}
+		return fmt.Sprintf("%s = timestamppb.New(%s)", to, from)
+	}
+
+	scalar, cast, wrapper := scalarType(f.field.Type)
+	if !f.field.Nullable {
+		if cast != "" {
+			return fmt.Sprintf("%s = %s(%s)", to, scalar, from)
+		}
+		return fmt.Sprintf("%s = %s", to, from)
+	}
+
+	value := "*" + from
+	switch {
+	case f.field.Type == consts.BlobField:
+		value = from
+	case cast != "":
+		value = fmt.Sprintf("%s(*%s)", scalar, from)
+	}
+	return fmt.Sprintf("if %s != nil {\n%s = wrapperspb.%s(%s)\n}",
+		from, to, wrapper, value)
+}
+
+// fromProtoFn returns the statement setting the field of obj from msg.
+func fromProtoFn(f *MessageField) string {
+	from := "msg." + f.GoName
+	to := "obj." + f.StructName
+	if isTime(f.field.Type) {
+		if f.field.Nullable {
+			return fmt.Sprintf("if %s != nil {\nv := %s.AsTime()\n%s = &v\n}",
+				from, from, to)
+		}
+		return fmt.Sprintf("%s = %s.AsTime()", to, from)
+	}
+
+	_, cast, _ := scalarType(f.field.Type)
+	if !f.field.Nullable {
+		if cast != "" {
+			return fmt.Sprintf("%s = %s(%s)", to, cast, from)
+		}
+		return fmt.Sprintf("%s = %s", to, from)
+	}
+
+	if f.field.Type == consts.BlobField {
+		return fmt.Sprintf("if %s != nil {\n%s = %s.Value\n}", from, to, from)
+	}
+	value := from + ".Value"
+	if cast != "" {
+		value = fmt.Sprintf("%s(%s)", cast, value)
+	}
+	return fmt.Sprintf("if %s != nil {\nv := %s\n%s = &v\n}", from, value, to)
+}
+
+func docLines(doc string) []string {
+	if doc == "" {
+		return nil
+	}
+	return strings.Split(doc, "\n")
+}
+
+// goCamelCase returns the name protoc-gen-go gives the Go field for a
+// protobuf field name.
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isLower(s[i+1]):
+			// the next letter is uppercased instead
+		case c >= '0' && c <= '9':
+			b = append(b, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isLower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
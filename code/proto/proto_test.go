@@ -0,0 +1,309 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+	"gopkg.in/spacemonkeygo/dbx.v1/templates"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/tmplutil"
+)
+
+func TestRender(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	ast_root, err := syntax.Parse("proto.dbx", []byte(`
+// A user of the site.
+model user (
+	key pk
+	// The surrogate key.
+	field pk         serial64
+	field age        int
+	field nick       text      ( nullable )
+	field created_at timestamp
+	field deleted_at timestamp ( nullable )
+)
+`))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+
+	renderer, err := New(tmplutil.BinLoader(templates.Asset), &Options{
+		Package:        "acme.users",
+		GoPackage:      "example.com/userspb",
+		ConvertPackage: "users",
+	})
+	tw.AssertNoError(err)
+
+	rendered, err := renderer.RenderProto(root)
+	tw.AssertNoError(err)
+	for _, fragment := range []string{
+		"package acme.users;\n",
+		"option go_package = \"example.com/userspb\";\n",
+		"import \"google/protobuf/timestamp.proto\";\n" +
+			"import \"google/protobuf/wrappers.proto\";\n",
+		"// A user of the site.\nmessage User {\n" +
+			"  // The surrogate key.\n" +
+			"  int64 pk = 1;\n" +
+			"  int32 age = 2;\n" +
+			"  google.protobuf.StringValue nick = 3;\n" +
+			"  google.protobuf.Timestamp created_at = 4;\n" +
+			"  google.protobuf.Timestamp deleted_at = 5;\n}\n",
+	} {
+		tw.AssertContains(string(rendered), fragment)
+	}
+
+	rendered, err = renderer.RenderConvert(root)
+	tw.AssertNoError(err)
+	for _, fragment := range []string{
+		"func UserToProto(obj *User) *pb.User {",
+		"msg.Age = int32(obj.Age)\n",
+		"msg.Nick = wrapperspb.String(*obj.Nick)\n",
+		"msg.CreatedAt = timestamppb.New(obj.CreatedAt)\n",
+		"func UserFromProto(msg *pb.User) *User {",
+		"obj.Age = int(msg.Age)\n",
+		"v := msg.DeletedAt.AsTime()\n\t\tobj.DeletedAt = &v\n",
+	} {
+		tw.AssertContains(string(rendered), fragment)
+	}
+
+	renderer, err = New(tmplutil.BinLoader(templates.Asset), &Options{
+		Package:        "acme.users",
+		ConvertPackage: "users",
+	})
+	tw.AssertNoError(err)
+	_, err = renderer.RenderConvert(root)
+	tw.AssertError(err, "Go package of the messages is required")
+}
+
+func TestGoCamelCase(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	for in, out := range map[string]string{
+		"pk":         "Pk",
+		"created_at": "CreatedAt",
+		"user_id":    "UserId",
+		"field_2":    "Field_2",
+		"v2_name":    "V2Name",
+		"_hidden":    "XHidden",
+	} {
+		if got := goCamelCase(in); got != out {
+			tw.Fatalf("goCamelCase(%q) = %q, expected %q", in, got, out)
+		}
+	}
+}
+
+func TestNumbers(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	ast_root, err := syntax.Parse("proto.dbx", []byte(`
+model user (
+	key pk
+	field pk   serial64 ( proto 1 )
+	field nick text     ( proto 3 )
+	field age  int      ( proto 2 )
+)
+`))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+
+	renderer, err := New(tmplutil.BinLoader(templates.Asset), &Options{
+		Package: "acme.users",
+	})
+	tw.AssertNoError(err)
+	rendered, err := renderer.RenderProto(root)
+	tw.AssertNoError(err)
+	tw.AssertContains(string(rendered), "message User {\n"+
+		"  int64 pk = 1;\n"+
+		"  string nick = 3;\n"+
+		"  int32 age = 2;\n}\n")
+}
+
+// convertDBX has every type, nullable or not, and a relation.
+const convertDBX = `
+model user (
+	key pk
+	field pk         serial64
+	field age        int
+	field score      uint      ( nullable )
+	field visits     uint64
+	field weight     float     ( nullable )
+	field height     float64
+	field nick       text      ( nullable )
+	field admin      bool
+	field avatar     blob      ( nullable )
+	field created_at timestamp
+	field deleted_at timestamp ( nullable )
+	field born       date
+)
+
+model post (
+	key pk
+	field pk     serial                   ( proto 2 )
+	field author user.pk cascade          ( proto 1 )
+	field count  int     ( nullable, proto 3 )
+)
+`
+
+// the parts of google.golang.org/protobuf used by the conversions.
+const (
+	timestamppbStub = `package timestamppb
+
+import "time"
+
+type Timestamp struct{ Seconds int64 }
+
+func New(t time.Time) *Timestamp { return &Timestamp{Seconds: t.Unix()} }
+
+func (x *Timestamp) AsTime() time.Time { return time.Unix(x.Seconds, 0) }
+`
+	wrapperspbStub = `package wrapperspb
+{{ range . }}
+type {{ .Name }}Value struct{ Value {{ .Type }} }
+
+func {{ .Name }}(v {{ .Type }}) *{{ .Name }}Value {
+	return &{{ .Name }}Value{Value: v}
+}
+{{ end }}`
+)
+
+// TestConvertCompiles builds the conversions against the dbx code and stubs
+// of the protobuf packages and of the messages protoc-gen-go would generate.
+func TestConvertCompiles(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	ast_root, err := syntax.Parse("convert.dbx", []byte(convertDBX))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+
+	dir, err := ioutil.TempDir("", "dbx-proto")
+	tw.AssertNoError(err)
+	defer os.RemoveAll(dir)
+
+	write := func(name string, data []byte) {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		tw.AssertNoError(os.MkdirAll(filepath.Dir(path), 0755))
+		tw.AssertNoError(ioutil.WriteFile(path, data, 0644))
+	}
+
+	golang_renderer, err := golang.New(tmplutil.BinLoader(templates.Asset),
+		&golang.Options{Package: "users"})
+	tw.AssertNoError(err)
+	code, err := golang_renderer.RenderCode(root,
+		[]sql.Dialect{sql.Postgres()})
+	tw.AssertNoError(err)
+	write("convert.dbx.go", code)
+
+	renderer, err := New(tmplutil.BinLoader(templates.Asset), &Options{
+		GoPackage:      "example.com/userspb",
+		ConvertPackage: "users",
+	})
+	tw.AssertNoError(err)
+	convert, err := renderer.RenderConvert(root)
+	tw.AssertNoError(err)
+	write("convert.dbx.proto.go", convert)
+
+	write("userspb/userspb.go", messagesStub(MessagesFromIR(root.Models)))
+	write("protobuf/go.mod", []byte("module google.golang.org/protobuf\n"))
+	write("protobuf/types/known/timestamppb/timestamppb.go",
+		[]byte(timestamppbStub))
+	var wrappers bytes.Buffer
+	tw.AssertNoError(template.Must(template.New("").Parse(wrapperspbStub)).
+		Execute(&wrappers, []struct{ Name, Type string }{
+			{"Int32", "int32"}, {"Int64", "int64"},
+			{"UInt32", "uint32"}, {"UInt64", "uint64"},
+			{"Float", "float32"}, {"Double", "float64"},
+			{"String", "string"}, {"Bool", "bool"}, {"Bytes", "[]byte"},
+		}))
+	write("protobuf/types/known/wrapperspb/wrapperspb.go", wrappers.Bytes())
+
+	pq_dir, err := exec.Command("go", "list", "-m", "-f", "{{ .Dir }}",
+		"github.com/lib/pq").Output()
+	tw.AssertNoError(err)
+	write("go.mod", []byte(fmt.Sprintf(`module example.com
+
+require (
+	github.com/lib/pq v1.0.0
+	google.golang.org/protobuf v0.0.0
+)
+
+replace github.com/lib/pq => %s
+
+replace google.golang.org/protobuf => ./protobuf
+`, strings.TrimSpace(string(pq_dir)))))
+
+	var output bytes.Buffer
+	cmd := exec.Command("go", "build", "./...")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off",
+		"GOWORK=off")
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		tw.Context("convert", string(convert))
+		tw.Fatalf("build failed: %v\n%s", err, output.String())
+	}
+}
+
+// messagesStub returns the structs protoc-gen-go generates for the messages.
+func messagesStub(messages []*Message) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("package userspb\n\nimport (\n" +
+		"\t\"google.golang.org/protobuf/types/known/timestamppb\"\n" +
+		"\t\"google.golang.org/protobuf/types/known/wrapperspb\"\n)\n\n" +
+		"var _ *timestamppb.Timestamp\nvar _ *wrapperspb.Int32Value\n")
+	go_types := map[string]string{
+		"float":                     "float32",
+		"double":                    "float64",
+		"bytes":                     "[]byte",
+		"google.protobuf.Timestamp": "*timestamppb.Timestamp",
+	}
+	for _, message := range messages {
+		fmt.Fprintf(&buf, "\ntype %s struct {\n", message.Name)
+		for _, field := range message.Fields {
+			go_type, ok := go_types[field.Type]
+			switch {
+			case ok:
+			case strings.HasPrefix(field.Type, "google.protobuf."):
+				go_type = "*wrapperspb." +
+					strings.TrimPrefix(field.Type, "google.protobuf.")
+			default:
+				go_type = field.Type
+			}
+			fmt.Fprintf(&buf, "\t%s %s\n", field.GoName, go_type)
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.Bytes()
+}
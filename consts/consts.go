@@ -22,6 +22,30 @@ const (
 	InnerJoin JoinType = iota
 )
 
+func (j JoinType) String() string {
+	switch j {
+	case InnerJoin:
+		return "inner"
+	default:
+		return "<UNKNOWN-JOIN-TYPE>"
+	}
+}
+
+type LockMode int
+
+const (
+	LockUpdate LockMode = iota
+	LockShare
+)
+
+type NullsOrder int
+
+const (
+	NullsDefault NullsOrder = iota
+	NullsFirst
+	NullsLast
+)
+
 type Operator string
 
 const (
@@ -32,6 +56,12 @@ const (
 	EQ   Operator = "="
 	NE   Operator = "!="
 	Like Operator = "like"
+
+	NotLike        Operator = "not like"
+	ILike          Operator = "ilike"
+	Between        Operator = "between"
+	IsDistinctFrom Operator = "is distinct from"
+	Regexp         Operator = "regexp"
 )
 
 func (o Operator) Suffix() string {
@@ -50,6 +80,16 @@ func (o Operator) Suffix() string {
 		return "not"
 	case Like:
 		return "like"
+	case NotLike:
+		return "not_like"
+	case ILike:
+		return "ilike"
+	case Between:
+		return "between"
+	case IsDistinctFrom:
+		return "is_distinct_from"
+	case Regexp:
+		return "regexp"
 	default:
 		panic(fmt.Sprintf("unhandled operation %q", o))
 	}
@@ -102,6 +142,8 @@ func (f FieldType) String() string {
 		return "utimestamp"
 	case BlobField:
 		return "blob"
+	case DateField:
+		return "date"
 	default:
 		return "<UNKNOWN-FIELD>"
 	}
@@ -125,3 +167,16 @@ const (
 	Cascade
 	Restrict
 )
+
+func (r RelationKind) String() string {
+	switch r {
+	case SetNull:
+		return "setnull"
+	case Cascade:
+		return "cascade"
+	case Restrict:
+		return "restrict"
+	default:
+		return "<UNKNOWN-RELATION-KIND>"
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diagram renders the models of a dbx schema as an
+// entity-relationship diagram.
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+// Formats are the diagram formats Render supports.
+var Formats = []string{"dot", "mermaid", "plantuml"}
+
+// Render writes the diagram of root in the given format to w.
+func Render(w io.Writer, root *ir.Root, format string) error {
+	var buf bytes.Buffer
+	switch format {
+	case "dot":
+		renderDot(&buf, root)
+	case "mermaid":
+		renderMermaid(&buf, root)
+	case "plantuml":
+		renderPlantUML(&buf, root)
+	default:
+		return fmt.Errorf("unknown diagram format %q (expected one of %s)",
+			format, strings.Join(Formats, ", "))
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// relation is a field relation or foreignkey from the fields of one model to
+// the fields of another.
+type relation struct {
+	Model      *ir.Model
+	Fields     []*ir.Field
+	References []*ir.Field
+	OnDelete   consts.RelationKind
+}
+
+func (r *relation) Referenced() *ir.Model {
+	return r.References[0].Model
+}
+
+// Nullable returns true if the relation is optional, i.e. any of the
+// referencing fields is nullable.
+func (r *relation) Nullable() bool {
+	for _, field := range r.Fields {
+		if field.Nullable {
+			return true
+		}
+	}
+	return false
+}
+
+// Line returns the line drawn between the models: solid if the relation is
+// identifying, i.e. all of the referencing fields are part of the primary key,
+// and dotted otherwise.
+func (r *relation) Line() string {
+	for _, field := range r.Fields {
+		if !r.Model.InPrimaryKey(field) {
+			return ".."
+		}
+	}
+	return "--"
+}
+
+// Label returns the referencing columns and the ondelete kind, e.g.
+// "user_id cascade".
+func (r *relation) Label() string {
+	columns := fieldColumns(r.Fields)
+	if len(columns) > 1 {
+		return fmt.Sprintf("(%s) %s", strings.Join(columns, ", "), r.OnDelete)
+	}
+	return fmt.Sprintf("%s %s", columns[0], r.OnDelete)
+}
+
+func modelRelations(model *ir.Model) (relations []*relation) {
+	for _, field := range model.Fields {
+		if field.Relation == nil {
+			continue
+		}
+		relations = append(relations, &relation{
+			Model:      model,
+			Fields:     []*ir.Field{field},
+			References: []*ir.Field{field.Relation.Field},
+			OnDelete:   field.Relation.Kind,
+		})
+	}
+	for _, foreign_key := range model.ForeignKeys {
+		relations = append(relations, &relation{
+			Model:      model,
+			Fields:     foreign_key.Fields,
+			References: foreign_key.References,
+			OnDelete:   foreign_key.OnDelete,
+		})
+	}
+	return relations
+}
+
+// keys returns the key markers of a field: PK if it is part of the primary
+// key, UK if it is part of a unique constraint or unique index and FK if it
+// references another model.
+func fieldColumns(fields []*ir.Field) (columns []string) {
+	for _, field := range fields {
+		columns = append(columns, field.Column)
+	}
+	return columns
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagram
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+)
+
+const diagramSource = `
+model user (
+	key    pk
+	unique email
+	field pk    serial64
+	field email text
+)
+
+model post (
+	key pk
+	field pk     serial64
+	field author user.pk cascade
+	field editor user.pk setnull ( nullable )
+)
+
+model tag (
+	key post name
+	field post user.pk restrict
+	field name text
+)
+`
+
+func TestRender(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	ast_root, err := syntax.Parse("diagram.dbx", []byte(diagramSource))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+
+	expected := map[string][]string{
+		"mermaid": {
+			"    users {\n        serial64 pk PK\n        text email UK\n",
+			"        int64 editor FK \"nullable\"\n",
+			"        int64 post PK, FK\n",
+			"posts }o..|| users : \"author cascade\"\n",
+			"posts }o..o| users : \"editor setnull\"\n",
+			"tags }o--|| users : \"post restrict\"\n",
+		},
+		"plantuml": {
+			"entity users {\n  * pk : serial64 <<PK>>\n  --\n",
+			"  editor : int64 <<FK>>\n",
+			"posts }o..o| users : editor setnull\n",
+		},
+		"dot": {
+			"\"posts\":\"author\" -> \"users\":\"pk\" " +
+				"[label=\"author cascade\", style=solid];\n",
+			"\"posts\":\"editor\" -> \"users\":\"pk\" " +
+				"[label=\"editor setnull\", style=dashed];\n",
+		},
+	}
+	for format, fragments := range expected {
+		var buf bytes.Buffer
+		tw.AssertNoError(Render(&buf, root, format))
+		for _, fragment := range fragments {
+			tw.AssertContains(buf.String(), fragment)
+		}
+	}
+
+	tw.AssertError(Render(new(bytes.Buffer), root, "svg"),
+		"unknown diagram format")
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+func renderDot(buf *bytes.Buffer, root *ir.Root) {
+	buf.WriteString("digraph dbx {\n")
+	buf.WriteString("\trankdir=LR;\n")
+	buf.WriteString("\tnode [shape=plaintext];\n")
+	for _, model := range root.Models {
+		fmt.Fprintf(buf, "\t%q [label=<\n", model.Table)
+		buf.WriteString("\t<table border=\"0\" cellborder=\"1\" " +
+			"cellspacing=\"0\">\n")
+		fmt.Fprintf(buf, "\t<tr><td colspan=\"3\"><b>%s</b></td></tr>\n",
+			model.Table)
+		for _, field := range model.Fields {
+			markers := model.FieldKeys(field)
+			if field.Nullable {
+				markers = append(markers, "nullable")
+			}
+			fmt.Fprintf(buf, "\t<tr><td port=%q align=\"left\">%s</td>"+
+				"<td align=\"left\">%s</td><td align=\"left\">%s</td></tr>\n",
+				field.Column, field.Column, field.Type,
+				strings.Join(markers, ", "))
+		}
+		buf.WriteString("\t</table>>];\n")
+	}
+	for _, model := range root.Models {
+		for _, relation := range modelRelations(model) {
+			style := "solid"
+			if relation.Nullable() {
+				style = "dashed"
+			}
+			fmt.Fprintf(buf, "\t%q:%q -> %q:%q [label=%q, style=%s];\n",
+				model.Table, relation.Fields[0].Column,
+				relation.Referenced().Table, relation.References[0].Column,
+				relation.Label(), style)
+		}
+	}
+	buf.WriteString("}\n")
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+func renderMermaid(buf *bytes.Buffer, root *ir.Root) {
+	buf.WriteString("erDiagram\n")
+	for _, model := range root.Models {
+		fmt.Fprintf(buf, "    %s {\n", model.Table)
+		for _, field := range model.Fields {
+			fmt.Fprintf(buf, "        %s %s", field.Type, field.Column)
+			if markers := model.FieldKeys(field); len(markers) > 0 {
+				fmt.Fprintf(buf, " %s", strings.Join(markers, ", "))
+			}
+			if field.Nullable {
+				buf.WriteString(` "nullable"`)
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString("    }\n")
+	}
+	for _, model := range root.Models {
+		for _, relation := range modelRelations(model) {
+			referenced := "||"
+			if relation.Nullable() {
+				referenced = "o|"
+			}
+			fmt.Fprintf(buf, "    %s }o%s%s %s : %q\n", model.Table,
+				relation.Line(), referenced, relation.Referenced().Table,
+				relation.Label())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+func renderPlantUML(buf *bytes.Buffer, root *ir.Root) {
+	buf.WriteString("@startuml\n")
+	for _, model := range root.Models {
+		fmt.Fprintf(buf, "entity %s {\n", model.Table)
+		for _, field := range model.PrimaryKey {
+			writePlantUMLField(buf, model, field)
+		}
+		if len(model.PrimaryKey) < len(model.Fields) {
+			buf.WriteString("  --\n")
+		}
+		for _, field := range model.Fields {
+			if !model.InPrimaryKey(field) {
+				writePlantUMLField(buf, model, field)
+			}
+		}
+		buf.WriteString("}\n")
+	}
+	for _, model := range root.Models {
+		for _, relation := range modelRelations(model) {
+			referenced := "||"
+			if relation.Nullable() {
+				referenced = "o|"
+			}
+			fmt.Fprintf(buf, "%s }o%s%s %s : %s\n", model.Table,
+				relation.Line(), referenced, relation.Referenced().Table,
+				relation.Label())
+		}
+	}
+	buf.WriteString("@enduml\n")
+}
+
+// writePlantUMLField writes a field of an entity, marking fields that are not
+// nullable with a leading "*".
+func writePlantUMLField(buf *bytes.Buffer, model *ir.Model, field *ir.Field) {
+	buf.WriteString("  ")
+	if !field.Nullable {
+		buf.WriteString("* ")
+	}
+	fmt.Fprintf(buf, "%s : %s", field.Column, field.Type)
+	for _, marker := range model.FieldKeys(field) {
+		fmt.Fprintf(buf, " <<%s>>", marker)
+	}
+	buf.WriteString("\n")
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package docs renders a reference for a dbx schema: its tables, columns,
+// indexes and relations, and every generated method with the SQL it runs.
+package docs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+)
+
+// Formats are the documentation formats Render supports.
+var Formats = []string{"html", "markdown"}
+
+// Dialect is a dialect to document and the explanations of the methods
+// generated for it.
+type Dialect struct {
+	Dialect      sql.Dialect
+	Explanations []*golang.Explanation
+}
+
+// Render writes the reference for root in the given format to w. The first
+// dialect is used for the method signatures, index columns and index
+// conditions. The SQL types and the SQL of the methods are documented for
+// every dialect.
+func Render(w io.Writer, title string, root *ir.Root, dialects []Dialect,
+	format string) error {
+
+	if len(dialects) == 0 {
+		return fmt.Errorf("no dialects to document")
+	}
+	reference := newReference(title, root, dialects)
+	switch format {
+	case "markdown":
+		return markdownTemplate.Execute(w, reference)
+	case "html":
+		return htmlTemplate.Execute(w, reference)
+	default:
+		return fmt.Errorf("unknown docs format %q (expected one of %s)",
+			format, strings.Join(Formats, ", "))
+	}
+}
+
+type reference struct {
+	Title    string
+	Dialects []string
+	Models   []*model
+}
+
+type model struct {
+	Name       string
+	Table      string
+	Doc        string
+	Columns    []*column
+	PrimaryKey string
+	Unique     []string
+	Indexes    []*index
+	Relations  []*relation
+	Methods    []*method
+}
+
+type column struct {
+	Name     string
+	Type     string
+	SQLTypes []string
+	Nullable bool
+	Keys     string
+	Doc      string
+}
+
+type relation struct {
+	Columns    string
+	References string
+	OnDelete   string
+}
+
+type index struct {
+	Name    string
+	Columns string
+	Unique  bool
+	Where   string
+}
+
+type method struct {
+	Name      string
+	Signature string
+	Doc       string
+	Kind      string
+	SQL       []*dialectSQL
+}
+
+type dialectSQL struct {
+	Dialect string
+	Queries []*golang.ExplainedQuery
+}
+
+func newReference(title string, root *ir.Root,
+	dialects []Dialect) *reference {
+
+	ref := &reference{
+		Title: title,
+	}
+	for _, dialect := range dialects {
+		ref.Dialects = append(ref.Dialects, dialect.Dialect.Name())
+	}
+
+	schema := sql.SchemaFromIRModels(root.Models, dialects[0].Dialect)
+	by_model := map[*ir.Model]*model{}
+	for _, ir_model := range root.Models {
+		m := &model{
+			Name:       ir_model.Name,
+			Table:      ir_model.Table,
+			Doc:        ir_model.Doc,
+			PrimaryKey: joinColumns(ir_model.PrimaryKey),
+		}
+		for _, unique := range ir_model.Unique {
+			m.Unique = append(m.Unique, joinColumns(unique))
+		}
+		for _, field := range ir_model.Fields {
+			c := &column{
+				Name:     field.Column,
+				Type:     field.Type.String(),
+				Nullable: field.Nullable,
+				Keys:     strings.Join(ir_model.FieldKeys(field), ", "),
+				Doc:      field.Doc,
+			}
+			for _, dialect := range dialects {
+				c.SQLTypes = append(c.SQLTypes,
+					dialect.Dialect.ColumnType(field))
+			}
+			m.Columns = append(m.Columns, c)
+			if field.Relation != nil {
+				m.Relations = append(m.Relations, newRelation(
+					[]*ir.Field{field}, []*ir.Field{field.Relation.Field},
+					field.Relation.Kind.String()))
+			}
+		}
+		for _, foreign_key := range ir_model.ForeignKeys {
+			m.Relations = append(m.Relations, newRelation(foreign_key.Fields,
+				foreign_key.References, foreign_key.OnDelete.String()))
+		}
+		for _, schema_index := range schema.Indexes {
+			if schema_index.Table != ir_model.Table {
+				continue
+			}
+			m.Indexes = append(m.Indexes, &index{
+				Name:    schema_index.Name,
+				Columns: strings.Join(schema_index.Columns, ", "),
+				Unique:  schema_index.Unique,
+				Where:   strings.Join(schema_index.Where, " AND "),
+			})
+		}
+		by_model[ir_model] = m
+		ref.Models = append(ref.Models, m)
+	}
+
+	for i, explanation := range dialects[0].Explanations {
+		m := by_model[opModel(explanation.Op)]
+		if m == nil {
+			continue
+		}
+		doc_method := &method{
+			Name:      explanation.Name,
+			Signature: explanation.Signature,
+			Doc:       explanation.Doc,
+			Kind:      explanation.Kind,
+		}
+		for _, dialect := range dialects {
+			doc_method.SQL = append(doc_method.SQL, &dialectSQL{
+				Dialect: dialect.Dialect.Name(),
+				Queries: dialect.Explanations[i].Queries,
+			})
+		}
+		m.Methods = append(m.Methods, doc_method)
+	}
+
+	return ref
+}
+
+// opModel returns the model an operation creates, reads from, updates or
+// deletes from.
+func opModel(op interface{}) *ir.Model {
+	switch op := op.(type) {
+	case *ir.Create:
+		return op.Model
+	case *ir.Read:
+		return op.From
+	case *ir.Update:
+		return op.Model
+	case *ir.Delete:
+		return op.Model
+	default:
+		return nil
+	}
+}
+
+func newRelation(fields, references []*ir.Field,
+	on_delete string) *relation {
+
+	return &relation{
+		Columns: joinColumns(fields),
+		References: references[0].Model.Table + "." +
+			joinColumns(references),
+		OnDelete: on_delete,
+	}
+}
+
+// keys returns PK, UK and FK for the fields that are part of the primary
+// key, a unique constraint or a foreign key.
+func joinColumns(fields []*ir.Field) string {
+	var columns []string
+	for _, field := range fields {
+		columns = append(columns, field.Column)
+	}
+	if len(columns) == 1 {
+		return columns[0]
+	}
+	return "(" + strings.Join(columns, ", ") + ")"
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+	"gopkg.in/spacemonkeygo/dbx.v1/templates"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/tmplutil"
+)
+
+func TestRender(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	ast_root, err := syntax.Parse("docs.dbx", []byte(`
+// A user of the site.
+model user (
+	key pk
+	// The surrogate key.
+	field pk   serial64
+	field nick text ( nullable )
+)
+
+model post (
+	key pk
+	index ( fields slug, unique )
+	field pk     serial64
+	field slug   text
+	field author user.pk cascade
+)
+
+// Lists users by nick.
+read all (
+	select user
+	where user.nick = ?
+)
+`))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+
+	renderer, err := golang.New(tmplutil.BinLoader(templates.Asset),
+		&golang.Options{})
+	tw.AssertNoError(err)
+
+	var dialects []Dialect
+	for _, dialect := range []sql.Dialect{sql.Postgres(), sql.SQLite3()} {
+		explanations, err := renderer.Explain(root, dialect)
+		tw.AssertNoError(err)
+		dialects = append(dialects, Dialect{
+			Dialect:      dialect,
+			Explanations: explanations,
+		})
+	}
+
+	var markdown bytes.Buffer
+	tw.AssertNoError(Render(&markdown, "docs", root, dialects, "markdown"))
+	for _, fragment := range []string{
+		"## user\n\nA user of the site.\n",
+		"| `pk` | serial64 | bigserial | INTEGER |  | PK | " +
+			"The surrogate key. |\n",
+		"| `slug` | text | text | TEXT |  | UK |  |\n",
+		"| `author` | int64 | bigint | INTEGER |  | FK |  |\n",
+		"- `author` references `users.pk` on delete cascade\n",
+		"### All_User_By_Nick\n\nLists users by nick.\n",
+		"-- when users.nick is null\n",
+		"FROM users WHERE users.nick = ?;\n",
+	} {
+		tw.AssertContains(markdown.String(), fragment)
+	}
+
+	var html bytes.Buffer
+	tw.AssertNoError(Render(&html, "docs", root, dialects, "html"))
+	for _, fragment := range []string{
+		`<h2 id="user">user</h2>`,
+		`<td class="doc">The surrogate key.</td>`,
+		`<h3 id="all_user_by_nick">All_User_By_Nick</h3>`,
+	} {
+		tw.AssertContains(html.String(), fragment)
+	}
+
+	tw.AssertError(Render(new(bytes.Buffer), "docs", root, dialects, "pdf"),
+		"unknown docs format")
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"html/template"
+	"strings"
+)
+
+var htmlTemplate = template.Must(template.New("html").Funcs(
+	template.FuncMap{
+		"anchor": strings.ToLower,
+	}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 60em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; text-align: left; }
+pre { background: #f6f6f6; padding: 0.5em; overflow-x: auto; }
+.doc { white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+<p>Dialects: {{ range $i, $d := .Dialects }}{{ if $i }}, {{ end }}{{ $d }}{{ end }}</p>
+<ul>
+{{- range .Models }}
+<li><a href="#{{ anchor .Name }}">{{ .Name }}</a></li>
+{{- end }}
+</ul>
+{{ range .Models }}
+<h2 id="{{ anchor .Name }}">{{ .Name }}</h2>
+{{- with .Doc }}
+<p class="doc">{{ . }}</p>
+{{- end }}
+<p>Table <code>{{ .Table }}</code></p>
+<table>
+<tr><th>Column</th><th>Type</th>{{ range $.Dialects }}<th>{{ . }}</th>{{ end }}<th>Nullable</th><th>Key</th><th>Description</th></tr>
+{{- range .Columns }}
+<tr><td><code>{{ .Name }}</code></td><td>{{ .Type }}</td>{{ range .SQLTypes }}<td>{{ . }}</td>{{ end }}<td>{{ if .Nullable }}yes{{ end }}</td><td>{{ .Keys }}</td><td class="doc">{{ .Doc }}</td></tr>
+{{- end }}
+</table>
+<p>Primary key: <code>{{ .PrimaryKey }}</code></p>
+{{- with .Unique }}
+<p>Unique:</p>
+<ul>
+{{- range . }}
+<li><code>{{ . }}</code></li>
+{{- end }}
+</ul>
+{{- end }}
+{{- with .Indexes }}
+<p>Indexes:</p>
+<ul>
+{{- range . }}
+<li><code>{{ .Name }}</code> on <code>{{ .Columns }}</code>{{ if .Unique }}, unique{{ end }}{{ with .Where }} where <code>{{ . }}</code>{{ end }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- with .Relations }}
+<p>Relations:</p>
+<ul>
+{{- range . }}
+<li><code>{{ .Columns }}</code> references <code>{{ .References }}</code> on delete {{ .OnDelete }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- range .Methods }}
+<h3 id="{{ anchor .Name }}">{{ .Name }}</h3>
+{{- with .Doc }}
+<p class="doc">{{ . }}</p>
+{{- end }}
+<pre><code>{{ .Signature }}</code></pre>
+{{- range .SQL }}
+<p>{{ .Dialect }}:</p>
+<pre><code>
+{{- range $i, $q := .Queries }}{{ if $i }}
+
+{{ end }}{{ with $q.Conditions }}-- when {{ range $j, $c := . }}{{ if $j }} and {{ end }}{{ $c }}{{ end }}
+{{ end }}{{ $q.SQL }}{{ end -}}
+</code></pre>
+{{- end }}
+{{- end }}
+{{ end -}}
+</body>
+</html>
+`))
@@ -0,0 +1,92 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"strings"
+	"text/template"
+)
+
+var markdownTemplate = template.Must(template.New("markdown").Funcs(
+	template.FuncMap{
+		"anchor": strings.ToLower,
+		"cell":   markdownCell,
+	}).Parse(`# {{ .Title }}
+
+Dialects: {{ range $i, $d := .Dialects }}{{ if $i }}, {{ end }}{{ $d }}{{ end }}
+
+{{ range .Models -}}
+- [{{ .Name }}](#{{ anchor .Name }})
+{{ end -}}
+
+{{ range .Models }}
+## {{ .Name }}
+{{ with .Doc }}
+{{ . }}
+{{ end }}
+Table ` + "`{{ .Table }}`" + `
+
+| Column | Type |{{ range $.Dialects }} {{ . }} |{{ end }} Nullable | Key | Description |
+| --- | --- |{{ range $.Dialects }} --- |{{ end }} --- | --- | --- |
+{{ range .Columns -}}
+| ` + "`{{ .Name }}`" + ` | {{ .Type }} |{{ range .SQLTypes }} {{ . }} |{{ end }} {{ if .Nullable }}yes{{ end }} | {{ .Keys }} | {{ cell .Doc }} |
+{{ end }}
+Primary key: ` + "`{{ .PrimaryKey }}`" + `
+{{ with .Unique }}
+Unique:
+{{ range . }}
+- ` + "`{{ . }}`" + `
+{{- end }}
+{{ end -}}
+{{ with .Indexes }}
+Indexes:
+{{ range . }}
+- ` + "`{{ .Name }}`" + ` on ` + "`{{ .Columns }}`" + `{{ if .Unique }}, unique{{ end }}{{ with .Where }} where ` + "`{{ . }}`" + `{{ end }}
+{{- end }}
+{{ end -}}
+{{ with .Relations }}
+Relations:
+{{ range . }}
+- ` + "`{{ .Columns }}`" + ` references ` + "`{{ .References }}`" + ` on delete {{ .OnDelete }}
+{{- end }}
+{{ end -}}
+{{ range .Methods }}
+### {{ .Name }}
+{{ with .Doc }}
+{{ . }}
+{{ end }}
+` + "```go" + `
+{{ .Signature }}
+` + "```" + `
+{{ range .SQL }}
+{{ .Dialect }}:
+
+` + "```sql" + `
+{{ range $i, $q := .Queries }}{{ if $i }}
+{{ end }}{{ with $q.Conditions }}-- when {{ range $j, $c := . }}{{ if $j }} and {{ end }}{{ $c }}{{ end }}
+{{ end }}{{ $q.SQL }}
+{{ end -}}
+` + "```" + `
+{{ end -}}
+{{ end -}}
+{{ end -}}
+`))
+
+// markdownCell returns s for use in a table cell: on one line and with pipes
+// escaped.
+func markdownCell(s string) string {
+	s = strings.Replace(s, "|", "\\|", -1)
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errutil
+
+import (
+	"sort"
+	"strings"
+)
+
+// Diagnostics collects the errors from checking independent parts of a dbx
+// file so that they can all be reported at once instead of one per run.
+type Diagnostics struct {
+	errs []error
+}
+
+// Add collects the errors in err, if any.
+func (d *Diagnostics) Add(err error) {
+	if err != nil {
+		d.errs = append(d.errs, Errors(err)...)
+	}
+}
+
+func (d *Diagnostics) Len() int {
+	return len(d.errs)
+}
+
+// Err returns nil if nothing was collected, the error if only one was and an
+// ErrorList sorted by position otherwise.
+func (d *Diagnostics) Err() error {
+	switch len(d.errs) {
+	case 0:
+		return nil
+	case 1:
+		return d.errs[0]
+	}
+
+	list := append(ErrorList(nil), d.errs...)
+	sort.Stable(byPosition(list))
+	return list
+}
+
+// ErrorList is a list of errors returned by Diagnostics.
+type ErrorList []error
+
+func (l ErrorList) Error() string {
+	messages := make([]string, 0, len(l))
+	for _, err := range l {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Errors returns the errors in an ErrorList or just err otherwise.
+func Errors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if list, ok := err.(ErrorList); ok {
+		return list
+	}
+	return []error{err}
+}
+
+// byPosition sorts errors by their file and offset with any errors without
+// a position last.
+type byPosition []error
+
+func (by byPosition) Len() int {
+	return len(by)
+}
+
+func (by byPosition) Swap(a, b int) {
+	by[a], by[b] = by[b], by[a]
+}
+
+func (by byPosition) Less(a, b int) bool {
+	apos, bpos := GetErrorPosition(by[a]), GetErrorPosition(by[b])
+	switch {
+	case apos == nil:
+		return false
+	case bpos == nil:
+		return true
+	case apos.Filename != bpos.Filename:
+		return apos.Filename < bpos.Filename
+	default:
+		return apos.Offset < bpos.Offset
+	}
+}
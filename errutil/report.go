@@ -0,0 +1,216 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/scanner"
+
+	"github.com/spacemonkeygo/errors"
+)
+
+var (
+	errorFixes    = errors.GenSym()
+	errorSeverity = errors.GenSym()
+)
+
+// NewWarning is like New but the error is reported with a warning severity.
+func NewWarning(pos scanner.Position, format string,
+	args ...interface{}) error {
+
+	str := fmt.Sprintf("%s: %s", pos, fmt.Sprintf(format, args...))
+	return Error.NewWith(str, SetErrorPosition(pos),
+		errors.SetData(errorSeverity, "warning"))
+}
+
+// GetSeverity returns "warning" for errors from NewWarning and "error" for
+// everything else.
+func GetSeverity(err error) string {
+	if severity, ok := errors.GetData(err, errorSeverity).(string); ok {
+		return severity
+	}
+	return "error"
+}
+
+// Fix is a suggested edit that resolves an error: the Length bytes at Pos are
+// replaced with Replacement.
+type Fix struct {
+	Description string
+	Pos         scanner.Position
+	Length      int
+	Replacement string
+}
+
+// SetFixes attaches suggested fixes to an error.
+func SetFixes(fixes ...Fix) errors.ErrorOption {
+	return errors.SetData(errorFixes, fixes)
+}
+
+func GetFixes(err error) []Fix {
+	fixes, _ := errors.GetData(err, errorFixes).([]Fix)
+	return fixes
+}
+
+// Diagnostic is the machine readable form of an error.
+type Diagnostic struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Fixes    []Fix  `json:"fixes,omitempty"`
+}
+
+// DiagnosticsFromError returns a Diagnostic for every error in err.
+func DiagnosticsFromError(err error) (diagnostics []Diagnostic) {
+	for _, err := range Errors(err) {
+		message := strings.TrimPrefix(errors.GetMessage(err), "dbx: ")
+		diagnostic := Diagnostic{
+			Severity: GetSeverity(err),
+			Message:  message,
+			Fixes:    GetFixes(err),
+		}
+		if pos := GetErrorPosition(err); pos != nil {
+			diagnostic.File = pos.Filename
+			diagnostic.Line = pos.Line
+			diagnostic.Column = pos.Column
+			diagnostic.Message = strings.TrimPrefix(message,
+				pos.String()+": ")
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+	return diagnostics
+}
+
+func (f Fix) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Description string `json:"description"`
+		Line        int    `json:"line"`
+		Column      int    `json:"column"`
+		Length      int    `json:"length"`
+		Replacement string `json:"replacement"`
+	}{
+		Description: f.Description,
+		Line:        f.Pos.Line,
+		Column:      f.Pos.Column,
+		Length:      f.Length,
+		Replacement: f.Replacement,
+	})
+}
+
+// CheckDiagnosticsFormat returns an error if format is not one that
+// WriteDiagnostics accepts.
+func CheckDiagnosticsFormat(format string) error {
+	switch format {
+	case "json", "sarif":
+		return nil
+	default:
+		return Error.New("unknown diagnostics format %q; expected json or "+
+			"sarif", format)
+	}
+}
+
+// WriteDiagnostics writes the diagnostics to w in the given format, which is
+// either "json" or "sarif".
+func WriteDiagnostics(w io.Writer, format string,
+	diagnostics []Diagnostic) error {
+
+	if err := CheckDiagnosticsFormat(format); err != nil {
+		return err
+	}
+
+	var out interface{}
+	switch format {
+	case "json":
+		if diagnostics == nil {
+			diagnostics = []Diagnostic{}
+		}
+		out = diagnostics
+	case "sarif":
+		out = sarifLog(diagnostics)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// the subset of SARIF 2.1.0 needed to report diagnostics.
+
+type sarifObject map[string]interface{}
+
+func sarifLog(diagnostics []Diagnostic) sarifObject {
+	results := []sarifObject{}
+	for _, diagnostic := range diagnostics {
+		result := sarifObject{
+			"ruleId":  "dbx",
+			"level":   diagnostic.Severity,
+			"message": sarifObject{"text": diagnostic.Message},
+		}
+		if diagnostic.File != "" || diagnostic.Line > 0 {
+			result["locations"] = []sarifObject{{
+				"physicalLocation": sarifObject{
+					"artifactLocation": sarifObject{"uri": diagnostic.File},
+					"region": sarifObject{
+						"startLine":   diagnostic.Line,
+						"startColumn": diagnostic.Column,
+					},
+				},
+			}}
+		}
+		if len(diagnostic.Fixes) > 0 {
+			var fixes []sarifObject
+			for _, fix := range diagnostic.Fixes {
+				fixes = append(fixes, sarifFix(diagnostic.File, fix))
+			}
+			result["fixes"] = fixes
+		}
+		results = append(results, result)
+	}
+
+	return sarifObject{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []sarifObject{{
+			"tool": sarifObject{
+				"driver": sarifObject{
+					"name":           "dbx",
+					"informationUri": "https://github.com/spacemonkeygo/dbx",
+				},
+			},
+			"results": results,
+		}},
+	}
+}
+
+func sarifFix(file string, fix Fix) sarifObject {
+	return sarifObject{
+		"description": sarifObject{"text": fix.Description},
+		"artifactChanges": []sarifObject{{
+			"artifactLocation": sarifObject{"uri": file},
+			"replacements": []sarifObject{{
+				"deletedRegion": sarifObject{
+					"startLine":   fix.Pos.Line,
+					"startColumn": fix.Pos.Column,
+					"endColumn":   fix.Pos.Column + fix.Length,
+				},
+				"insertedContent": sarifObject{"text": fix.Replacement},
+			}},
+		}},
+	}
+}
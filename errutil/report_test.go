@@ -0,0 +1,148 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"text/scanner"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+)
+
+func TestDiagnosticsJSON(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	var diagnostics Diagnostics
+	diagnostics.Add(New(scanner.Position{
+		Filename: "b.dbx", Offset: 10, Line: 2, Column: 3,
+	}, "second"))
+	diagnostics.Add(New(scanner.Position{
+		Filename: "b.dbx", Offset: 1, Line: 1, Column: 2,
+	}, "first"))
+
+	var buf bytes.Buffer
+	tw.AssertNoError(WriteDiagnostics(&buf, "json",
+		DiagnosticsFromError(diagnostics.Err())))
+
+	var out []Diagnostic
+	tw.AssertNoError(json.Unmarshal(buf.Bytes(), &out))
+	if len(out) != 2 {
+		tw.Fatalf("expected 2 diagnostics, got %d", len(out))
+	}
+	first := out[0]
+	if first.File != "b.dbx" || first.Line != 1 || first.Column != 2 ||
+		first.Severity != "error" || first.Message != "first" {
+		tw.Fatalf("unexpected diagnostic: %+v", out[0])
+	}
+}
+
+func TestDiagnosticsSARIF(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	pos := scanner.Position{Filename: "a.dbx", Offset: 5, Line: 3, Column: 7}
+	err := Error.NewWith("a.dbx:3:7: unknown thing", SetErrorPosition(pos),
+		SetFixes(Fix{
+			Description: "replace with thing",
+			Pos:         pos,
+			Length:      5,
+			Replacement: "thing",
+		}))
+
+	var buf bytes.Buffer
+	tw.AssertNoError(WriteDiagnostics(&buf, "sarif",
+		DiagnosticsFromError(err)))
+
+	type region struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+		EndColumn   int `json:"endColumn"`
+	}
+	type artifactLocation struct {
+		URI string `json:"uri"`
+	}
+	type message struct {
+		Text string `json:"text"`
+	}
+	var out struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				Level     string  `json:"level"`
+				Message   message `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation artifactLocation `json:"artifactLocation"`
+						Region           region           `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+				Fixes []struct {
+					Description     message `json:"description"`
+					ArtifactChanges []struct {
+						ArtifactLocation artifactLocation `json:"artifactLocation"`
+						Replacements     []struct {
+							DeletedRegion   region  `json:"deletedRegion"`
+							InsertedContent message `json:"insertedContent"`
+						} `json:"replacements"`
+					} `json:"artifactChanges"`
+				} `json:"fixes"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	tw.AssertNoError(json.Unmarshal(buf.Bytes(), &out))
+
+	if out.Version != "2.1.0" || len(out.Runs) != 1 ||
+		len(out.Runs[0].Results) != 1 {
+		tw.Fatalf("unexpected log: %s", buf.String())
+	}
+	result := out.Runs[0].Results[0]
+	if result.Level != "error" || result.Message.Text != "unknown thing" ||
+		len(result.Locations) != 1 || len(result.Fixes) != 1 {
+		tw.Fatalf("unexpected result: %s", buf.String())
+	}
+	location := result.Locations[0].PhysicalLocation
+	if location.ArtifactLocation.URI != "a.dbx" ||
+		location.Region.StartLine != 3 || location.Region.StartColumn != 7 {
+		tw.Fatalf("unexpected location: %+v", location)
+	}
+	fix := result.Fixes[0]
+	if fix.Description.Text != "replace with thing" ||
+		len(fix.ArtifactChanges) != 1 ||
+		len(fix.ArtifactChanges[0].Replacements) != 1 {
+		tw.Fatalf("unexpected fix: %s", buf.String())
+	}
+	replacement := fix.ArtifactChanges[0].Replacements[0]
+	if replacement.DeletedRegion != (region{3, 7, 12}) ||
+		replacement.InsertedContent.Text != "thing" {
+		tw.Fatalf("unexpected replacement: %+v", replacement)
+	}
+}
+
+func TestDiagnosticsSARIFEmpty(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	var buf bytes.Buffer
+	tw.AssertNoError(WriteDiagnostics(&buf, "sarif", nil))
+	tw.AssertContains(buf.String(), `"results": []`)
+
+	tw.AssertError(CheckDiagnosticsFormat("bogus"),
+		`unknown diagnostics format "bogus"`)
+	tw.AssertError(WriteDiagnostics(&buf, "bogus", nil),
+		`unknown diagnostics format "bogus"`)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errutil
+
+import (
+	"fmt"
+	"text/scanner"
+)
+
+// NewSuggest is like New but if name, found at name_pos, looks like a typo of
+// one of the candidates the error asks if that was meant and carries a Fix
+// to replace it.
+func NewSuggest(pos, name_pos scanner.Position, name string,
+	candidates []string, format string, args ...interface{}) error {
+
+	message := fmt.Sprintf(format, args...)
+	suggestion := Suggest(name, candidates)
+	if suggestion == "" {
+		return New(pos, "%s", message)
+	}
+
+	str := fmt.Sprintf("%s: %s; did you mean %q?", pos, message, suggestion)
+	return Error.NewWith(str, SetErrorPosition(pos), SetFixes(Fix{
+		Description: fmt.Sprintf("replace %q with %q", name, suggestion),
+		Pos:         name_pos,
+		Length:      len(name),
+		Replacement: suggestion,
+	}))
+}
+
+// Suggest returns the candidate closest to name by edit distance if it is
+// close enough to be a likely typo, and the empty string otherwise. Ties go
+// to the earliest candidate.
+func Suggest(name string, candidates []string) (suggestion string) {
+	best := len(name)/3 + 1
+	if best > len(name)-1 {
+		best = len(name) - 1
+	}
+	for _, candidate := range candidates {
+		if candidate == name {
+			continue
+		}
+		if distance := editDistance(name, candidate); distance <= best {
+			if suggestion == "" || distance < editDistance(name, suggestion) {
+				suggestion = candidate
+			}
+		}
+	}
+	return suggestion
+}
+
+// editDistance returns the Levenshtein distance between a and b, counting
+// transposing two adjacent characters as a single edit.
+func editDistance(a, b string) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = minInt(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+func minInt(first int, rest ...int) int {
+	for _, value := range rest {
+		if value < first {
+			first = value
+		}
+	}
+	return first
+}
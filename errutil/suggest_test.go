@@ -0,0 +1,42 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errutil
+
+import (
+	"testing"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+)
+
+func TestSuggest(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	candidates := []string{"id", "name", "created_at", "pk", "serial64"}
+	for name, expected := range map[string]string{
+		"pf":         "pk",
+		"nmae":       "name",
+		"created":    "created_at",
+		"craeted_at": "created_at",
+		"srial64":    "serial64",
+		"x":          "",
+		"id":         "",
+		"unrelated":  "",
+	} {
+		if got := Suggest(name, candidates); got != expected {
+			tw.Errorf("Suggest(%q): expected %q, got %q", name, expected, got)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package introspect
+
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+)
+
+// Compare lists the differences between the schema expected for the dialect
+// and the schema found in the database, the same way the CheckSchema method
+// of the generated code does.
+func Compare(expected *sql.Schema, dialect sql.Dialect, actual *Schema) (
+	differences []SchemaDifference) {
+
+	return compareSchema(expectedTables(expected, dialect),
+		catalogFromSchema(actual))
+}
+
+func expectedTables(schema *sql.Schema, dialect sql.Dialect) (
+	tables []schemaTable) {
+
+	for _, table := range SchemaTablesFromSQL(schema, dialect) {
+		expected := schemaTable{
+			name:    table.Name,
+			indexes: table.Indexes,
+		}
+		for _, column := range table.Columns {
+			expected.columns = append(expected.columns, schemaColumn{
+				name:     column.Name,
+				typ:      column.Type,
+				nullable: column.Nullable,
+			})
+		}
+		tables = append(tables, expected)
+	}
+	return tables
+}
+
+func catalogFromSchema(schema *Schema) *schemaCatalog {
+	catalog := newSchemaCatalog()
+	for _, table := range schema.Tables {
+		for _, column := range table.Columns {
+			catalog.addColumn(table.Name, schemaColumn{
+				name:     column.Name,
+				typ:      column.DBType,
+				nullable: column.Nullable,
+			})
+		}
+		for _, index := range table.Indexes {
+			catalog.addIndex(table.Name, index.Name)
+		}
+	}
+	return catalog
+}
+
+// SchemaTable is a table as the database catalog is expected to report it,
+// used to check the schema of a connected database.
+type SchemaTable struct {
+	Name    string
+	Columns []SchemaColumn
+	Indexes []string
+}
+
+type SchemaColumn struct {
+	Name     string
+	Type     string
+	Nullable bool
+}
+
+// SchemaTablesFromSQL returns the tables the database catalog is expected to
+// report for the schema.
+func SchemaTablesFromSQL(schema *sql.Schema, dialect sql.Dialect) (
+	tables []SchemaTable) {
+
+	by_name := map[string]int{}
+	for _, sql_table := range schema.Tables {
+		table := SchemaTable{
+			Name: sql_table.Name,
+		}
+		for _, sql_column := range sql_table.Columns {
+			table.Columns = append(table.Columns, SchemaColumn{
+				Name:     sql_column.Name,
+				Type:     dialect.CatalogType(sql_column.Type),
+				Nullable: !sql_column.NotNull,
+			})
+		}
+		by_name[table.Name] = len(tables)
+		tables = append(tables, table)
+	}
+	for _, sql_index := range schema.Indexes {
+		table := &tables[by_name[sql_index.Table]]
+		table.Indexes = append(table.Indexes, sql_index.Name)
+	}
+	return tables
+}
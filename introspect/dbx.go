@@ -0,0 +1,178 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package introspect
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"bitbucket.org/pkg/inflect"
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+)
+
+// DBX renders the schema as formatted dbx models. It fails listing every
+// column whose type could not be mapped to a field type and every partial or
+// expression index, since dropping them could lose an invariant like a
+// partial unique index.
+func DBX(schema *Schema) (data []byte, err error) {
+	var unmapped, indexes []string
+	for _, table := range schema.Tables {
+		for _, column := range table.Columns {
+			if !column.Mapped {
+				unmapped = append(unmapped, fmt.Sprintf("%s.%s (%s)",
+					table.Name, column.Name, column.DBType))
+			}
+		}
+		for _, index := range table.Indexes {
+			if kind := indexKind(index); kind != "" {
+				indexes = append(indexes, fmt.Sprintf("%s.%s (%s)",
+					table.Name, index.Name, kind))
+			}
+		}
+	}
+	var problems []string
+	if len(unmapped) > 0 {
+		problems = append(problems, "unable to map column types: "+
+			strings.Join(unmapped, ", "))
+	}
+	if len(indexes) > 0 {
+		problems = append(problems, "unable to import indexes: "+
+			strings.Join(indexes, ", "))
+	}
+	if len(problems) > 0 {
+		return nil, errutil.Error.New("%s", strings.Join(problems, "; "))
+	}
+
+	var buf bytes.Buffer
+	for i, table := range schema.Tables {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		if err := writeModel(&buf, schema, table); err != nil {
+			return nil, err
+		}
+	}
+
+	return syntax.Format("", buf.Bytes())
+}
+
+// indexKind describes why an index can't be written from its column names
+// alone, or returns "" if it can.
+func indexKind(index *Index) string {
+	var kinds []string
+	if index.Unique {
+		kinds = append(kinds, "unique")
+	}
+	if index.Partial {
+		kinds = append(kinds, "partial")
+	}
+	if index.Expression {
+		kinds = append(kinds, "expression")
+	}
+	if !index.Partial && !index.Expression {
+		return ""
+	}
+	return strings.Join(kinds, " ")
+}
+
+func modelName(table string) string {
+	return inflect.Singularize(table)
+}
+
+func writeModel(buf *bytes.Buffer, schema *Schema, table *Table) error {
+	if len(table.PrimaryKey) == 0 {
+		return errutil.Error.New("table %q has no primary key", table.Name)
+	}
+
+	name := modelName(table.Name)
+	fmt.Fprintf(buf, "model %s (\n", name)
+	if inflect.Pluralize(name) != table.Name {
+		fmt.Fprintf(buf, "\ttable %s\n", table.Name)
+	}
+	fmt.Fprintf(buf, "\tkey %s\n", strings.Join(table.PrimaryKey, " "))
+	for _, unique := range table.Unique {
+		fmt.Fprintf(buf, "\tunique %s\n", strings.Join(unique, " "))
+	}
+	for _, index := range table.Indexes {
+		fmt.Fprintf(buf, "\tindex (\n\t\tname %s\n\t\tfields %s\n",
+			index.Name, strings.Join(index.Columns, " "))
+		if index.Unique {
+			buf.WriteString("\t\tunique\n")
+		}
+		buf.WriteString("\t)\n")
+	}
+	buf.WriteString("\n")
+
+	// single column foreign keys become relation fields and the rest are
+	// declared after the fields.
+	relations := map[string]*ForeignKey{}
+	var foreign_keys []*ForeignKey
+	for _, foreign_key := range table.ForeignKeys {
+		column := foreign_key.Columns[0]
+		if len(foreign_key.Columns) == 1 && relations[column] == nil {
+			relations[column] = foreign_key
+			continue
+		}
+		foreign_keys = append(foreign_keys, foreign_key)
+	}
+
+	for _, column := range table.Columns {
+		var attributes []string
+		if column.Nullable {
+			attributes = append(attributes, "nullable")
+		}
+		if relation := relations[column.Name]; relation != nil {
+			fmt.Fprintf(buf, "\tfield %s %s.%s %s", column.Name,
+				modelName(relation.Table), relation.References[0],
+				relation.OnDelete.String())
+			if relation.OnUpdate != consts.Restrict {
+				attributes = append(attributes, "onupdate "+
+					relation.OnUpdate.String())
+			}
+		} else {
+			fmt.Fprintf(buf, "\tfield %s %s", column.Name, column.Type)
+			if column.Length > 0 {
+				attributes = append(attributes,
+					fmt.Sprintf("length %d", column.Length))
+			}
+		}
+		if len(attributes) > 0 {
+			fmt.Fprintf(buf, " ( %s )", strings.Join(attributes, ", "))
+		}
+		buf.WriteString("\n")
+	}
+
+	for _, foreign_key := range foreign_keys {
+		fmt.Fprintf(buf, "\n\tforeignkey ( %s ) references %s ( %s )",
+			strings.Join(foreign_key.Columns, ", "),
+			modelName(foreign_key.Table),
+			strings.Join(foreign_key.References, ", "))
+		if foreign_key.OnDelete != consts.Restrict {
+			fmt.Fprintf(buf, " ondelete %s",
+				foreign_key.OnDelete.String())
+		}
+		if foreign_key.OnUpdate != consts.Restrict {
+			fmt.Fprintf(buf, " onupdate %s",
+				foreign_key.OnUpdate.String())
+		}
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString(")\n")
+	return nil
+}
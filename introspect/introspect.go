@@ -0,0 +1,111 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package introspect reads the catalog of a live database and describes it
+// as dbx models.
+package introspect
+
+import (
+	"database/sql"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
+
+// Schema is the set of tables found in a database.
+type Schema struct {
+	Tables []*Table
+}
+
+func (s *Schema) Table(name string) *Table {
+	for _, table := range s.Tables {
+		if table.Name == name {
+			return table
+		}
+	}
+	return nil
+}
+
+type Table struct {
+	Name        string
+	Columns     []*Column
+	PrimaryKey  []string
+	Unique      [][]string
+	Indexes     []*Index
+	ForeignKeys []*ForeignKey
+}
+
+func (t *Table) Column(name string) *Column {
+	for _, column := range t.Columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+// Column is a table column. DBType is the type reported by the database and
+// Mapped is false when it has no matching field type.
+type Column struct {
+	Name     string
+	DBType   string
+	Type     consts.FieldType
+	Mapped   bool
+	Length   int
+	Nullable bool
+}
+
+// Index is an index that does not back a constraint. Partial indexes and
+// indexes over expressions are flagged because their columns alone do not
+// describe them, and DBX refuses to write them.
+type Index struct {
+	Name       string
+	Columns    []string
+	Unique     bool
+	Partial    bool
+	Expression bool
+}
+
+type ForeignKey struct {
+	Columns    []string
+	Table      string
+	References []string
+	OnDelete   consts.RelationKind
+	OnUpdate   consts.RelationKind
+}
+
+// Introspect reads the schema of the database using the named dialect.
+func Introspect(db *sql.DB, dialect string) (*Schema, error) {
+	switch dialect {
+	case "postgres":
+		return introspectPostgres(db)
+	case "sqlite3":
+		return introspectSQLite3(db)
+	default:
+		return nil, errutil.Error.New("unknown dialect %q", dialect)
+	}
+}
+
+// relationKind maps a referential action like "SET NULL" to the closest
+// relation kind.
+func relationKind(action string) consts.RelationKind {
+	switch action {
+	case "CASCADE":
+		return consts.Cascade
+	case "SET NULL":
+		return consts.SetNull
+	default:
+		return consts.Restrict
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package introspect
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	dbxsql "gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+)
+
+const roundTripDBX = `
+model tenant (
+	key    pk
+	unique name
+
+	field pk   serial64
+	field name text
+)
+
+model project (
+	key    pk
+	unique tenant_pk name
+	index (
+		name projects_created_index
+		fields created
+	)
+
+	field pk        serial64
+	field tenant_pk tenant.pk cascade
+	field name      text
+	field created   timestamp
+	field budget    float64   ( nullable )
+)
+
+model task (
+	key   pk
+
+	field pk           serial64
+	field tenant_pk    int64
+	field project_name text
+	field owner_pk     tenant.pk setnull ( nullable, onupdate cascade )
+	field data         blob
+
+	foreignkey ( tenant_pk, project_name ) references project ( tenant_pk, name ) ondelete cascade
+)
+`
+
+func schemaSQL(tw *testutil.T, source string) string {
+	ast_root, err := syntax.Parse("", []byte(source))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+	dialect := dbxsql.SQLite3()
+	return sqlgen.Render(dialect, dbxsql.SchemaSQL(root, dialect),
+		sqlgen.NoTerminate, sqlgen.NoFlatten)
+}
+
+func TestSQLite3RoundTrip(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	tw.AssertNoError(err)
+	defer db.Close()
+
+	expected := schemaSQL(tw, roundTripDBX)
+	_, err = db.Exec(expected)
+	tw.AssertNoError(err)
+
+	schema, err := Introspect(db, "sqlite3")
+	tw.AssertNoError(err)
+
+	imported, err := DBX(schema)
+	tw.AssertNoError(err)
+	tw.Context("imported", string(imported))
+
+	got := schemaSQL(tw, string(imported))
+	if got != expected {
+		tw.Context("expected", expected)
+		tw.Context("got", got)
+		tw.Fatal("schema did not round trip")
+	}
+}
+
+func TestSQLite3Unmapped(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	tw.AssertNoError(err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE shapes (
+		pk INTEGER NOT NULL,
+		area POLYGON NOT NULL,
+		PRIMARY KEY ( pk )
+	)`)
+	tw.AssertNoError(err)
+
+	schema, err := Introspect(db, "sqlite3")
+	tw.AssertNoError(err)
+
+	_, err = DBX(schema)
+	tw.AssertError(err, "shapes.area (POLYGON)")
+}
+
+func TestSQLite3PartialIndex(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	tw.AssertNoError(err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE users (
+		pk INTEGER NOT NULL,
+		email TEXT NOT NULL,
+		deleted INTEGER NOT NULL,
+		PRIMARY KEY ( pk )
+	);
+	CREATE UNIQUE INDEX users_email ON users ( email ) WHERE deleted = 0;
+	CREATE INDEX users_lower_email ON users ( lower(email) );`)
+	tw.AssertNoError(err)
+
+	schema, err := Introspect(db, "sqlite3")
+	tw.AssertNoError(err)
+
+	_, err = DBX(schema)
+	tw.AssertError(err, "users.users_email (unique partial)")
+	tw.AssertError(err, "users.users_lower_email (expression)")
+}
+
+func TestSQLite3Compare(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	tw.AssertNoError(err)
+	defer db.Close()
+
+	ast_root, err := syntax.Parse("", []byte(roundTripDBX))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+	dialect := dbxsql.SQLite3()
+	expected := dbxsql.SchemaFromIRModels(root.Models, dialect)
+
+	_, err = db.Exec(sqlgen.Render(dialect, dbxsql.SQLFromSchema(expected),
+		sqlgen.NoTerminate, sqlgen.NoFlatten))
+	tw.AssertNoError(err)
+
+	schema, err := Introspect(db, "sqlite3")
+	tw.AssertNoError(err)
+	if differences := Compare(expected, dialect, schema); len(differences) > 0 {
+		tw.Fatalf("expected no differences. got %v", differences)
+	}
+
+	_, err = db.Exec(`DROP INDEX projects_created_index;
+		DROP TABLE tasks;`)
+	tw.AssertNoError(err)
+
+	schema, err = Introspect(db, "sqlite3")
+	tw.AssertNoError(err)
+	differences := Compare(expected, dialect, schema)
+	kinds := map[SchemaDifferenceKind]int{}
+	for _, difference := range differences {
+		kinds[difference.Kind]++
+	}
+	if len(differences) != 2 ||
+		kinds[SchemaMissingTable] != 1 ||
+		kinds[SchemaMissingIndex] != 1 {
+		tw.Fatalf("unexpected differences: %v", differences)
+	}
+}
+
+func TestSchemaDiffSource(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	source, err := ioutil.ReadFile("schemadiff.go")
+	tw.AssertNoError(err)
+	if !strings.Contains(string(source), SchemaDiffSource) {
+		tw.Fatalf("schemadiff_source.go is stale: run go generate")
+	}
+}
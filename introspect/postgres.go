@@ -0,0 +1,243 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package introspect
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
+
+func introspectPostgres(db *sql.DB) (schema *Schema, err error) {
+	names, err := queryStrings(db, `SELECT table_name
+		FROM information_schema.tables
+		WHERE table_schema = current_schema()
+			AND table_type = 'BASE TABLE'
+		ORDER BY table_name`)
+	if err != nil {
+		return nil, err
+	}
+
+	schema = new(Schema)
+	for _, name := range names {
+		table := &Table{Name: name}
+		if err := postgresColumns(db, table); err != nil {
+			return nil, err
+		}
+		if err := postgresConstraints(db, table); err != nil {
+			return nil, err
+		}
+		if err := postgresIndexes(db, table); err != nil {
+			return nil, err
+		}
+		schema.Tables = append(schema.Tables, table)
+	}
+	return schema, nil
+}
+
+func postgresColumns(db *sql.DB, table *Table) (err error) {
+	rows, err := db.Query(`SELECT column_name, data_type, is_nullable,
+			COALESCE(character_maximum_length, 0),
+			COALESCE(column_default, '')
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1
+		ORDER BY ordinal_position`, table.Name)
+	if err != nil {
+		return errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name, data_type, is_nullable, column_default string
+		var length int
+		err := rows.Scan(&name, &data_type, &is_nullable, &length,
+			&column_default)
+		if err != nil {
+			return errutil.Error.Wrap(err)
+		}
+		column := &Column{
+			Name:     name,
+			DBType:   data_type,
+			Nullable: is_nullable == "YES",
+		}
+		if length > 0 {
+			column.DBType = fmt.Sprintf("%s(%d)", data_type, length)
+		}
+		column.Type, column.Mapped = postgresFieldType(data_type,
+			strings.HasPrefix(column_default, "nextval("))
+		if column.Type == consts.TextField {
+			column.Length = length
+		}
+		table.Columns = append(table.Columns, column)
+	}
+	return errutil.Error.Wrap(rows.Err())
+}
+
+func postgresConstraints(db *sql.DB, table *Table) (err error) {
+	rows, err := db.Query(`SELECT tc.constraint_name, tc.constraint_type,
+			kcu.column_name,
+			COALESCE(rkcu.table_name, ''), COALESCE(rkcu.column_name, ''),
+			COALESCE(rc.delete_rule, ''), COALESCE(rc.update_rule, '')
+		FROM information_schema.table_constraints tc
+		JOIN information_schema.key_column_usage kcu
+			ON kcu.constraint_schema = tc.constraint_schema
+			AND kcu.constraint_name = tc.constraint_name
+		LEFT JOIN information_schema.referential_constraints rc
+			ON rc.constraint_schema = tc.constraint_schema
+			AND rc.constraint_name = tc.constraint_name
+		LEFT JOIN information_schema.key_column_usage rkcu
+			ON rkcu.constraint_schema = rc.unique_constraint_schema
+			AND rkcu.constraint_name = rc.unique_constraint_name
+			AND rkcu.ordinal_position = kcu.position_in_unique_constraint
+		WHERE tc.table_schema = current_schema() AND tc.table_name = $1
+			AND tc.constraint_type IN ('PRIMARY KEY', 'UNIQUE', 'FOREIGN KEY')
+		ORDER BY tc.constraint_name, kcu.ordinal_position`, table.Name)
+	if err != nil {
+		return errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	var last_name string
+	var unique []string
+	var foreign_key *ForeignKey
+	flush := func() {
+		if unique != nil {
+			table.Unique = append(table.Unique, unique)
+		}
+		if foreign_key != nil {
+			table.ForeignKeys = append(table.ForeignKeys, foreign_key)
+		}
+		unique, foreign_key = nil, nil
+	}
+
+	for rows.Next() {
+		var name, kind, column, ref_table, ref_column string
+		var delete_rule, update_rule string
+		err := rows.Scan(&name, &kind, &column, &ref_table, &ref_column,
+			&delete_rule, &update_rule)
+		if err != nil {
+			return errutil.Error.Wrap(err)
+		}
+		if name != last_name {
+			flush()
+			last_name = name
+		}
+		switch kind {
+		case "PRIMARY KEY":
+			table.PrimaryKey = append(table.PrimaryKey, column)
+		case "UNIQUE":
+			unique = append(unique, column)
+		case "FOREIGN KEY":
+			if foreign_key == nil {
+				foreign_key = &ForeignKey{
+					Table:    ref_table,
+					OnDelete: relationKind(delete_rule),
+					OnUpdate: relationKind(update_rule),
+				}
+			}
+			foreign_key.Columns = append(foreign_key.Columns, column)
+			foreign_key.References = append(foreign_key.References,
+				ref_column)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errutil.Error.Wrap(err)
+	}
+	flush()
+	return nil
+}
+
+// postgresIndexes finds the indexes that are not backing a constraint. the
+// information schema does not describe indexes so the catalog is used.
+func postgresIndexes(db *sql.DB, table *Table) (err error) {
+	rows, err := db.Query(`SELECT i.relname, ix.indisunique,
+			ix.indpred IS NOT NULL, COALESCE(a.attname, '')
+		FROM pg_index ix
+		JOIN pg_class t ON t.oid = ix.indrelid
+		JOIN pg_class i ON i.oid = ix.indexrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		CROSS JOIN LATERAL unnest(ix.indkey::int2[])
+			WITH ORDINALITY AS k(attnum, ord)
+		LEFT JOIN pg_attribute a
+			ON a.attrelid = t.oid AND a.attnum = k.attnum AND k.attnum > 0
+		WHERE n.nspname = current_schema() AND t.relname = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM pg_constraint c
+				WHERE c.conindid = ix.indexrelid)
+		ORDER BY i.relname, k.ord`, table.Name)
+	if err != nil {
+		return errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	var index *Index
+	for rows.Next() {
+		var name, column string
+		var unique, partial bool
+		err := rows.Scan(&name, &unique, &partial, &column)
+		if err != nil {
+			return errutil.Error.Wrap(err)
+		}
+		if index == nil || index.Name != name {
+			index = &Index{Name: name, Unique: unique, Partial: partial}
+			table.Indexes = append(table.Indexes, index)
+		}
+		// expressions are stored as a zero attribute number.
+		if column == "" {
+			index.Expression = true
+			continue
+		}
+		index.Columns = append(index.Columns, column)
+	}
+	return errutil.Error.Wrap(rows.Err())
+}
+
+func postgresFieldType(data_type string, sequence bool) (
+	field_type consts.FieldType, ok bool) {
+
+	switch data_type {
+	case "smallint", "integer":
+		if sequence {
+			return consts.SerialField, true
+		}
+		return consts.IntField, true
+	case "bigint":
+		if sequence {
+			return consts.Serial64Field, true
+		}
+		return consts.Int64Field, true
+	case "real":
+		return consts.FloatField, true
+	case "double precision":
+		return consts.Float64Field, true
+	case "text", "character varying":
+		return consts.TextField, true
+	case "boolean":
+		return consts.BoolField, true
+	case "timestamp with time zone":
+		return consts.TimestampField, true
+	case "timestamp without time zone":
+		return consts.TimestampUTCField, true
+	case "bytea":
+		return consts.BlobField, true
+	case "date":
+		return consts.DateField, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate go run gen_schemadiff.go
+
+package introspect
+
+// This file is included in the generated code after its imports, so it may
+// only use the fmt package and must not refer to anything else in this
+// package. Run go generate after changing it.
+
+import (
+	"fmt"
+)
+
+// SchemaDifferenceKind is the kind of a SchemaDifference.
+type SchemaDifferenceKind string
+
+const (
+	SchemaMissingTable        SchemaDifferenceKind = "missing table"
+	SchemaMissingColumn       SchemaDifferenceKind = "missing column"
+	SchemaTypeMismatch        SchemaDifferenceKind = "type mismatch"
+	SchemaNullabilityMismatch SchemaDifferenceKind = "nullability mismatch"
+	SchemaMissingIndex        SchemaDifferenceKind = "missing index"
+)
+
+// SchemaDifference is a way a database does not match the expected schema.
+// Column is set for column differences and Index for missing indexes.
+type SchemaDifference struct {
+	Kind     SchemaDifferenceKind
+	Table    string
+	Column   string
+	Index    string
+	Expected string
+	Actual   string
+}
+
+func (d SchemaDifference) String() string {
+	switch d.Kind {
+	case SchemaMissingTable:
+		return fmt.Sprintf("table %q is missing", d.Table)
+	case SchemaMissingColumn:
+		return fmt.Sprintf("column %q on table %q is missing",
+			d.Column, d.Table)
+	case SchemaMissingIndex:
+		return fmt.Sprintf("index %q on table %q is missing",
+			d.Index, d.Table)
+	default:
+		return fmt.Sprintf("column %q on table %q has %s: expected %s, got %s",
+			d.Column, d.Table, d.Kind, d.Expected, d.Actual)
+	}
+}
+
+type schemaColumn struct {
+	name     string
+	typ      string
+	nullable bool
+}
+
+type schemaTable struct {
+	name    string
+	columns []schemaColumn
+	indexes []string
+}
+
+// schemaCatalog holds the columns and index names of every table found in
+// a database.
+type schemaCatalog struct {
+	tables  map[string]map[string]schemaColumn
+	indexes map[string]map[string]bool
+}
+
+func newSchemaCatalog() *schemaCatalog {
+	return &schemaCatalog{
+		tables:  map[string]map[string]schemaColumn{},
+		indexes: map[string]map[string]bool{},
+	}
+}
+
+func (c *schemaCatalog) addColumn(table string, column schemaColumn) {
+	columns := c.tables[table]
+	if columns == nil {
+		columns = map[string]schemaColumn{}
+		c.tables[table] = columns
+	}
+	columns[column.name] = column
+}
+
+func (c *schemaCatalog) addIndex(table string, index string) {
+	indexes := c.indexes[table]
+	if indexes == nil {
+		indexes = map[string]bool{}
+		c.indexes[table] = indexes
+	}
+	indexes[index] = true
+}
+
+func schemaNullability(nullable bool) string {
+	if nullable {
+		return "null"
+	}
+	return "not null"
+}
+
+// compareSchema lists the differences between the expected tables and the
+// catalog. Tables, columns and indexes that are only in the catalog are not
+// differences.
+func compareSchema(expected []schemaTable, catalog *schemaCatalog) (
+	differences []SchemaDifference) {
+
+	for _, table := range expected {
+		columns, ok := catalog.tables[table.name]
+		if !ok {
+			differences = append(differences, SchemaDifference{
+				Kind:  SchemaMissingTable,
+				Table: table.name,
+			})
+			continue
+		}
+		for _, column := range table.columns {
+			actual, ok := columns[column.name]
+			if !ok {
+				differences = append(differences, SchemaDifference{
+					Kind:   SchemaMissingColumn,
+					Table:  table.name,
+					Column: column.name,
+				})
+				continue
+			}
+			if actual.typ != column.typ {
+				differences = append(differences, SchemaDifference{
+					Kind:     SchemaTypeMismatch,
+					Table:    table.name,
+					Column:   column.name,
+					Expected: column.typ,
+					Actual:   actual.typ,
+				})
+			}
+			if actual.nullable != column.nullable {
+				differences = append(differences, SchemaDifference{
+					Kind:     SchemaNullabilityMismatch,
+					Table:    table.name,
+					Column:   column.name,
+					Expected: schemaNullability(column.nullable),
+					Actual:   schemaNullability(actual.nullable),
+				})
+			}
+		}
+		for _, index := range table.indexes {
+			if !catalog.indexes[table.name][index] {
+				differences = append(differences, SchemaDifference{
+					Kind:  SchemaMissingIndex,
+					Table: table.name,
+					Index: index,
+				})
+			}
+		}
+	}
+	return differences
+}
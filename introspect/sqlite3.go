@@ -0,0 +1,314 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package introspect
+
+import (
+	"database/sql"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
+
+func introspectSQLite3(db *sql.DB) (schema *Schema, err error) {
+	names, err := queryStrings(db, `SELECT name FROM sqlite_master
+		WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+
+	schema = new(Schema)
+	for _, name := range names {
+		table, err := sqlite3Table(db, name)
+		if err != nil {
+			return nil, err
+		}
+		schema.Tables = append(schema.Tables, table)
+	}
+
+	// foreign keys may leave out the referenced columns when they reference
+	// the primary key.
+	for _, table := range schema.Tables {
+		for _, foreign_key := range table.ForeignKeys {
+			if len(foreign_key.References) > 0 {
+				continue
+			}
+			referenced := schema.Table(foreign_key.Table)
+			if referenced == nil {
+				return nil, errutil.Error.New(
+					"table %q references unknown table %q",
+					table.Name, foreign_key.Table)
+			}
+			foreign_key.References = referenced.PrimaryKey
+		}
+	}
+
+	return schema, nil
+}
+
+func sqlite3Table(db *sql.DB, name string) (table *Table, err error) {
+	table = &Table{Name: name}
+
+	rows, err := db.Query("PRAGMA table_info(" + sqlite3Quote(name) + ")")
+	if err != nil {
+		return nil, errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	pk_positions := map[string]int{}
+	for rows.Next() {
+		var cid, not_null, pk int
+		var column_name, column_type string
+		var default_value sql.NullString
+		err := rows.Scan(&cid, &column_name, &column_type, &not_null,
+			&default_value, &pk)
+		if err != nil {
+			return nil, errutil.Error.Wrap(err)
+		}
+		column := &Column{
+			Name:     column_name,
+			DBType:   column_type,
+			Nullable: not_null == 0 && pk == 0,
+		}
+		column.Type, column.Length, column.Mapped = sqlite3FieldType(
+			column_type)
+		table.Columns = append(table.Columns, column)
+		if pk > 0 {
+			pk_positions[column_name] = pk
+			table.PrimaryKey = append(table.PrimaryKey, column_name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errutil.Error.Wrap(err)
+	}
+	sort.SliceStable(table.PrimaryKey, func(i, j int) bool {
+		return pk_positions[table.PrimaryKey[i]] <
+			pk_positions[table.PrimaryKey[j]]
+	})
+
+	// an INTEGER primary key is an alias for the rowid and so is a serial.
+	if len(table.PrimaryKey) == 1 {
+		column := table.Column(table.PrimaryKey[0])
+		if strings.EqualFold(column.DBType, "INTEGER") {
+			column.Type = consts.Serial64Field
+		}
+	}
+
+	if err := sqlite3Indexes(db, table); err != nil {
+		return nil, err
+	}
+	if err := sqlite3ForeignKeys(db, table); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+func sqlite3Indexes(db *sql.DB, table *Table) (err error) {
+	rows, err := db.Query(
+		"PRAGMA index_list(" + sqlite3Quote(table.Name) + ")")
+	if err != nil {
+		return errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	type indexInfo struct {
+		name    string
+		unique  bool
+		origin  string
+		partial bool
+	}
+
+	var infos []indexInfo
+	for rows.Next() {
+		var seq, unique, partial int
+		var name, origin string
+		if err := rows.Scan(&seq, &name, &unique, &origin,
+			&partial); err != nil {
+			return errutil.Error.Wrap(err)
+		}
+		infos = append(infos, indexInfo{
+			name:    name,
+			unique:  unique != 0,
+			origin:  origin,
+			partial: partial != 0,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return errutil.Error.Wrap(err)
+	}
+
+	// index_list reports the most recently created index first.
+	for i := len(infos) - 1; i >= 0; i-- {
+		info := infos[i]
+		if info.origin == "pk" {
+			continue
+		}
+		columns, expression, err := sqlite3IndexColumns(db, info.name)
+		if err != nil {
+			return err
+		}
+		if info.origin == "u" {
+			table.Unique = append(table.Unique, columns)
+			continue
+		}
+		table.Indexes = append(table.Indexes, &Index{
+			Name:       info.name,
+			Columns:    columns,
+			Unique:     info.unique,
+			Partial:    info.partial,
+			Expression: expression,
+		})
+	}
+	return nil
+}
+
+// sqlite3IndexColumns returns the named columns of the index and if it
+// indexes any expressions.
+func sqlite3IndexColumns(db *sql.DB, index string) (
+	columns []string, expression bool, err error) {
+
+	rows, err := db.Query("PRAGMA index_info(" + sqlite3Quote(index) + ")")
+	if err != nil {
+		return nil, false, errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var seqno, cid int
+		var name sql.NullString
+		if err := rows.Scan(&seqno, &cid, &name); err != nil {
+			return nil, false, errutil.Error.Wrap(err)
+		}
+		if !name.Valid {
+			expression = true
+			continue
+		}
+		columns = append(columns, name.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, errutil.Error.Wrap(err)
+	}
+	return columns, expression, nil
+}
+
+func sqlite3ForeignKeys(db *sql.DB, table *Table) (err error) {
+	rows, err := db.Query(
+		"PRAGMA foreign_key_list(" + sqlite3Quote(table.Name) + ")")
+	if err != nil {
+		return errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	by_id := map[int]*ForeignKey{}
+	var ids []int
+	for rows.Next() {
+		var id, seq int
+		var referenced, from, on_update, on_delete, match string
+		var to sql.NullString
+		err := rows.Scan(&id, &seq, &referenced, &from, &to, &on_update,
+			&on_delete, &match)
+		if err != nil {
+			return errutil.Error.Wrap(err)
+		}
+		foreign_key := by_id[id]
+		if foreign_key == nil {
+			foreign_key = &ForeignKey{
+				Table:    referenced,
+				OnDelete: relationKind(on_delete),
+				OnUpdate: relationKind(on_update),
+			}
+			by_id[id] = foreign_key
+			ids = append(ids, id)
+		}
+		foreign_key.Columns = append(foreign_key.Columns, from)
+		if to.Valid {
+			foreign_key.References = append(foreign_key.References,
+				to.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errutil.Error.Wrap(err)
+	}
+
+	// foreign key ids count down from the last declared key.
+	sort.Sort(sort.Reverse(sort.IntSlice(ids)))
+	for _, id := range ids {
+		table.ForeignKeys = append(table.ForeignKeys, by_id[id])
+	}
+	return nil
+}
+
+func sqlite3FieldType(column_type string) (
+	field_type consts.FieldType, length int, ok bool) {
+
+	name := strings.ToUpper(strings.TrimSpace(column_type))
+	if open := strings.IndexByte(name, '('); open >= 0 {
+		if size, err := strconv.Atoi(strings.TrimSpace(
+			strings.TrimSuffix(name[open+1:], ")"))); err == nil {
+			length = size
+		}
+		name = strings.TrimSpace(name[:open])
+	}
+
+	switch name {
+	case "INTEGER", "INT", "BIGINT", "SMALLINT", "TINYINT", "MEDIUMINT":
+		return consts.Int64Field, 0, true
+	case "REAL", "DOUBLE", "DOUBLE PRECISION", "FLOAT":
+		return consts.Float64Field, 0, true
+	case "TEXT", "CLOB":
+		return consts.TextField, 0, true
+	case "VARCHAR", "CHAR", "CHARACTER", "VARYING CHARACTER", "NVARCHAR":
+		return consts.TextField, length, true
+	case "BLOB":
+		return consts.BlobField, 0, true
+	case "BOOLEAN", "BOOL":
+		return consts.BoolField, 0, true
+	case "TIMESTAMP", "DATETIME":
+		return consts.TimestampField, 0, true
+	case "DATE":
+		return consts.DateField, 0, true
+	default:
+		return 0, 0, false
+	}
+}
+
+func sqlite3Quote(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+func queryStrings(db *sql.DB, query string, args ...interface{}) (
+	values []string, err error) {
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, errutil.Error.Wrap(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, errutil.Error.Wrap(err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errutil.Error.Wrap(err)
+	}
+	return values, nil
+}
@@ -14,9 +14,14 @@
 
 package ir
 
-import "fmt"
+import (
+	"fmt"
+	"text/scanner"
+)
 
 type Create struct {
+	Pos      scanner.Position
+	Doc      string
 	Suffix   []string
 	Model    *Model
 	Raw      bool
@@ -14,13 +14,19 @@
 
 package ir
 
-import "fmt"
+import (
+	"fmt"
+	"text/scanner"
+)
 
 type Delete struct {
+	Pos    scanner.Position
+	Doc    string
 	Suffix []string
 	Model  *Model
 	Joins  []*Join
 	Where  []*Where
+	NoLint []*NoLint
 }
 
 func (r *Delete) Signature() string {
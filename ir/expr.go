@@ -14,6 +14,12 @@
 
 package ir
 
+import (
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+)
+
 type Expr struct {
 	Null        bool
 	Placeholder bool
@@ -31,20 +37,61 @@ func (e *Expr) Nullable() bool {
 	case e.Field != nil:
 		return e.Field.Nullable
 	case e.FuncCall != nil:
-		return e.FuncCall.Nullable()
+		return e.FuncCall.Nullable
 	default:
 		return false
 	}
 }
 
-func (e *Expr) HasPlaceholder() bool {
-	if e.Placeholder {
-		return true
+// Type returns the type the expression evaluates to. Nulls and placeholders
+// have no type of their own and return false.
+func (e *Expr) Type() (typ consts.FieldType, ok bool) {
+	switch {
+	case e.StringLit != nil:
+		return consts.TextField, true
+	case e.NumberLit != nil:
+		if strings.ContainsAny(*e.NumberLit, ".eE") {
+			return consts.Float64Field, true
+		}
+		return consts.Int64Field, true
+	case e.BoolLit != nil:
+		return consts.BoolField, true
+	case e.Field != nil:
+		return e.Field.Type, true
+	case e.FuncCall != nil:
+		return e.FuncCall.Type, true
+	default:
+		return 0, false
 	}
-	if e.FuncCall != nil {
-		return e.FuncCall.HasPlaceholder()
+}
+
+// ResultField returns the field whose values the expression evaluates to the
+// type of, or nil if there is none.
+func (e *Expr) ResultField() *Field {
+	switch {
+	case e.Field != nil:
+		return e.Field
+	case e.FuncCall != nil:
+		return e.FuncCall.Field
+	default:
+		return nil
+	}
+}
+
+func (e *Expr) HasPlaceholder() bool {
+	return e.Placeholders() > 0
+}
+
+// Placeholders returns how many placeholders the expression binds.
+func (e *Expr) Placeholders() int {
+	switch {
+	case e.Placeholder:
+		return 1
+	case e.FuncCall != nil:
+		return e.FuncCall.Placeholders()
+	default:
+		return 0
 	}
-	return false
 }
 
 func (e *Expr) Unique() bool {
@@ -54,22 +101,30 @@ func (e *Expr) Unique() bool {
 type FuncCall struct {
 	Name string
 	Args []*Expr
-}
 
-func (fc *FuncCall) Nullable() bool {
-	for _, arg := range fc.Args {
-		if arg.Nullable() {
-			return true
-		}
-	}
-	return false
+	// Type is the type of the value the function evaluates to.
+	Type consts.FieldType
+
+	// Field is set if the function evaluates to values of the same type as
+	// the field, like lower(user.name), so that arguments compared against
+	// the function can be typed by the field.
+	Field *Field
+
+	// Nullable is true if the function can evaluate to null. Functions
+	// without a Field are compared against plain values and are never
+	// considered nullable.
+	Nullable bool
 }
 
 func (fc *FuncCall) HasPlaceholder() bool {
+	return fc.Placeholders() > 0
+}
+
+// Placeholders returns how many placeholders the arguments of the call bind.
+func (fc *FuncCall) Placeholders() int {
+	count := 0
 	for _, arg := range fc.Args {
-		if arg.HasPlaceholder() {
-			return true
-		}
+		count += arg.Placeholders()
 	}
-	return false
+	return count
 }
@@ -16,26 +16,31 @@ package ir
 
 import (
 	"fmt"
+	"text/scanner"
 
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
 )
 
 type Relation struct {
-	Field *Field
-	Kind  consts.RelationKind
+	Field    *Field
+	Kind     consts.RelationKind
+	OnUpdate consts.RelationKind
 }
 
 type Field struct {
-	Name       string
-	Column     string
-	Model      *Model
-	Type       consts.FieldType
-	Relation   *Relation
-	Nullable   bool
-	AutoInsert bool
-	AutoUpdate bool
-	Updatable  bool
-	Length     int // Text only
+	Pos         scanner.Position
+	Doc         string
+	Name        string
+	Column      string
+	Model       *Model
+	Type        consts.FieldType
+	Relation    *Relation
+	Nullable    bool
+	AutoInsert  bool
+	AutoUpdate  bool
+	Updatable   bool
+	Length      int // Text only
+	ProtoNumber int // 0 if the field is numbered by its position
 }
 
 func (f *Field) Insertable() bool {
@@ -17,6 +17,7 @@ package ir
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
 	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
@@ -48,6 +49,15 @@ func fieldSetEquivalent(left, right []*Field) bool {
 }
 
 // returns true if left is a subset of right
+func fieldSetContains(fields []*Field, field *Field) bool {
+	for _, other := range fields {
+		if other == field {
+			return true
+		}
+	}
+	return false
+}
+
 func fieldSetPrune(all, bad []*Field) (out []*Field) {
 	for i := range all {
 		if fieldSetSubset(all[i:i+1], bad) {
@@ -165,14 +175,25 @@ func (by byModelDepth) Less(a, b int) bool {
 	return by[a].Name < by[b].Name
 }
 
+// findCycles returns an error naming the models in the first cycle of
+// references it finds. tables in a cycle could not be created or dropped in
+// dependency order.
 func findCycles(models []*Model) (err error) {
 	seen_ever := map[*Model]bool{}
 	seen_this := map[*Model]bool{}
+	var path []string
 
 	var traverse func(*Model) error
 	traverse = func(model *Model) (err error) {
 		if seen_this[model] {
-			return errutil.Error.New("model %q part of a cycle", model.Name)
+			for i, name := range path {
+				if name == model.Name {
+					path = append(path[i:], model.Name)
+					break
+				}
+			}
+			return errutil.Error.New("model %q part of a cycle: %s",
+				model.Name, strings.Join(path, " -> "))
 		}
 		if seen_ever[model] {
 			return nil
@@ -180,15 +201,16 @@ func findCycles(models []*Model) (err error) {
 
 		seen_this[model] = true
 		seen_ever[model] = true
-
-		for _, field := range model.Fields {
-			if field.Relation == nil {
-				continue
+		path = append(path, model.Name)
+		defer func() {
+			delete(seen_this, model)
+			if err == nil {
+				path = path[:len(path)-1]
 			}
-			if field.Relation.Field.Model == model {
-				continue
-			}
-			if err := traverse(field.Relation.Field.Model); err != nil {
+		}()
+
+		for _, referenced := range model.ReferencedModels() {
+			if err := traverse(referenced); err != nil {
 				return err
 			}
 		}
@@ -200,21 +222,14 @@ func findCycles(models []*Model) (err error) {
 		if err := traverse(model); err != nil {
 			return err
 		}
-		seen_this = map[*Model]bool{}
 	}
 
 	return nil
 }
 
 func modelDepth(model *Model) (depth int) {
-	for _, field := range model.Fields {
-		if field.Relation == nil {
-			continue
-		}
-		if field.Relation.Field.Model == model {
-			continue
-		}
-		reldepth := modelDepth(field.Relation.Field.Model) + 1
+	for _, referenced := range model.ReferencedModels() {
+		reldepth := modelDepth(referenced) + 1
 		if reldepth > depth {
 			depth = reldepth
 		}
@@ -14,9 +14,30 @@
 
 package ir
 
+import "text/scanner"
+
 type Index struct {
-	Name   string
-	Model  *Model
-	Fields []*Field
-	Unique bool
+	Pos     scanner.Position
+	Name    string
+	Model   *Model
+	Columns []*IndexColumn
+	Unique  bool
+	Where   []*Where
+	Using   string
+}
+
+// IndexColumn is an indexed expression, which is usually just a field.
+type IndexColumn struct {
+	Expr       *Expr
+	Descending bool
+}
+
+// Fields returns the fields of the columns that index a plain field.
+func (i *Index) Fields() (fields []*Field) {
+	for _, column := range i.Columns {
+		if column.Expr.Field != nil {
+			fields = append(fields, column.Expr.Field)
+		}
+	}
+	return fields
 }
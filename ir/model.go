@@ -14,13 +14,52 @@
 
 package ir
 
+import (
+	"text/scanner"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+)
+
 type Model struct {
-	Name       string
-	Table      string
+	Pos         scanner.Position
+	Doc         string
+	Name        string
+	Table       string
+	Fields      []*Field
+	PrimaryKey  []*Field
+	Unique      [][]*Field
+	Indexes     []*Index
+	Checks      []*Check
+	ForeignKeys []*ForeignKey
+	NoLint      []*NoLint
+}
+
+// NoLint is a lint rule suppressed on a model or operation.
+type NoLint struct {
+	Pos  scanner.Position
+	Rule string
+}
+
+// ForeignKey constrains a set of fields to match a unique set of fields on
+// another model.
+type ForeignKey struct {
 	Fields     []*Field
-	PrimaryKey []*Field
-	Unique     [][]*Field
-	Indexes    []*Index
+	References []*Field
+	OnDelete   consts.RelationKind
+	OnUpdate   consts.RelationKind
+}
+
+// ReferencedModel returns the model the foreign key references.
+func (fk *ForeignKey) ReferencedModel() *Model {
+	return fk.References[0].Model
+}
+
+// Check is a named list of where clauses that every row of the model must
+// satisfy. Field is set for checks declared on a field.
+type Check struct {
+	Name   string
+	Field  *Field
+	Wheres []*Where
 }
 
 func (m *Model) BasicPrimaryKey() *Field {
@@ -91,6 +130,74 @@ func (m *Model) FieldSetUnique(fields []*Field) bool {
 	return false
 }
 
+// ReferencedModels returns the other models referenced by the model through
+// its relations and foreign keys.
+func (m *Model) ReferencedModels() (models []*Model) {
+	seen := map[*Model]bool{m: true}
+	add := func(model *Model) {
+		if !seen[model] {
+			seen[model] = true
+			models = append(models, model)
+		}
+	}
+	for _, field := range m.Fields {
+		if field.Relation != nil {
+			add(field.Relation.Field.Model)
+		}
+	}
+	for _, foreign_key := range m.ForeignKeys {
+		add(foreign_key.ReferencedModel())
+	}
+	return models
+}
+
+// InPrimaryKey returns true if the field is part of the primary key.
+func (m *Model) InPrimaryKey(field *Field) bool {
+	return fieldSetContains(m.PrimaryKey, field)
+}
+
+// FieldKeys returns "PK", "UK" and "FK" for the field being part of the
+// primary key, of a unique constraint or index, and of a relation or foreign
+// key, as diagrams and docs mark them.
+func (m *Model) FieldKeys(field *Field) (keys []string) {
+	if m.InPrimaryKey(field) {
+		keys = append(keys, "PK")
+	}
+	if m.inUnique(field) {
+		keys = append(keys, "UK")
+	}
+	if m.inReference(field) {
+		keys = append(keys, "FK")
+	}
+	return keys
+}
+
+func (m *Model) inUnique(field *Field) bool {
+	for _, unique := range m.Unique {
+		if fieldSetContains(unique, field) {
+			return true
+		}
+	}
+	for _, index := range m.Indexes {
+		if index.Unique && fieldSetContains(index.Fields(), field) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Model) inReference(field *Field) bool {
+	if field.Relation != nil {
+		return true
+	}
+	for _, foreign_key := range m.ForeignKeys {
+		if fieldSetContains(foreign_key.Fields, field) {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Model) ModelOf() *Model {
 	return m
 }
@@ -16,6 +16,7 @@ package ir
 
 import (
 	"fmt"
+	"text/scanner"
 
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
 )
@@ -27,6 +28,8 @@ type Selectable interface {
 }
 
 type Read struct {
+	Pos         scanner.Position
+	Doc         string
 	Suffix      []string
 	Selectables []Selectable
 	From        *Model
@@ -34,7 +37,9 @@ type Read struct {
 	Where       []*Where
 	OrderBy     *OrderBy
 	GroupBy     *GroupBy
+	Lock        *Lock
 	View        View
+	NoLint      []*NoLint
 }
 
 func (r *Read) Signature() string {
@@ -60,6 +65,12 @@ func (r *Read) SelectedModel() *Model {
 	return nil
 }
 
+type Lock struct {
+	Pos        scanner.Position
+	Mode       consts.LockMode
+	SkipLocked bool
+}
+
 type View string
 
 const (
@@ -71,6 +82,8 @@ const (
 	Scalar      View = "scalar"
 	One         View = "one"
 	First       View = "first"
+	Iterate     View = "iterate"
+	Dynamic     View = "dynamic"
 )
 
 type Join struct {
@@ -80,8 +93,13 @@ type Join struct {
 }
 
 type OrderBy struct {
-	Fields     []*Field
+	Entries []*OrderByEntry
+}
+
+type OrderByEntry struct {
+	Field      *Field
 	Descending bool
+	Nulls      consts.NullsOrder
 }
 
 type GroupBy struct {
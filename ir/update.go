@@ -14,14 +14,20 @@
 
 package ir
 
-import "fmt"
+import (
+	"fmt"
+	"text/scanner"
+)
 
 type Update struct {
+	Pos      scanner.Position
+	Doc      string
 	Suffix   []string
 	Model    *Model
 	Joins    []*Join
 	Where    []*Where
 	NoReturn bool
+	NoLint   []*NoLint
 }
 
 func (r *Update) Signature() string {
@@ -14,12 +14,29 @@
 
 package ir
 
-import "gopkg.in/spacemonkeygo/dbx.v1/consts"
+import (
+	"text/scanner"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+)
 
 type Where struct {
+	Pos   scanner.Position
 	Left  *Expr
 	Op    consts.Operator
 	Right *Expr
+
+	// Upper is the upper bound of a between operator
+	Upper *Expr
+}
+
+// Operands returns the right hand side expressions of the where in the order
+// they appear in the rendered SQL.
+func (w *Where) Operands() []*Expr {
+	if w.Upper != nil {
+		return []*Expr{w.Right, w.Upper}
+	}
+	return []*Expr{w.Right}
 }
 
 func (w *Where) NeedsCondition() bool {
@@ -24,8 +24,8 @@ import (
 
 func DefaultIndexName(i *ir.Index) string {
 	parts := []string{i.Model.Table}
-	for _, field := range i.Fields {
-		parts = append(parts, field.Column)
+	for _, column := range i.Columns {
+		parts = append(parts, indexExprParts(column.Expr)...)
 	}
 	if i.Unique {
 		parts = append(parts, "unique")
@@ -34,6 +34,21 @@ func DefaultIndexName(i *ir.Index) string {
 	return strings.Join(parts, "_")
 }
 
+// indexExprParts names an indexed expression by the function calls and the
+// columns in it, like "lower_email" for lower(email).
+func indexExprParts(expr *ir.Expr) (parts []string) {
+	switch {
+	case expr.Field != nil:
+		parts = append(parts, expr.Field.Column)
+	case expr.FuncCall != nil:
+		parts = append(parts, expr.FuncCall.Name)
+		for _, arg := range expr.FuncCall.Args {
+			parts = append(parts, indexExprParts(arg)...)
+		}
+	}
+	return parts
+}
+
 func DefaultCreateSuffix(cre *ir.Create) []string {
 	var parts []string
 	parts = append(parts, cre.Model.Name)
@@ -57,16 +72,27 @@ func DefaultReadSuffix(read *ir.Read) []string {
 	parts = append(parts, whereSuffix(read.Where, full)...)
 	if read.OrderBy != nil {
 		parts = append(parts, "order_by")
-		if read.OrderBy.Descending {
-			parts = append(parts, "desc")
-		} else {
-			parts = append(parts, "asc")
-		}
-		for _, field := range read.OrderBy.Fields {
+		for i, entry := range read.OrderBy.Entries {
+			// the direction is only repeated when it changes so that
+			// single direction orderings keep their names.
+			if i == 0 ||
+				entry.Descending != read.OrderBy.Entries[i-1].Descending {
+				if entry.Descending {
+					parts = append(parts, "desc")
+				} else {
+					parts = append(parts, "asc")
+				}
+			}
 			if full {
-				parts = append(parts, field.Model.Name)
+				parts = append(parts, entry.Field.Model.Name)
+			}
+			parts = append(parts, entry.Field.Name)
+			switch entry.Nulls {
+			case consts.NullsFirst:
+				parts = append(parts, "nulls", "first")
+			case consts.NullsLast:
+				parts = append(parts, "nulls", "last")
 			}
-			parts = append(parts, field.Name)
 		}
 	}
 	if read.GroupBy != nil {
@@ -133,6 +159,12 @@ func whereSuffix(wheres []*ir.Where, full bool) (parts []string) {
 		if len(right) > 0 {
 			parts = append(parts, right...)
 		}
+		if where.Upper != nil {
+			if upper := exprSuffix(where.Upper, full); len(upper) > 0 {
+				parts = append(parts, "and")
+				parts = append(parts, upper...)
+			}
+		}
 
 	}
 	return parts
@@ -155,15 +187,28 @@ func exprSuffix(expr *ir.Expr, full bool) (parts []string) {
 		}
 		parts = append(parts, expr.Field.Name)
 	case expr.FuncCall != nil:
+		// calls over a field leave their literal arguments out to keep the
+		// name short, except for words like the date_trunc unit. reads that
+		// only differ in those literals need a suffix of their own.
+		short := refsField(expr)
 		parts = append(parts, expr.FuncCall.Name)
-		for i, arg := range expr.FuncCall.Args {
+		first := true
+		for _, arg := range expr.FuncCall.Args {
+			if short && arg.StringLit != nil && isWord(*arg.StringLit) {
+				parts = append(parts, *arg.StringLit)
+				continue
+			}
+			if short && isLiteral(arg) {
+				continue
+			}
 			arg_suffix := exprSuffix(arg, full)
 			if len(arg_suffix) == 0 {
 				continue
 			}
-			if i != 0 {
+			if !first {
 				parts = append(parts, "and")
 			}
+			first = false
 			parts = append(parts, arg_suffix...)
 		}
 	default:
@@ -171,3 +216,34 @@ func exprSuffix(expr *ir.Expr, full bool) (parts []string) {
 	}
 	return parts
 }
+
+func isLiteral(expr *ir.Expr) bool {
+	return expr.Null || expr.StringLit != nil || expr.NumberLit != nil ||
+		expr.BoolLit != nil
+}
+
+func isWord(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func refsField(expr *ir.Expr) bool {
+	if expr.Field != nil {
+		return true
+	}
+	if expr.FuncCall != nil {
+		for _, arg := range expr.FuncCall.Args {
+			if refsField(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
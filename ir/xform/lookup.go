@@ -15,6 +15,10 @@
 package xform
 
 import (
+	"errors"
+	"sort"
+	"text/scanner"
+
 	"gopkg.in/spacemonkeygo/dbx.v1/ast"
 	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
@@ -28,8 +32,16 @@ type modelEntry struct {
 	model  *ir.Model
 	ast    *ast.Model
 	fields map[string]*fieldEntry
+
+	// failed is set once the model had errors of its own.
+	failed bool
 }
 
+// errFailedModel is returned when looking up a model that had errors of its
+// own. Those are already reported, so operations using the model are skipped
+// instead of adding errors that only follow from them.
+var errFailedModel = errors.New("model has errors")
+
 type fieldEntry struct {
 	field *ir.Field
 	ast   *ast.Field
@@ -58,25 +70,44 @@ func (l *lookup) GetModel(name string) *modelEntry {
 func (l *lookup) FindModel(ref *ast.ModelRef) (*ir.Model, error) {
 	link := l.models[ref.Model.Value]
 	if link != nil {
+		if link.failed {
+			return nil, errFailedModel
+		}
 		return link.model, nil
 	}
-	return nil, errutil.New(ref.Pos, "no model %q defined",
-		ref.Model.Value)
+	return nil, l.noModel(ref.Pos, ref.Model)
 }
 
 func (l *lookup) FindField(ref *ast.FieldRef) (*ir.Field, error) {
 	model_link := l.models[ref.Model.Value]
 	if model_link == nil {
-		return nil, errutil.New(ref.Pos, "no model %q defined",
-			ref.Model.Value)
+		return nil, l.noModel(ref.Pos, ref.Model)
+	}
+	if model_link.failed {
+		return nil, errFailedModel
 	}
 	return model_link.FindField(ref.Relative())
 }
 
+// noModel returns the error for a reference to an undefined model, suggesting
+// a similarly named model if there is one.
+func (l *lookup) noModel(pos scanner.Position, name *ast.String) error {
+	var names []string
+	for model_name := range l.models {
+		names = append(names, model_name)
+	}
+	sort.Strings(names)
+	return errutil.NewSuggest(pos, name.Pos, name.Value, names,
+		"no model %q defined", name.Value)
+}
+
 func newModelEntry(ast_model *ast.Model) *modelEntry {
 	return &modelEntry{
 		model: &ir.Model{
-			Name: ast_model.Name.Value,
+			Pos:    ast_model.Pos,
+			Doc:    ast_model.Doc,
+			Name:   ast_model.Name.Value,
+			NoLint: transformNoLint(ast_model.NoLint),
 		},
 		ast:    ast_model,
 		fields: make(map[string]*fieldEntry),
@@ -85,6 +116,8 @@ func newModelEntry(ast_model *ast.Model) *modelEntry {
 
 func (m *modelEntry) newFieldEntry(ast_field *ast.Field) *fieldEntry {
 	field := &ir.Field{
+		Pos:   ast_field.Pos,
+		Doc:   ast_field.Doc,
 		Name:  ast_field.Name.Value,
 		Model: m.model,
 	}
@@ -114,8 +147,13 @@ func (m *modelEntry) GetField(name string) *fieldEntry {
 func (m *modelEntry) FindField(ref *ast.RelativeFieldRef) (*ir.Field, error) {
 	field_link := m.fields[ref.Field.Value]
 	if field_link == nil {
-		return nil, errutil.New(ref.Pos, "no field %q defined on model %q",
-			ref.Field.Value, m.model.Name)
+		var names []string
+		for _, field := range m.model.Fields {
+			names = append(names, field.Name)
+		}
+		return nil, errutil.NewSuggest(ref.Pos, ref.Field.Pos,
+			ref.Field.Value, names,
+			"no field %q defined on model %q", ref.Field.Value, m.model.Name)
 	}
 	return field_link.field, nil
 }
@@ -0,0 +1,33 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xform
+
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+func transformNoLint(nolint *ast.NoLint) (rules []*ir.NoLint) {
+	if nolint == nil {
+		return nil
+	}
+	for _, rule := range nolint.Rules {
+		rules = append(rules, &ir.NoLint{
+			Pos:  rule.Pos,
+			Rule: rule.Value,
+		})
+	}
+	return rules
+}
@@ -16,19 +16,25 @@ package xform
 
 import (
 	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
 )
 
 func Transform(ast_root *ast.Root) (root *ir.Root, err error) {
 	lookup := newLookup()
 
+	// models and operations are independent of each other so all of their
+	// errors are collected. operations using a model with errors are
+	// skipped since their errors would only follow from the model's.
+	var diagnostics errutil.Diagnostics
+
 	models, err := transformModels(lookup, ast_root.Models)
-	if err != nil {
-		return nil, err
-	}
-	models, err = ir.SortModels(models)
-	if err != nil {
-		return nil, err
+	diagnostics.Add(err)
+	if err == nil {
+		models, err = ir.SortModels(models)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	root = &ir.Root{
@@ -39,11 +45,14 @@ func Transform(ast_root *ast.Root) (root *ir.Root, err error) {
 	for _, ast_cre := range ast_root.Creates {
 		cre, err := transformCreate(lookup, ast_cre)
 		if err != nil {
-			return nil, err
+			diagnostics.Add(skipFailed(err))
+			continue
 		}
 
 		if existing := create_signatures[cre.Signature()]; existing != nil {
-			return nil, duplicateQuery(ast_cre.Pos, "create", existing.Pos)
+			diagnostics.Add(duplicateQuery(ast_cre.Pos, "create",
+				existing.Pos))
+			continue
 		}
 		create_signatures[cre.Signature()] = ast_cre
 
@@ -54,28 +63,33 @@ func Transform(ast_root *ast.Root) (root *ir.Root, err error) {
 	for _, ast_read := range ast_root.Reads {
 		reads, err := transformRead(lookup, ast_read)
 		if err != nil {
-			return nil, err
+			diagnostics.Add(skipFailed(err))
+			continue
 		}
 
 		for _, read := range reads {
 			if existing := read_signatures[read.Signature()]; existing != nil {
-				return nil, duplicateQuery(ast_read.Pos, "read", existing.Pos)
+				diagnostics.Add(duplicateQuery(ast_read.Pos, "read",
+					existing.Pos))
+				continue
 			}
 			read_signatures[read.Signature()] = ast_read
+			root.Reads = append(root.Reads, read)
 		}
-
-		root.Reads = append(root.Reads, reads...)
 	}
 
 	update_signatures := map[string]*ast.Update{}
 	for _, ast_upd := range ast_root.Updates {
 		upd, err := transformUpdate(lookup, ast_upd)
 		if err != nil {
-			return nil, err
+			diagnostics.Add(skipFailed(err))
+			continue
 		}
 
 		if existing := update_signatures[upd.Signature()]; existing != nil {
-			return nil, duplicateQuery(ast_upd.Pos, "update", existing.Pos)
+			diagnostics.Add(duplicateQuery(ast_upd.Pos, "update",
+				existing.Pos))
+			continue
 		}
 		update_signatures[upd.Signature()] = ast_upd
 
@@ -86,16 +100,31 @@ func Transform(ast_root *ast.Root) (root *ir.Root, err error) {
 	for _, ast_del := range ast_root.Deletes {
 		del, err := transformDelete(lookup, ast_del)
 		if err != nil {
-			return nil, err
+			diagnostics.Add(skipFailed(err))
+			continue
 		}
 
 		if existing := delete_signatures[del.Signature()]; existing != nil {
-			return nil, duplicateQuery(ast_del.Pos, "delete", existing.Pos)
+			diagnostics.Add(duplicateQuery(ast_del.Pos, "delete",
+				existing.Pos))
+			continue
 		}
 		delete_signatures[del.Signature()] = ast_del
 
 		root.Deletes = append(root.Deletes, del)
 	}
 
+	if err := diagnostics.Err(); err != nil {
+		return nil, err
+	}
 	return root, nil
 }
+
+// skipFailed drops the error returned for looking up a model that already
+// reported errors of its own.
+func skipFailed(err error) error {
+	if err == errFailedModel {
+		return nil
+	}
+	return err
+}
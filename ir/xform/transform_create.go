@@ -28,6 +28,8 @@ func transformCreate(lookup *lookup, ast_cre *ast.Create) (
 	}
 
 	cre = &ir.Create{
+		Pos:      ast_cre.Pos,
+		Doc:      ast_cre.Doc,
 		Model:    model,
 		Raw:      ast_cre.Raw.Get(),
 		NoReturn: ast_cre.NoReturn.Get(),
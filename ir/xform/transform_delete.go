@@ -34,8 +34,11 @@ func transformDelete(lookup *lookup, ast_del *ast.Delete) (
 	}
 
 	del = &ir.Delete{
+		Pos:    ast_del.Pos,
+		Doc:    ast_del.Doc,
 		Model:  model,
 		Suffix: transformSuffix(ast_del.Suffix),
+		NoLint: transformNoLint(ast_del.NoLint),
 	}
 
 	models, joins, err := transformJoins(
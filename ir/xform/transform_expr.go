@@ -16,6 +16,7 @@ package xform
 
 import (
 	"fmt"
+	"sort"
 	"text/scanner"
 
 	"gopkg.in/spacemonkeygo/dbx.v1/ast"
@@ -86,6 +87,13 @@ func transformExpr(lookup *lookup, models map[string]scanner.Position,
 		if err != nil {
 			return nil, err
 		}
+		// every operand is bound to a single argument of the generated
+		// method, so a call can only take one value from the caller.
+		if count := func_call.Placeholders(); count > 1 {
+			return nil, errutil.New(ast_expr.Pos,
+				"%s() has %d placeholders; only one placeholder is allowed "+
+					"per operand", func_call.Name, count)
+		}
 		return &ir.Expr{
 			FuncCall: func_call,
 		}, nil
@@ -97,39 +105,39 @@ func transformExpr(lookup *lookup, models map[string]scanner.Position,
 func transformFuncCall(lookup *lookup, models map[string]scanner.Position,
 	ast_func_call *ast.FuncCall, is_left bool) (*ir.FuncCall, error) {
 
-	var args []*ir.Expr
-	for _, ast_expr := range ast_func_call.Args {
-		arg, err := transformExpr(lookup, models, ast_expr, is_left)
-		if err != nil {
-			return nil, err
-		}
-		args = append(args, arg)
-	}
-
-	// TODO: better arg validation (type checks, etc.)
 	name := ast_func_call.Name.Value
-	switch name {
-	case "lower":
-		if err := checkArgs(ast_func_call, args, 1); err != nil {
-			return nil, err
+	check, ok := funcs[name]
+	if !ok {
+		var names []string
+		for func_name := range funcs {
+			names = append(names, func_name)
 		}
-	default:
-		return nil, errutil.New(ast_func_call.Name.Pos,
+		sort.Strings(names)
+		return nil, errutil.NewSuggest(ast_func_call.Name.Pos,
+			ast_func_call.Name.Pos, name, names,
 			"unknown function %q", name)
 	}
 
-	return &ir.FuncCall{
+	// literals are allowed as function arguments on the left side of a where
+	// clause, but placeholders would leave the argument to bind to unclear.
+	func_call := &ir.FuncCall{
 		Name: name,
-		Args: args,
-	}, nil
-}
-
-func checkArgs(ast_func_call *ast.FuncCall, args []*ir.Expr,
-	expected_count int) (err error) {
+	}
+	for _, ast_expr := range ast_func_call.Args {
+		arg, err := transformExpr(lookup, models, ast_expr, false)
+		if err != nil {
+			return nil, err
+		}
+		if is_left && arg.HasPlaceholder() {
+			return nil, errutil.New(ast_expr.Pos,
+				"placeholders are not valid on the left side of a where clause")
+		}
+		func_call.Args = append(func_call.Args, arg)
+	}
 
-	if len(args) != expected_count {
-		return errutil.New(ast_func_call.Pos,
-			"expected %d argument, got %d", expected_count, len(args))
+	if err := check(ast_func_call, func_call); err != nil {
+		return nil, err
 	}
-	return nil
+
+	return func_call, nil
 }
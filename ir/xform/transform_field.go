@@ -31,6 +31,7 @@ func transformField(lookup *lookup, field_entry *fieldEntry) (err error) {
 	field.AutoInsert = ast_field.AutoInsert.Get()
 	field.AutoUpdate = ast_field.AutoUpdate.Get()
 	field.Length = ast_field.Length.Get()
+	field.ProtoNumber = ast_field.ProtoNumber.Get()
 
 	if field.AutoUpdate {
 		field.Updatable = true
@@ -48,9 +49,15 @@ func transformField(lookup *lookup, field_entry *fieldEntry) (err error) {
 				"setnull relationships must be nullable")
 		}
 
+		if ast_field.OnUpdate.Get() == consts.SetNull && !field.Nullable {
+			return errutil.New(ast_field.OnUpdate.Pos,
+				"setnull relationships must be nullable")
+		}
+
 		field.Relation = &ir.Relation{
-			Field: related,
-			Kind:  relation_kind,
+			Field:    related,
+			Kind:     relation_kind,
+			OnUpdate: ast_field.OnUpdate.Get(),
 		}
 		field.Type = related.Type.AsLink()
 	} else {
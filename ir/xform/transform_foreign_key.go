@@ -0,0 +1,84 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xform
+
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+func transformForeignKey(lookup *lookup, model_entry *modelEntry,
+	ast_foreign_key *ast.ForeignKey) (foreign_key *ir.ForeignKey, err error) {
+
+	referenced := lookup.GetModel(ast_foreign_key.Model.Value)
+	if referenced == nil {
+		return nil, lookup.noModel(ast_foreign_key.Model.Pos,
+			ast_foreign_key.Model)
+	}
+
+	fields, err := resolveRelativeFieldRefs(model_entry,
+		ast_foreign_key.Fields.Refs)
+	if err != nil {
+		return nil, err
+	}
+	references, err := resolveRelativeFieldRefs(referenced,
+		ast_foreign_key.References.Refs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) != len(references) {
+		return nil, errutil.New(ast_foreign_key.Pos,
+			"foreignkey has %d fields but references %d fields",
+			len(fields), len(references))
+	}
+	if !referenced.model.FieldSetUnique(references) {
+		return nil, errutil.New(ast_foreign_key.References.Pos,
+			"foreignkey must reference the primary key or a unique set of "+
+				"fields on model %q", referenced.model.Name)
+	}
+
+	for i, field := range fields {
+		reference := references[i]
+		if field.Type != reference.Type &&
+			field.Type != reference.Type.AsLink() {
+			return nil, errutil.New(ast_foreign_key.Fields.Refs[i].Pos,
+				"field %q of type %s cannot reference field %q of type %s",
+				field.Name, field.Type, reference.Name, reference.Type)
+		}
+	}
+
+	foreign_key = &ir.ForeignKey{
+		Fields:     fields,
+		References: references,
+		OnDelete:   ast_foreign_key.OnDelete.Get(),
+		OnUpdate:   ast_foreign_key.OnUpdate.Get(),
+	}
+
+	if foreign_key.OnDelete == consts.SetNull ||
+		foreign_key.OnUpdate == consts.SetNull {
+		for i, field := range fields {
+			if !field.Nullable {
+				return nil, errutil.New(ast_foreign_key.Fields.Refs[i].Pos,
+					"setnull foreignkey field %q must be nullable",
+					field.Name)
+			}
+		}
+	}
+
+	return foreign_key, nil
+}
@@ -0,0 +1,290 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xform
+
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+// funcs maps the name of every supported SQL function to a check that
+// validates the arguments and fills in the result type of the call.
+var funcs = map[string]func(*ast.FuncCall, *ir.FuncCall) error{
+	"lower":      checkCaseFunc,
+	"upper":      checkCaseFunc,
+	"length":     checkLengthFunc,
+	"coalesce":   checkCoalesceFunc,
+	"abs":        checkAbsFunc,
+	"now":        checkNowFunc,
+	"date_trunc": checkDateTruncFunc,
+	"substr":     checkSubstrFunc,
+}
+
+// dateTruncUnits are the units date_trunc can truncate a time to.
+var dateTruncUnits = []string{"year", "month", "day", "hour", "minute"}
+
+func checkCaseFunc(ast_call *ast.FuncCall, call *ir.FuncCall) error {
+	if err := checkArgCount(ast_call, call, 1, 1); err != nil {
+		return err
+	}
+	if err := checkArgType(ast_call, call, 0, "text", isText); err != nil {
+		return err
+	}
+	call.Type = consts.TextField
+	call.Field = call.Args[0].ResultField()
+	call.Nullable = call.Args[0].Nullable()
+	return nil
+}
+
+func checkLengthFunc(ast_call *ast.FuncCall, call *ir.FuncCall) error {
+	if err := checkArgCount(ast_call, call, 1, 1); err != nil {
+		return err
+	}
+	err := checkArgType(ast_call, call, 0, "text or blob",
+		func(t consts.FieldType) bool {
+			return isText(t) || t == consts.BlobField
+		})
+	if err != nil {
+		return err
+	}
+	call.Type = consts.Int64Field
+	return nil
+}
+
+func checkCoalesceFunc(ast_call *ast.FuncCall, call *ir.FuncCall) error {
+	if err := checkArgCount(ast_call, call, 2, -1); err != nil {
+		return err
+	}
+
+	// every argument with a type has to agree with the first one.
+	first := -1
+	call.Nullable = true
+	for i, arg := range call.Args {
+		call.Nullable = call.Nullable && arg.Nullable()
+		typ, ok := arg.Type()
+		if !ok {
+			continue
+		}
+		if first == -1 {
+			first = i
+			call.Type = typ
+			call.Field = arg.ResultField()
+			continue
+		}
+		if !sameTypeClass(call.Type, typ) {
+			return errutil.New(ast_call.Args[i].Pos,
+				"coalesce argument %d has type %s; expected %s like "+
+					"argument %d", i+1, typ, call.Type, first+1)
+		}
+	}
+	if first == -1 {
+		return errutil.New(ast_call.Pos,
+			"coalesce needs at least one argument with a type")
+	}
+	if call.Field == nil {
+		call.Nullable = false
+	}
+	return nil
+}
+
+func checkAbsFunc(ast_call *ast.FuncCall, call *ir.FuncCall) error {
+	if err := checkArgCount(ast_call, call, 1, 1); err != nil {
+		return err
+	}
+	err := checkArgType(ast_call, call, 0, "numeric", isNumeric)
+	if err != nil {
+		return err
+	}
+	typ, ok := call.Args[0].Type()
+	if !ok {
+		typ = consts.Float64Field
+	}
+	call.Type = typ.AsLink()
+	call.Field = call.Args[0].ResultField()
+	call.Nullable = call.Args[0].Nullable()
+	return nil
+}
+
+func checkNowFunc(ast_call *ast.FuncCall, call *ir.FuncCall) error {
+	if err := checkArgCount(ast_call, call, 0, 0); err != nil {
+		return err
+	}
+	call.Type = consts.TimestampField
+	return nil
+}
+
+func checkDateTruncFunc(ast_call *ast.FuncCall, call *ir.FuncCall) error {
+	if err := checkArgCount(ast_call, call, 2, 2); err != nil {
+		return err
+	}
+
+	unit := call.Args[0].StringLit
+	if unit == nil || !stringIn(*unit, dateTruncUnits) {
+		return errutil.New(ast_call.Args[0].Pos,
+			"date_trunc unit must be one of %q", dateTruncUnits)
+	}
+
+	err := checkArgType(ast_call, call, 1, "a time", isTime)
+	if err != nil {
+		return err
+	}
+	typ, ok := call.Args[1].Type()
+	if !ok {
+		typ = consts.TimestampField
+	}
+	call.Type = typ
+	call.Field = call.Args[1].ResultField()
+	call.Nullable = call.Args[1].Nullable()
+	return nil
+}
+
+func checkSubstrFunc(ast_call *ast.FuncCall, call *ir.FuncCall) error {
+	if err := checkArgCount(ast_call, call, 2, 3); err != nil {
+		return err
+	}
+	if err := checkArgType(ast_call, call, 0, "text", isText); err != nil {
+		return err
+	}
+	for i := 1; i < len(call.Args); i++ {
+		err := checkArgType(ast_call, call, i, "an integer", isInteger)
+		if err != nil {
+			return err
+		}
+	}
+	call.Type = consts.TextField
+	call.Field = call.Args[0].ResultField()
+	call.Nullable = call.Args[0].Nullable()
+	return nil
+}
+
+// checkArgCount makes sure the call has between min and max arguments. A
+// negative max means there is no upper bound.
+func checkArgCount(ast_call *ast.FuncCall, call *ir.FuncCall,
+	min, max int) error {
+
+	count := len(call.Args)
+	switch {
+	case min == max && count != min:
+		return errutil.New(ast_call.Pos,
+			"%s expects %d argument(s), got %d", call.Name, min, count)
+	case count < min:
+		return errutil.New(ast_call.Pos,
+			"%s expects at least %d argument(s), got %d",
+			call.Name, min, count)
+	case max >= 0 && count > max:
+		return errutil.New(ast_call.Pos,
+			"%s expects at most %d argument(s), got %d",
+			call.Name, max, count)
+	}
+	return nil
+}
+
+// checkImmutable makes sure the expression only calls functions whose result
+// depends on nothing but their arguments, as postgres requires of index
+// expressions and partial index wheres. now() changes over time and
+// date_trunc() depends on the session time zone unless it truncates a
+// utimestamp.
+func checkImmutable(ast_expr *ast.Expr, expr *ir.Expr) error {
+	if expr.FuncCall == nil {
+		return nil
+	}
+	call := expr.FuncCall
+	switch call.Name {
+	case "now":
+		return errutil.New(ast_expr.Pos,
+			"now() is not immutable and cannot be used in an index")
+	case "date_trunc":
+		if typ, _ := call.Args[1].Type(); typ != consts.TimestampUTCField {
+			return errutil.New(ast_expr.Pos,
+				"date_trunc() of a %s is not immutable and cannot be "+
+					"used in an index; use a %s", typ,
+				consts.TimestampUTCField)
+		}
+	}
+	for i, arg := range call.Args {
+		if err := checkImmutable(ast_expr.FuncCall.Args[i], arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkArgType makes sure the i'th argument of the call has a type accepted
+// by ok. Arguments without a type, like placeholders, are always accepted.
+func checkArgType(ast_call *ast.FuncCall, call *ir.FuncCall, i int,
+	kind string, ok func(consts.FieldType) bool) error {
+
+	typ, known := call.Args[i].Type()
+	if known && !ok(typ) {
+		return errutil.New(ast_call.Args[i].Pos,
+			"%s argument %d must be %s; got %s", call.Name, i+1, kind, typ)
+	}
+	return nil
+}
+
+func isText(t consts.FieldType) bool {
+	return t == consts.TextField
+}
+
+func isInteger(t consts.FieldType) bool {
+	switch t {
+	case consts.SerialField, consts.Serial64Field,
+		consts.IntField, consts.Int64Field,
+		consts.UintField, consts.Uint64Field:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNumeric(t consts.FieldType) bool {
+	switch t {
+	case consts.FloatField, consts.Float64Field:
+		return true
+	default:
+		return isInteger(t)
+	}
+}
+
+func isTime(t consts.FieldType) bool {
+	switch t {
+	case consts.TimestampField, consts.TimestampUTCField, consts.DateField:
+		return true
+	default:
+		return false
+	}
+}
+
+func sameTypeClass(a, b consts.FieldType) bool {
+	switch {
+	case isNumeric(a):
+		return isNumeric(b)
+	case isTime(a):
+		return isTime(b)
+	default:
+		return a == b
+	}
+}
+
+func stringIn(s string, set []string) bool {
+	for _, v := range set {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -16,6 +16,7 @@ package xform
 
 import (
 	"fmt"
+	"text/scanner"
 
 	"bitbucket.org/pkg/inflect"
 	"gopkg.in/spacemonkeygo/dbx.v1/ast"
@@ -50,6 +51,10 @@ func transformModel(lookup *lookup, model_entry *modelEntry) (err error) {
 		column_names[field.Column] = ast_field
 	}
 
+	if err := checkProtoNumbers(ast_model); err != nil {
+		return err
+	}
+
 	if ast_model.PrimaryKey == nil || len(ast_model.PrimaryKey.Refs) == 0 {
 		return errutil.New(ast_model.Pos, "no primary key defined")
 	}
@@ -84,25 +89,17 @@ func transformModel(lookup *lookup, model_entry *modelEntry) (err error) {
 	for _, ast_index := range ast_model.Indexes {
 		// BUG(jeff): we can only have one index without a name specified when
 		// really we want to pick a name for them that won't collide.
-		if ast_index.Fields == nil || len(ast_index.Fields.Refs) < 1 {
+		if len(ast_index.Columns) < 1 {
 			return errutil.New(ast_index.Pos,
 				"index %q has no fields defined",
 				ast_index.Name.Get())
 		}
 
-		fields, err := resolveRelativeFieldRefs(
-			model_entry, ast_index.Fields.Refs)
+		index, err := transformIndex(lookup, model_entry, ast_index)
 		if err != nil {
 			return err
 		}
 
-		index := &ir.Index{
-			Name:   ast_index.Name.Get(),
-			Model:  fields[0].Model,
-			Fields: fields,
-			Unique: ast_index.Unique.Get(),
-		}
-
 		if index.Name == "" {
 			index.Name = DefaultIndexName(index)
 		}
@@ -117,5 +114,199 @@ func transformModel(lookup *lookup, model_entry *modelEntry) (err error) {
 		model.Indexes = append(model.Indexes, index)
 	}
 
+	for _, ast_field := range ast_model.Fields {
+		if ast_field.Check == nil {
+			continue
+		}
+		field := model_entry.GetField(ast_field.Name.Value).field
+		check, err := transformCheck(lookup, model_entry, ast_field.Check,
+			field)
+		if err != nil {
+			return err
+		}
+		check.Name = fmt.Sprintf("%s_%s_check", model.Table, field.Column)
+		model.Checks = append(model.Checks, check)
+	}
+
+	for i, ast_check := range ast_model.Checks {
+		check, err := transformCheck(lookup, model_entry, ast_check, nil)
+		if err != nil {
+			return err
+		}
+		check.Name = fmt.Sprintf("%s_check_%d", model.Table, i+1)
+		model.Checks = append(model.Checks, check)
+	}
+
+	return nil
+}
+
+func transformCheck(lookup *lookup, model_entry *modelEntry,
+	ast_check *ast.Check, field *ir.Field) (check *ir.Check, err error) {
+
+	check = &ir.Check{
+		Field: field,
+	}
+
+	// checks may only refer to the fields of their model
+	models := map[string]scanner.Position{
+		model_entry.model.Name: ast_check.Pos,
+	}
+
+	for _, ast_where := range ast_check.Wheres {
+		var where *ir.Where
+		if field != nil {
+			where = &ir.Where{
+				Pos:  ast_where.Pos,
+				Left: &ir.Expr{Field: field},
+				Op:   ast_where.Op.Value,
+			}
+			where.Right, err = transformExpr(lookup, models, ast_where.Right,
+				false)
+			if err == nil && ast_where.Upper != nil {
+				where.Upper, err = transformExpr(lookup, models,
+					ast_where.Upper, false)
+			}
+		} else {
+			where, err = transformWhere(lookup, models, ast_where)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, operand := range where.Operands() {
+			if operand.HasPlaceholder() {
+				return nil, errutil.New(ast_where.Pos,
+					"check cannot use placeholders")
+			}
+		}
+		check.Wheres = append(check.Wheres, where)
+	}
+
+	return check, nil
+}
+
+// indexMethods are the methods an index can be created "using".
+var indexMethods = []string{"btree", "hash", "gin", "gist", "brin"}
+
+func transformIndex(lookup *lookup, model_entry *modelEntry,
+	ast_index *ast.Index) (index *ir.Index, err error) {
+
+	index = &ir.Index{
+		Pos:    ast_index.Pos,
+		Name:   ast_index.Name.Get(),
+		Model:  model_entry.model,
+		Unique: ast_index.Unique.Get(),
+		Using:  ast_index.Using.Get(),
+	}
+
+	// expressions and where clauses may only refer to the indexed model
+	models := map[string]scanner.Position{
+		model_entry.model.Name: ast_index.Pos,
+	}
+
+	for _, ast_column := range ast_index.Columns {
+		column := &ir.IndexColumn{
+			Descending: ast_column.Descending.Get(),
+		}
+		if ast_column.Field != nil {
+			field, err := model_entry.FindField(ast_column.Field)
+			if err != nil {
+				return nil, err
+			}
+			column.Expr = &ir.Expr{Field: field}
+		} else {
+			column.Expr, err = transformExpr(lookup, models, ast_column.Expr,
+				true)
+			if err != nil {
+				return nil, err
+			}
+			err = checkImmutable(ast_column.Expr, column.Expr)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if column.Descending &&
+			index.Using != "" && index.Using != "btree" {
+			return nil, errutil.New(ast_column.Descending.Pos,
+				"index using %q cannot have descending columns",
+				index.Using)
+		}
+		index.Columns = append(index.Columns, column)
+	}
+
+	index.Where, err = transformWheres(lookup, models, ast_index.Where)
+	if err != nil {
+		return nil, err
+	}
+	for i, where := range index.Where {
+		for _, operand := range where.Operands() {
+			if operand.HasPlaceholder() {
+				return nil, errutil.New(ast_index.Where[i].Pos,
+					"index where clause cannot use placeholders")
+			}
+		}
+
+		ast_where := ast_index.Where[i]
+		err := checkImmutable(ast_where.Left, where.Left)
+		if err == nil {
+			err = checkImmutable(ast_where.Right, where.Right)
+		}
+		if err == nil && where.Upper != nil {
+			err = checkImmutable(ast_where.Upper, where.Upper)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if index.Using != "" {
+		if !stringIn(index.Using, indexMethods) {
+			return nil, errutil.New(ast_index.Using.Pos,
+				"index using must be one of %q", indexMethods)
+		}
+		if index.Unique && index.Using != "btree" {
+			return nil, errutil.New(ast_index.Using.Pos,
+				"unique index cannot use %q", index.Using)
+		}
+	}
+
+	return index, nil
+}
+
+// checkProtoNumbers makes sure that either every field of the model has a
+// valid and distinct protobuf field number or none of them do, in which case
+// they are numbered by position.
+func checkProtoNumbers(ast_model *ast.Model) error {
+	numbered := map[int]*ast.Field{}
+	for _, ast_field := range ast_model.Fields {
+		number := ast_field.ProtoNumber
+		if number == nil {
+			continue
+		}
+		value := number.Value
+		if value < 1 || value > 536870911 ||
+			(value >= 19000 && value <= 19999) {
+			return errutil.New(number.Pos,
+				"proto field number %d is not a valid protobuf field number",
+				value)
+		}
+		if existing := numbered[value]; existing != nil {
+			return errutil.New(number.Pos,
+				"proto field number %d already used by field %q at %s",
+				value, existing.Name.Get(), existing.Pos)
+		}
+		numbered[value] = ast_field
+	}
+	if len(numbered) == 0 || len(numbered) == len(ast_model.Fields) {
+		return nil
+	}
+	for _, ast_field := range ast_model.Fields {
+		if ast_field.ProtoNumber == nil {
+			return errutil.New(ast_field.Pos,
+				"field %q needs a proto number since other fields of "+
+					"model %q have one", ast_field.Name.Get(),
+				ast_model.Name.Get())
+		}
+	}
 	return nil
 }
@@ -23,16 +23,26 @@ import (
 func transformModels(lookup *lookup, ast_models []*ast.Model) (
 	models []*ir.Model, err error) {
 
+	// errors in one model do not stop the others from being checked, but
+	// models with errors are left out of the later steps and marked failed
+	// in the lookup so that operations using them are skipped. the models
+	// without errors are returned either way.
+	var diagnostics errutil.Diagnostics
+	failed := map[*ast.Model]bool{}
+
 	// step 1. create all the Model and Field instances and set their pointers
 	// to point at each other appropriately.
 	for _, ast_model := range ast_models {
 		link, err := lookup.AddModel(ast_model)
 		if err != nil {
-			return nil, err
+			diagnostics.Add(err)
+			failed[ast_model] = true
+			continue
 		}
 		for _, ast_field := range ast_model.Fields {
 			if err := link.AddField(ast_field); err != nil {
-				return nil, err
+				diagnostics.Add(err)
+				failed[ast_model] = true
 			}
 		}
 	}
@@ -41,22 +51,56 @@ func transformModels(lookup *lookup, ast_models []*ast.Model) (
 	// including references between them. also check for duplicate table names.
 	table_names := map[string]*ast.Model{}
 	for _, ast_model := range ast_models {
+		if failed[ast_model] {
+			continue
+		}
 		model_entry := lookup.GetModel(ast_model.Name.Value)
 		if err := transformModel(lookup, model_entry); err != nil {
-			return nil, err
+			diagnostics.Add(err)
+			failed[ast_model] = true
+			continue
 		}
 
 		model := model_entry.model
 
 		if existing := table_names[model.Table]; existing != nil {
-			return nil, errutil.New(ast_model.Pos,
+			diagnostics.Add(errutil.New(ast_model.Pos,
 				"table %q already used by model %q (%s)",
-				model.Table, existing.Name.Get(), existing.Pos)
+				model.Table, existing.Name.Get(), existing.Pos))
+			continue
 		}
 		table_names[model.Table] = ast_model
 
 		models = append(models, model_entry.model)
 	}
 
-	return models, nil
+	// step 3. resolve foreign keys now that every model knows its primary
+	// key and unique constraints.
+	for _, ast_model := range ast_models {
+		if failed[ast_model] {
+			continue
+		}
+		model_entry := lookup.GetModel(ast_model.Name.Value)
+		for _, ast_foreign_key := range ast_model.ForeignKeys {
+			foreign_key, err := transformForeignKey(lookup, model_entry,
+				ast_foreign_key)
+			if err != nil {
+				diagnostics.Add(err)
+				failed[ast_model] = true
+				continue
+			}
+			model_entry.model.ForeignKeys = append(
+				model_entry.model.ForeignKeys, foreign_key)
+		}
+	}
+
+	for ast_model := range failed {
+		// a duplicate model never got an entry of its own.
+		model_entry := lookup.GetModel(ast_model.Name.Value)
+		if model_entry != nil && model_entry.ast == ast_model {
+			model_entry.failed = true
+		}
+	}
+
+	return models, diagnostics.Err()
 }
@@ -24,7 +24,10 @@ func transformRead(lookup *lookup, ast_read *ast.Read) (
 	reads []*ir.Read, err error) {
 
 	tmpl := &ir.Read{
+		Pos:    ast_read.Pos,
+		Doc:    ast_read.Doc,
 		Suffix: transformSuffix(ast_read.Suffix),
+		NoLint: transformNoLint(ast_read.NoLint),
 	}
 
 	if ast_read.Select == nil || len(ast_read.Select.Refs) == 0 {
@@ -114,21 +117,33 @@ func transformRead(lookup *lookup, ast_read *ast.Read) (
 
 	// Finalize OrderBy and make sure referenced fields are part of the select
 	if ast_read.OrderBy != nil {
-		fields, err := resolveFieldRefs(lookup, ast_read.OrderBy.Fields.Refs)
-		if err != nil {
-			return nil, err
-		}
-		for _, order_by_field := range ast_read.OrderBy.Fields.Refs {
+		tmpl.OrderBy = new(ir.OrderBy)
+		seen := map[*ir.Field]bool{}
+		for _, ast_entry := range ast_read.OrderBy.Entries {
+			order_by_field := ast_entry.Field
+			field, err := lookup.FindField(order_by_field)
+			if err != nil {
+				return nil, err
+			}
 			if _, ok := models[order_by_field.Model.Value]; !ok {
 				return nil, errutil.New(order_by_field.Pos,
 					"invalid orderby field %q; model %q is not joined",
 					order_by_field, order_by_field.Model.Value)
 			}
-		}
+			if seen[field] {
+				return nil, errutil.New(order_by_field.Pos,
+					"field %q ordered more than once", order_by_field)
+			}
+			seen[field] = true
 
-		tmpl.OrderBy = &ir.OrderBy{
-			Fields:     fields,
-			Descending: ast_read.OrderBy.Descending.Get(),
+			entry := &ir.OrderByEntry{
+				Field:      field,
+				Descending: ast_entry.Descending.Get(),
+			}
+			if ast_entry.Nulls != nil {
+				entry.Nulls = ast_entry.Nulls.Value
+			}
+			tmpl.OrderBy.Entries = append(tmpl.OrderBy.Entries, entry)
 		}
 	}
 
@@ -151,6 +166,18 @@ func transformRead(lookup *lookup, ast_read *ast.Read) (
 		}
 	}
 
+	if ast_read.Lock != nil {
+		if tmpl.GroupBy != nil {
+			return nil, errutil.New(ast_read.Lock.Pos,
+				"cannot lock rows of a read with group by")
+		}
+		tmpl.Lock = &ir.Lock{
+			Pos:        ast_read.Lock.Pos,
+			Mode:       ast_read.Lock.Mode.Value,
+			SkipLocked: ast_read.Lock.SkipLocked.Get(),
+		}
+	}
+
 	// Now emit one select per view type (or one for all if unspecified)
 	view := ast_read.View
 	if view == nil {
@@ -176,6 +203,10 @@ func transformRead(lookup *lookup, ast_read *ast.Read) (
 		addView(ir.All)
 	}
 	if view.Count.Get() {
+		if tmpl.Lock != nil {
+			return nil, errutil.New(view.Count.Pos,
+				"cannot use count view with lock")
+		}
 		addView(ir.Count)
 	}
 	if view.Has.Get() {
@@ -223,6 +254,66 @@ func transformRead(lookup *lookup, ast_read *ast.Read) (
 	if view.First.Get() {
 		addView(ir.First)
 	}
+	if view.Iterate.Get() {
+		if tmpl.Distinct() {
+			return nil, errutil.New(view.Iterate.Pos,
+				"cannot use iterate view with distinct read")
+		}
+		addView(ir.Iterate)
+	}
+	if view.Dynamic.Get() {
+		if tmpl.Distinct() {
+			return nil, errutil.New(view.Dynamic.Pos,
+				"cannot use dynamic view with distinct read")
+		}
+		if tmpl.OrderBy == nil {
+			return nil, errutil.New(view.Dynamic.Pos,
+				"dynamic view requires an orderby of the sortable fields")
+		}
+		if tmpl.GroupBy != nil {
+			return nil, errutil.New(view.Dynamic.Pos,
+				"cannot use dynamic view with group by")
+		}
+		if err := checkDynamicWheres(ast_read.Where, tmpl.Where); err != nil {
+			return nil, err
+		}
+		addView(ir.Dynamic)
+	}
 
 	return reads, nil
 }
+
+// checkDynamicWheres makes sure no two wheres with arguments would get the
+// same options field, which is named after the left side and the operator.
+func checkDynamicWheres(ast_wheres []*ast.Where, wheres []*ir.Where) error {
+	seen := map[string]*ast.Where{}
+	for i, where := range wheres {
+		if !where.Right.HasPlaceholder() &&
+			(where.Upper == nil || !where.Upper.HasPlaceholder()) {
+			continue
+		}
+		key := dynamicWhereKey(where.Left) + " " + string(where.Op)
+		if existing := seen[key]; existing != nil {
+			return errutil.New(ast_wheres[i].Pos,
+				"where %q would share an option with where %q (%s) in "+
+					"a dynamic view", ast_wheres[i], existing, existing.Pos)
+		}
+		seen[key] = ast_wheres[i]
+	}
+	return nil
+}
+
+func dynamicWhereKey(expr *ir.Expr) string {
+	if field := expr.ResultField(); field != nil {
+		return field.Model.Name + "." + field.Name
+	}
+	if expr.FuncCall == nil {
+		return ""
+	}
+	for _, arg := range expr.FuncCall.Args {
+		if arg.Field != nil || arg.FuncCall != nil {
+			return dynamicWhereKey(arg) + " " + expr.FuncCall.Name
+		}
+	}
+	return expr.FuncCall.Name
+}
@@ -39,8 +39,11 @@ func transformUpdate(lookup *lookup, ast_upd *ast.Update) (
 
 	upd = &ir.Update{
 		Model:    model,
+		Pos:      ast_upd.Pos,
+		Doc:      ast_upd.Doc,
 		NoReturn: ast_upd.NoReturn.Get(),
 		Suffix:   transformSuffix(ast_upd.Suffix),
+		NoLint:   transformNoLint(ast_upd.NoLint),
 	}
 
 	models, joins, err := transformJoins(
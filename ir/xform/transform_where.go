@@ -47,9 +47,20 @@ func transformWhere(lookup *lookup, models map[string]scanner.Position,
 		return nil, err
 	}
 
-	return &ir.Where{
+	where = &ir.Where{
+		Pos:   ast_where.Pos,
 		Left:  lexpr,
 		Op:    ast_where.Op.Value,
 		Right: rexpr,
-	}, nil
+	}
+
+	if ast_where.Upper != nil {
+		where.Upper, err = transformExpr(lookup, models, ast_where.Upper,
+			false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return where, nil
 }
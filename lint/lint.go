@@ -0,0 +1,372 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lint checks a dbx schema for likely performance and safety
+// problems that are not errors.
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"text/scanner"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+// The lint rules, which are suppressed by listing them in a nolint attribute
+// on the model or operation they are reported for.
+const (
+	// Unindexed reports where clauses and orderbys that no primary key,
+	// unique constraint or index can be used for.
+	Unindexed = "unindexed"
+
+	// Unordered reports reads returning many rows without an orderby.
+	Unordered = "unordered"
+
+	// LikeWildcard reports like patterns starting with a wildcard.
+	LikeWildcard = "like_wildcard"
+
+	// NullableUnique reports nullable fields in unique constraints.
+	NullableUnique = "nullable_unique"
+
+	// ForeignKeyIndex reports foreign keys without an index on the
+	// referencing fields.
+	ForeignKeyIndex = "foreignkey_index"
+)
+
+var Rules = []string{
+	ForeignKeyIndex,
+	LikeWildcard,
+	NullableUnique,
+	Unindexed,
+	Unordered,
+}
+
+// Lint returns the warnings for root sorted by position, or nil if there are
+// none. Suppressing an unknown rule is reported as an error.
+func Lint(root *ir.Root) error {
+	l := &linter{
+		seen:         map[string]bool{},
+		seen_nolints: map[*ir.NoLint]bool{},
+	}
+	for _, model := range root.Models {
+		l.lintModel(model)
+	}
+	for _, read := range root.Reads {
+		l.lintRead(read)
+	}
+	for _, upd := range root.Updates {
+		l.lintWheres(upd.NoLint, upd.Pos, "update", upd.Where)
+	}
+	for _, del := range root.Deletes {
+		l.lintWheres(del.NoLint, del.Pos, "delete", del.Where)
+	}
+	return l.diagnostics.Err()
+}
+
+type linter struct {
+	diagnostics errutil.Diagnostics
+
+	// a read with many views is many ir.Reads sharing a position, so
+	// warnings are only reported once.
+	seen         map[string]bool
+	seen_nolints map[*ir.NoLint]bool
+}
+
+func (l *linter) warn(nolint []*ir.NoLint, rule string, pos scanner.Position,
+	format string, args ...interface{}) {
+
+	for _, suppressed := range nolint {
+		if suppressed.Rule == rule {
+			return
+		}
+	}
+
+	message := fmt.Sprintf(format, args...)
+	key := fmt.Sprintf("%s: %s", pos, message)
+	if l.seen[key] {
+		return
+	}
+	l.seen[key] = true
+
+	l.diagnostics.Add(errutil.NewWarning(pos, "%s (%s)", message, rule))
+}
+
+// checkNoLint reports any unknown rules in nolint.
+func (l *linter) checkNoLint(nolint []*ir.NoLint) {
+	for _, suppressed := range nolint {
+		if l.seen_nolints[suppressed] || knownRule(suppressed.Rule) {
+			continue
+		}
+		l.seen_nolints[suppressed] = true
+		l.diagnostics.Add(errutil.NewSuggest(suppressed.Pos, suppressed.Pos,
+			suppressed.Rule, Rules, "unknown lint rule %q", suppressed.Rule))
+	}
+}
+
+func knownRule(rule string) bool {
+	for _, known := range Rules {
+		if known == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *linter) lintModel(model *ir.Model) {
+	l.checkNoLint(model.NoLint)
+
+	uniques := model.Unique
+	for _, index := range model.Indexes {
+		if index.Unique {
+			uniques = append(uniques, index.Fields())
+		}
+	}
+	for _, unique := range uniques {
+		for _, field := range unique {
+			if field.Nullable {
+				l.warn(model.NoLint, NullableUnique, field.Pos,
+					"nullable field %q is part of a unique constraint but "+
+						"rows where it is null never conflict", field.Name)
+			}
+		}
+	}
+
+	leading := leadingColumns(model)
+	for _, field := range model.Fields {
+		if field.Relation != nil && !leading[exprKey(&ir.Expr{Field: field})] {
+			l.warn(model.NoLint, ForeignKeyIndex, field.Pos,
+				"foreign key field %q has no index, so deletes and updates "+
+					"of %q scan %q", field.Name,
+				field.Relation.Field.Model.Name, model.Name)
+		}
+	}
+	for _, foreign_key := range model.ForeignKeys {
+		first := foreign_key.Fields[0]
+		if !leading[exprKey(&ir.Expr{Field: first})] {
+			l.warn(model.NoLint, ForeignKeyIndex, first.Pos,
+				"foreignkey on %s has no index, so deletes and updates "+
+					"of %q scan %q", fieldNames(foreign_key.Fields),
+				foreign_key.ReferencedModel().Name, model.Name)
+		}
+	}
+}
+
+func (l *linter) lintRead(read *ir.Read) {
+	l.checkNoLint(read.NoLint)
+
+	// dynamic reads get their where clause and orderby at runtime.
+	if read.View == ir.Dynamic {
+		return
+	}
+
+	l.lintWheres(read.NoLint, read.Pos, "read", read.Where)
+
+	switch read.View {
+	case ir.All, ir.LimitOffset, ir.First:
+		if read.OrderBy == nil {
+			l.warn(read.NoLint, Unordered, read.Pos,
+				"%s read of %q has no orderby so the order of its rows "+
+					"is unspecified", read.View, read.From.Name)
+		}
+	}
+
+	// paged reads are always ordered by the primary key and ignore the
+	// orderby.
+	if read.OrderBy != nil && read.View != ir.Paged &&
+		read.View != ir.Count && read.View != ir.Has {
+
+		if first := unorderedField(read); first != nil {
+			l.warn(read.NoLint, Unindexed, read.Pos,
+				"read of %q orders by %q which is not covered by an index "+
+					"along with its where clause, so every matching row is "+
+					"sorted", read.From.Name, first.Name)
+		}
+	}
+}
+
+// unorderedField returns the first orderby field of the read that no index
+// returns in order, or nil if one does. An index returns rows in order if its
+// columns start with fields the where clause compares for equality followed
+// by the orderby fields that are not fixed that way.
+func unorderedField(read *ir.Read) *ir.Field {
+	fixed := map[string]bool{}
+	for _, where := range read.Where {
+		if where.Op == consts.EQ && !where.Right.Null {
+			fixed[exprKey(where.Left)] = true
+		}
+	}
+
+	var first *ir.Field
+	for _, entry := range read.OrderBy.Entries {
+		if !fixed[exprKey(&ir.Expr{Field: entry.Field})] {
+			first = entry.Field
+			break
+		}
+	}
+	if first == nil {
+		return nil
+	}
+
+	for _, columns := range indexColumns(first.Model) {
+		i := 0
+		for i < len(columns) && fixed[columns[i]] {
+			i++
+		}
+		if i < len(columns) && columns[i] == exprKey(&ir.Expr{Field: first}) {
+			return nil
+		}
+	}
+	return first
+}
+
+// lintWheres checks that for each model a where clause filters, at least one
+// filter can use an index and that like patterns can use one.
+func (l *linter) lintWheres(nolint []*ir.NoLint, pos scanner.Position,
+	kind string, wheres []*ir.Where) {
+
+	l.checkNoLint(nolint)
+
+	var models []*ir.Model
+	filtered := map[*ir.Model][]string{}
+	covered := map[*ir.Model]bool{}
+	for _, where := range wheres {
+		model := exprModel(where.Left)
+		if model == nil {
+			continue
+		}
+
+		if where.Op == consts.Like || where.Op == consts.ILike {
+			if where.Right.StringLit != nil &&
+				strings.IndexAny(*where.Right.StringLit, "%_") == 0 {
+				l.warn(nolint, LikeWildcard, where.Pos,
+					"%s like pattern %q starts with a wildcard so it "+
+						"cannot use an index", kind, *where.Right.StringLit)
+				continue
+			}
+		}
+
+		if _, ok := filtered[model]; !ok {
+			models = append(models, model)
+		}
+		filtered[model] = append(filtered[model], exprString(where.Left))
+
+		if sargable(where) && leadingColumns(model)[exprKey(where.Left)] {
+			covered[model] = true
+		}
+	}
+
+	for _, model := range models {
+		if !covered[model] {
+			l.warn(nolint, Unindexed, pos,
+				"%s filters %q on %s which is not covered by a primary "+
+					"key, unique constraint or index", kind, model.Name,
+				strings.Join(filtered[model], ", "))
+		}
+	}
+}
+
+// sargable returns if an index on the left side of the where can be used to
+// find the matching rows.
+func sargable(where *ir.Where) bool {
+	switch where.Op {
+	case consts.EQ, consts.LT, consts.LE, consts.GT, consts.GE,
+		consts.Between, consts.Like:
+		return true
+	default:
+		return false
+	}
+}
+
+// leadingColumns returns the keys of the expressions that an index on model
+// can be used for by themselves: the first column of the primary key, of
+// each unique constraint and of each index.
+func leadingColumns(model *ir.Model) map[string]bool {
+	leading := map[string]bool{}
+	for _, columns := range indexColumns(model) {
+		leading[columns[0]] = true
+	}
+	return leading
+}
+
+// indexColumns returns the keys of the columns of the primary key, each
+// unique constraint and each index of model.
+func indexColumns(model *ir.Model) (out [][]string) {
+	fieldKeys := func(fields []*ir.Field) (keys []string) {
+		for _, field := range fields {
+			keys = append(keys, exprKey(&ir.Expr{Field: field}))
+		}
+		return keys
+	}
+	if len(model.PrimaryKey) > 0 {
+		out = append(out, fieldKeys(model.PrimaryKey))
+	}
+	for _, unique := range model.Unique {
+		out = append(out, fieldKeys(unique))
+	}
+	for _, index := range model.Indexes {
+		var keys []string
+		for _, column := range index.Columns {
+			keys = append(keys, exprKey(column.Expr))
+		}
+		out = append(out, keys)
+	}
+	return out
+}
+
+// exprModel returns the model of the field the expression is on, if any.
+func exprModel(expr *ir.Expr) *ir.Model {
+	switch {
+	case expr.Field != nil:
+		return expr.Field.Model
+	case expr.FuncCall != nil:
+		for _, arg := range expr.FuncCall.Args {
+			if model := exprModel(arg); model != nil {
+				return model
+			}
+		}
+	}
+	return nil
+}
+
+// exprKey returns a string identifying the fields and functions of an
+// expression.
+func exprKey(expr *ir.Expr) string {
+	switch {
+	case expr.Field != nil:
+		return expr.Field.Model.Name + "." + expr.Field.Name
+	case expr.FuncCall != nil:
+		var args []string
+		for _, arg := range expr.FuncCall.Args {
+			args = append(args, exprKey(arg))
+		}
+		return expr.FuncCall.Name + "(" + strings.Join(args, ", ") + ")"
+	default:
+		return ""
+	}
+}
+
+func exprString(expr *ir.Expr) string {
+	return fmt.Sprintf("%q", exprKey(expr))
+}
+
+func fieldNames(fields []*ir.Field) string {
+	var names []string
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+)
+
+const lintSource = `
+model user (
+	key   pk
+	unique email
+	field pk    serial64
+	field email text ( nullable )
+	field name  text
+)
+
+model post (
+	key pk
+	index ( fields author )
+	field pk     serial64
+	field author user.pk cascade
+	field title  text
+)
+
+read all (
+	select user
+	where user.name = ?
+)
+
+read all (
+	select post
+	where post.title like "%rust%"
+	orderby asc post.pk
+	nolint unordered
+)
+
+read all (
+	select post
+	where post.author = ?
+	orderby asc post.pk
+)
+
+read all (
+	select post
+	where post.title = ?
+	orderby asc post.title
+	nolint unindexed
+)
+
+read all (
+	select post
+	where post.author = ?
+	orderby asc post.title
+)
+`
+
+func TestLint(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	ast_root, err := syntax.Parse("lint.dbx", []byte(lintSource))
+	tw.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	tw.AssertNoError(err)
+
+	var warnings []string
+	for _, err := range errutil.Errors(Lint(root)) {
+		if errutil.GetSeverity(err) != "warning" {
+			tw.Fatalf("unexpected error: %v", err)
+		}
+		warnings = append(warnings, err.Error())
+	}
+
+	expected := []string{
+		"lint.dbx:6:2: nullable field \"email\"",
+		"lint.dbx:18:1: read filters \"user\" on \"user.name\"",
+		"lint.dbx:18:1: all read of \"user\" has no orderby",
+		"lint.dbx:25:2: read like pattern \"%rust%\"",
+		"lint.dbx:43:1: read of \"post\" orders by \"title\"",
+	}
+	if len(warnings) != len(expected) {
+		tw.Fatalf("expected %d warnings, got %d:\n%s", len(expected),
+			len(warnings), strings.Join(warnings, "\n"))
+	}
+	for i := range expected {
+		tw.AssertContains(warnings[i], expected[i])
+	}
+}
@@ -0,0 +1,495 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"text/scanner"
+	"unicode"
+	"unicode/utf16"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	"gopkg.in/spacemonkeygo/dbx.v1/lint"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+)
+
+// document is an open dbx file. ast is from the last version of the text
+// that parsed, so that completion keeps working while an edit is incomplete.
+// refs and root only hold the current text since their positions are used,
+// so they are cleared when it does not parse or transform.
+//
+// positions sent to and from the client count UTF-16 code units while the
+// parser and lines count runes, so the server converts positions it receives
+// with fromClient and the document converts the ones it returns with
+// toClient.
+type document struct {
+	uri         string
+	filename    string
+	text        []byte
+	lines       []string
+	diagnostics []diagnostic
+
+	ast  *ast.Root
+	root *ir.Root
+
+	// refs are the model and field names in ast and the definitions they
+	// refer to.
+	refs []reference
+}
+
+type reference struct {
+	name   *ast.String
+	target scanner.Position
+}
+
+func (d *document) update(text []byte) {
+	d.text = text
+	d.lines = strings.Split(string(text), "\n")
+	d.diagnostics = nil
+	d.refs = nil
+	d.root = nil
+
+	ast_root, err := syntax.Parse(d.filename, text)
+	if err != nil {
+		d.addDiagnostics(err)
+		return
+	}
+	d.ast = ast_root
+	d.refs = collectReferences(ast_root)
+
+	root, err := xform.Transform(ast_root)
+	if err != nil {
+		d.addDiagnostics(err)
+		return
+	}
+	d.root = root
+
+	d.addDiagnostics(lint.Lint(root))
+}
+
+func (d *document) addDiagnostics(err error) {
+	for _, diag := range errutil.DiagnosticsFromError(err) {
+		severity := severityError
+		if diag.Severity == "warning" {
+			severity = severityWarning
+		}
+		start := position{Line: diag.Line - 1, Character: diag.Column - 1}
+		if start.Line < 0 {
+			start = position{}
+		}
+		d.diagnostics = append(d.diagnostics, diagnostic{
+			Range:    d.toClientRange(d.wordRange(start)),
+			Severity: severity,
+			Source:   "dbx",
+			Message:  diag.Message,
+		})
+	}
+}
+
+// wordRange returns the range of the identifier starting at pos, or of the
+// single character there if there is none.
+func (d *document) wordRange(pos position) lspRange {
+	end := pos
+	if pos.Line < len(d.lines) {
+		line := []rune(d.lines[pos.Line])
+		for end.Character < len(line) && isIdent(line[end.Character]) {
+			end.Character++
+		}
+	}
+	if end.Character == pos.Character {
+		end.Character++
+	}
+	return lspRange{Start: pos, End: end}
+}
+
+func (d *document) fullRange() lspRange {
+	last := len(d.lines) - 1
+	return d.toClientRange(lspRange{End: position{
+		Line:      last,
+		Character: len([]rune(d.lines[last])),
+	}})
+}
+
+// fromClient converts a position counting UTF-16 code units to one counting
+// runes.
+func (d *document) fromClient(pos position) position {
+	if pos.Line < 0 || pos.Line >= len(d.lines) {
+		return pos
+	}
+	units, runes := 0, 0
+	for _, r := range d.lines[pos.Line] {
+		if units >= pos.Character {
+			break
+		}
+		units += utf16Len(r)
+		runes++
+	}
+	return position{Line: pos.Line, Character: runes}
+}
+
+// toClient converts a position counting runes to one counting UTF-16 code
+// units.
+func (d *document) toClient(pos position) position {
+	if pos.Line < 0 || pos.Line >= len(d.lines) {
+		return pos
+	}
+	units, runes := 0, 0
+	for _, r := range d.lines[pos.Line] {
+		if runes >= pos.Character {
+			break
+		}
+		units += utf16Len(r)
+		runes++
+	}
+	return position{Line: pos.Line, Character: units + pos.Character - runes}
+}
+
+func (d *document) toClientRange(r lspRange) lspRange {
+	return lspRange{Start: d.toClient(r.Start), End: d.toClient(r.End)}
+}
+
+func utf16Len(r rune) int {
+	if n := utf16.RuneLen(r); n > 0 {
+		return n
+	}
+	// invalid runes are replaced by a single unit.
+	return 1
+}
+
+func isIdent(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+//
+// definitions
+//
+
+func (d *document) definition(pos position) *location {
+	for _, ref := range d.refs {
+		name := ref.name
+		if name.Pos.Line-1 == pos.Line &&
+			name.Pos.Column-1 <= pos.Character &&
+			pos.Character < name.Pos.Column-1+len([]rune(name.Value)) {
+
+			start := position{
+				Line:      ref.target.Line - 1,
+				Character: ref.target.Column - 1,
+			}
+			return &location{
+				URI:   d.uri,
+				Range: d.toClientRange(lspRange{Start: start, End: start}),
+			}
+		}
+	}
+	return nil
+}
+
+// collectReferences returns every model and field name in root that refers
+// to a model or field defined in it.
+func collectReferences(root *ast.Root) (refs []reference) {
+	models := map[string]*ast.Model{}
+	fields := map[string]map[string]*ast.Field{}
+	for _, model := range root.Models {
+		models[model.Name.Value] = model
+		fields[model.Name.Value] = map[string]*ast.Field{}
+		for _, field := range model.Fields {
+			fields[model.Name.Value][field.Name.Value] = field
+		}
+	}
+
+	addModel := func(name *ast.String) {
+		if name == nil {
+			return
+		}
+		if model := models[name.Value]; model != nil {
+			refs = append(refs, reference{name: name, target: model.Pos})
+		}
+	}
+	addField := func(model string, name *ast.String) {
+		if name == nil {
+			return
+		}
+		if field := fields[model][name.Value]; field != nil {
+			refs = append(refs, reference{name: name, target: field.Pos})
+		}
+	}
+	addFieldRef := func(ref *ast.FieldRef) {
+		if ref == nil || ref.Model == nil {
+			return
+		}
+		addModel(ref.Model)
+		addField(ref.Model.Value, ref.Field)
+	}
+	addRelative := func(model string, refs *ast.RelativeFieldRefs) {
+		if refs == nil {
+			return
+		}
+		for _, ref := range refs.Refs {
+			addField(model, ref.Field)
+		}
+	}
+	var addExpr func(expr *ast.Expr)
+	addExpr = func(expr *ast.Expr) {
+		if expr == nil {
+			return
+		}
+		addFieldRef(expr.FieldRef)
+		if expr.FuncCall != nil {
+			for _, arg := range expr.FuncCall.Args {
+				addExpr(arg)
+			}
+		}
+	}
+	addWheres := func(wheres []*ast.Where) {
+		for _, where := range wheres {
+			addExpr(where.Left)
+			addExpr(where.Right)
+			addExpr(where.Upper)
+		}
+	}
+	addJoins := func(joins []*ast.Join) {
+		for _, join := range joins {
+			addFieldRef(join.Left)
+			addFieldRef(join.Right)
+		}
+	}
+
+	for _, model := range root.Models {
+		name := model.Name.Value
+		addRelative(name, model.PrimaryKey)
+		for _, unique := range model.Unique {
+			addRelative(name, unique)
+		}
+		for _, index := range model.Indexes {
+			for _, column := range index.Columns {
+				if column.Field != nil {
+					addField(name, column.Field.Field)
+				}
+				addExpr(column.Expr)
+			}
+			addWheres(index.Where)
+		}
+		for _, check := range model.Checks {
+			addWheres(check.Wheres)
+		}
+		for _, field := range model.Fields {
+			addFieldRef(field.Relation)
+			if field.Check != nil {
+				addWheres(field.Check.Wheres)
+			}
+		}
+		for _, foreign_key := range model.ForeignKeys {
+			addRelative(name, foreign_key.Fields)
+			addModel(foreign_key.Model)
+			if foreign_key.Model != nil {
+				addRelative(foreign_key.Model.Value, foreign_key.References)
+			}
+		}
+	}
+	for _, cre := range root.Creates {
+		addModel(cre.Model.Model)
+	}
+	for _, read := range root.Reads {
+		if read.Select != nil {
+			for _, ref := range read.Select.Refs {
+				addFieldRef(ref)
+			}
+		}
+		addJoins(read.Joins)
+		addWheres(read.Where)
+		if read.OrderBy != nil {
+			for _, entry := range read.OrderBy.Entries {
+				addFieldRef(entry.Field)
+			}
+		}
+		if read.GroupBy != nil && read.GroupBy.Fields != nil {
+			for _, ref := range read.GroupBy.Fields.Refs {
+				addFieldRef(ref)
+			}
+		}
+	}
+	for _, upd := range root.Updates {
+		addModel(upd.Model.Model)
+		addJoins(upd.Joins)
+		addWheres(upd.Where)
+	}
+	for _, del := range root.Deletes {
+		addModel(del.Model.Model)
+		addJoins(del.Joins)
+		addWheres(del.Where)
+	}
+
+	return refs
+}
+
+//
+// completion
+//
+
+var reModelDot = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)\.[A-Za-z0-9_]*$`)
+
+// keywords are the words that start tuples or appear in them, and views are
+// the kinds of reads.
+var (
+	keywords   = syntax.Keywords()
+	views      = syntax.Views()
+	fieldTypes = syntax.FieldTypes()
+)
+
+func (d *document) completion(pos position) (items []completionItem) {
+	if d.ast == nil {
+		return nil
+	}
+
+	var before string
+	if pos.Line < len(d.lines) {
+		line := []rune(d.lines[pos.Line])
+		if pos.Character <= len(line) {
+			before = string(line[:pos.Character])
+		}
+	}
+
+	// after "model." complete the fields of the model.
+	if match := reModelDot.FindStringSubmatch(before); match != nil {
+		for _, model := range d.ast.Models {
+			if model.Name.Value != match[1] {
+				continue
+			}
+			for _, field := range model.Fields {
+				items = append(items, completionItem{
+					Label:  field.Name.Value,
+					Kind:   completionField,
+					Detail: fieldDetail(field),
+				})
+			}
+		}
+		return items
+	}
+
+	for _, model := range d.ast.Models {
+		items = append(items, completionItem{
+			Label: model.Name.Value,
+			Kind:  completionClass,
+		})
+	}
+	for _, keyword := range keywords {
+		items = append(items, completionItem{
+			Label: keyword,
+			Kind:  completionKeyword,
+		})
+	}
+	for _, view := range views {
+		items = append(items, completionItem{
+			Label:  view,
+			Kind:   completionEnum,
+			Detail: "view",
+		})
+	}
+	for _, field_type := range fieldTypes {
+		items = append(items, completionItem{
+			Label:  field_type,
+			Kind:   completionEnum,
+			Detail: "field type",
+		})
+	}
+	return items
+}
+
+func fieldDetail(field *ast.Field) string {
+	switch {
+	case field.Type != nil:
+		return field.Type.Value.String()
+	case field.Relation != nil:
+		return field.Relation.String()
+	default:
+		return ""
+	}
+}
+
+//
+// hover
+//
+
+// signatures returns the signatures of the methods generated for the
+// operation at pos.
+func (d *document) signatures(pos position, renderer *golang.Renderer,
+	dialect sql.Dialect) (signatures []string, err error) {
+
+	if d.root == nil {
+		return nil, nil
+	}
+
+	type operation struct {
+		pos scanner.Position
+		op  interface{}
+	}
+	var ops []operation
+	for _, cre := range d.root.Creates {
+		ops = append(ops, operation{pos: cre.Pos, op: cre})
+	}
+	for _, read := range d.root.Reads {
+		ops = append(ops, operation{pos: read.Pos, op: read})
+	}
+	for _, upd := range d.root.Updates {
+		ops = append(ops, operation{pos: upd.Pos, op: upd})
+	}
+	for _, del := range d.root.Deletes {
+		ops = append(ops, operation{pos: del.Pos, op: del})
+	}
+
+	// the operation at pos is the last top level construct starting before
+	// it, which may be a model instead.
+	var tops []scanner.Position
+	for _, model := range d.root.Models {
+		tops = append(tops, model.Pos)
+	}
+	for _, op := range ops {
+		tops = append(tops, op.pos)
+	}
+	sort.Slice(tops, func(i, j int) bool {
+		return tops[i].Offset < tops[j].Offset
+	})
+	var top scanner.Position
+	for _, candidate := range tops {
+		if candidate.Line-1 > pos.Line || (candidate.Line-1 == pos.Line &&
+			candidate.Column-1 > pos.Character) {
+			break
+		}
+		top = candidate
+	}
+
+	seen := map[string]bool{}
+	for _, op := range ops {
+		if op.pos != top {
+			continue
+		}
+		signature, err := renderer.Signature(op.op, dialect)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[signature] {
+			seen[signature] = true
+			signatures = append(signatures, "func "+signature)
+		}
+	}
+	return signatures, nil
+}
@@ -0,0 +1,295 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lsp implements a language server for dbx files over stdio.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spacemonkeygo/errors"
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+)
+
+var Error = errors.NewClass("lsp")
+
+// Server answers language server requests about the dbx files open in the
+// client. Hovering over an operation shows the signature of the method
+// generated for it by the renderer for the dialect.
+type Server struct {
+	in       *bufio.Reader
+	out      io.Writer
+	renderer *golang.Renderer
+	dialect  sql.Dialect
+	docs     map[string]*document
+}
+
+func NewServer(in io.Reader, out io.Writer, renderer *golang.Renderer,
+	dialect sql.Dialect) *Server {
+
+	return &Server{
+		in:       bufio.NewReader(in),
+		out:      out,
+		renderer: renderer,
+		dialect:  dialect,
+		docs:     map[string]*document{},
+	}
+}
+
+// Serve handles requests until the client sends exit or closes the input.
+func (s *Server) Serve() error {
+	for {
+		req, err := s.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if req.Method == "exit" {
+			return nil
+		}
+
+		result, resp_err := s.handle(req)
+		if req.ID == nil {
+			continue
+		}
+		resp := response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error:   resp_err,
+		}
+		if resp_err == nil {
+			data, err := json.Marshal(result)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			raw := json.RawMessage(data)
+			resp.Result = &raw
+		}
+		if err := s.write(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) read() (req *request, err error) {
+	header, err := textproto.NewReader(s.in).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, Error.New("invalid Content-Length %q",
+			header.Get("Content-Length"))
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(s.in, body); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	req = new(request)
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return req, nil
+}
+
+func (s *Server) write(msg interface{}) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body),
+		body)
+	return Error.Wrap(err)
+}
+
+func (s *Server) handle(req *request) (
+	result interface{}, resp_err *responseError) {
+
+	decode := func(params interface{}) *responseError {
+		if err := json.Unmarshal(req.Params, params); err != nil {
+			return &responseError{Code: codeInvalidParams,
+				Message: err.Error()}
+		}
+		return nil
+	}
+
+	switch req.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				// full document sync
+				"textDocumentSync":   1,
+				"definitionProvider": true,
+				"hoverProvider":      true,
+				"completionProvider": map[string]interface{}{
+					"triggerCharacters": []string{"."},
+				},
+				"documentFormattingProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "dbx"},
+		}, nil
+
+	case "shutdown":
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if resp_err := decode(&params); resp_err != nil {
+			return nil, resp_err
+		}
+		s.update(params.TextDocument.URI, params.TextDocument.Text)
+		return nil, nil
+
+	case "textDocument/didChange":
+		var params didChangeParams
+		if resp_err := decode(&params); resp_err != nil {
+			return nil, resp_err
+		}
+		changes := params.ContentChanges
+		if len(changes) > 0 {
+			s.update(params.TextDocument.URI, changes[len(changes)-1].Text)
+		}
+		return nil, nil
+
+	case "textDocument/didClose":
+		var params didCloseParams
+		if resp_err := decode(&params); resp_err != nil {
+			return nil, resp_err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		s.publish(params.TextDocument.URI, nil)
+		return nil, nil
+
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if resp_err := decode(&params); resp_err != nil {
+			return nil, resp_err
+		}
+		doc := s.docs[params.TextDocument.URI]
+		if doc == nil {
+			return nil, nil
+		}
+		return doc.definition(doc.fromClient(params.Position)), nil
+
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if resp_err := decode(&params); resp_err != nil {
+			return nil, resp_err
+		}
+		doc := s.docs[params.TextDocument.URI]
+		if doc == nil {
+			return nil, nil
+		}
+		return doc.completion(doc.fromClient(params.Position)), nil
+
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if resp_err := decode(&params); resp_err != nil {
+			return nil, resp_err
+		}
+		doc := s.docs[params.TextDocument.URI]
+		if doc == nil {
+			return nil, nil
+		}
+		signatures, err := doc.signatures(doc.fromClient(params.Position),
+			s.renderer, s.dialect)
+		if err != nil {
+			return nil, &responseError{Code: codeInternalError,
+				Message: err.Error()}
+		}
+		if len(signatures) == 0 {
+			return nil, nil
+		}
+		return hover{Contents: markupContent{
+			Kind: "markdown",
+			Value: "```go\n" + strings.Join(signatures, "\n") +
+				"\n```",
+		}}, nil
+
+	case "textDocument/formatting":
+		var params formattingParams
+		if resp_err := decode(&params); resp_err != nil {
+			return nil, resp_err
+		}
+		doc := s.docs[params.TextDocument.URI]
+		if doc == nil {
+			return nil, nil
+		}
+		formatted, err := syntax.Format(doc.filename, doc.text)
+		if err != nil {
+			// the diagnostics already report why the file can't be parsed.
+			return nil, nil
+		}
+		return []textEdit{{
+			Range:   doc.fullRange(),
+			NewText: string(formatted),
+		}}, nil
+
+	default:
+		if req.ID == nil {
+			// unknown notifications are ignored.
+			return nil, nil
+		}
+		return nil, &responseError{Code: codeMethodNotFound,
+			Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+}
+
+// update analyzes the new text of a document and publishes its diagnostics.
+func (s *Server) update(uri, text string) {
+	doc := s.docs[uri]
+	if doc == nil {
+		doc = &document{uri: uri, filename: uriFilename(uri)}
+		s.docs[uri] = doc
+	}
+	doc.update([]byte(text))
+	s.publish(uri, doc.diagnostics)
+}
+
+func (s *Server) publish(uri string, diagnostics []diagnostic) {
+	if diagnostics == nil {
+		diagnostics = []diagnostic{}
+	}
+	// a client that went away will stop the server on the next read.
+	_ = s.write(notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: publishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics,
+		},
+	})
+}
+
+func uriFilename(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Path == "" {
+		return uri
+	}
+	return parsed.Path
+}
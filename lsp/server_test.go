@@ -0,0 +1,316 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/templates"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/tmplutil"
+)
+
+const (
+	testURI    = "file:///tmp/test.dbx"
+	testSource = `model user (
+	key pk
+	field pk   serial64
+	field name text
+)
+
+read one (
+	select user
+	where user.pk = ?
+)
+`
+)
+
+type testMessage struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *responseError  `json:"error"`
+}
+
+// runServer sends the requests, numbering them from 1, and returns the
+// responses by id and the notifications in order.
+func runServer(t *testutil.T, requests ...map[string]interface{}) (
+	responses map[int]testMessage, notifications []testMessage) {
+
+	var in bytes.Buffer
+	for i, req := range requests {
+		req["jsonrpc"] = "2.0"
+		if _, ok := req["notify"]; ok {
+			delete(req, "notify")
+		} else {
+			req["id"] = i + 1
+		}
+		body, err := json.Marshal(req)
+		t.AssertNoError(err)
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	}
+
+	renderer, err := golang.New(tmplutil.BinLoader(templates.Asset),
+		&golang.Options{SupportRx: true})
+	t.AssertNoError(err)
+
+	var out bytes.Buffer
+	t.AssertNoError(NewServer(&in, &out, renderer, sql.Postgres()).Serve())
+
+	responses = map[int]testMessage{}
+	reader := bufio.NewReader(&out)
+	for {
+		header, err := textproto.NewReader(reader).ReadMIMEHeader()
+		if err == io.EOF {
+			break
+		}
+		t.AssertNoError(err)
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		t.AssertNoError(err)
+		body := make([]byte, length)
+		_, err = io.ReadFull(reader, body)
+		t.AssertNoError(err)
+
+		var msg testMessage
+		t.AssertNoError(json.Unmarshal(body, &msg))
+		if msg.ID != nil {
+			responses[*msg.ID] = msg
+		} else {
+			notifications = append(notifications, msg)
+		}
+	}
+	return responses, notifications
+}
+
+func open(text string) map[string]interface{} {
+	return map[string]interface{}{
+		"notify": true,
+		"method": "textDocument/didOpen",
+		"params": map[string]interface{}{
+			"textDocument": map[string]interface{}{
+				"uri": testURI, "text": text,
+			},
+		},
+	}
+}
+
+func at(method string, line, character int) map[string]interface{} {
+	return map[string]interface{}{
+		"method": method,
+		"params": map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": testURI},
+			"position": map[string]interface{}{
+				"line": line, "character": character,
+			},
+		},
+	}
+}
+
+func TestServer(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	responses, notifications := runServer(tw,
+		map[string]interface{}{"method": "initialize", "params": nil},
+		open(testSource),
+		// "pk" in "where user.pk = ?"
+		at("textDocument/definition", 8, 13),
+		// after "user." in the where clause
+		at("textDocument/completion", 8, 12),
+		at("textDocument/hover", 7, 2),
+		map[string]interface{}{
+			"method": "textDocument/formatting",
+			"params": map[string]interface{}{
+				"textDocument": map[string]interface{}{"uri": testURI},
+			},
+		},
+		map[string]interface{}{"method": "bogus"},
+	)
+
+	tw.AssertContains(string(responses[1].Result), "definitionProvider")
+
+	if len(notifications) != 1 {
+		tw.Fatalf("expected 1 notification, got %d", len(notifications))
+	}
+	tw.AssertContains(string(notifications[0].Params), `"diagnostics":[]`)
+
+	var definition location
+	tw.AssertNoError(json.Unmarshal(responses[3].Result, &definition))
+	if definition.Range.Start != (position{Line: 2, Character: 1}) {
+		tw.Fatalf("unexpected definition: %+v", definition)
+	}
+
+	var items []completionItem
+	tw.AssertNoError(json.Unmarshal(responses[4].Result, &items))
+	if len(items) != 2 || items[0].Label != "pk" || items[1].Label != "name" {
+		tw.Fatalf("unexpected completions: %+v", items)
+	}
+
+	tw.AssertContains(string(responses[5].Result),
+		"Get_User_By_Pk(ctx context.Context")
+
+	var edits []textEdit
+	tw.AssertNoError(json.Unmarshal(responses[6].Result, &edits))
+	if len(edits) != 1 || !strings.Contains(edits[0].NewText, "key   pk") {
+		tw.Fatalf("unexpected formatting: %+v", edits)
+	}
+
+	if responses[7].Error == nil ||
+		responses[7].Error.Code != codeMethodNotFound {
+		tw.Fatalf("expected method not found, got %+v", responses[7])
+	}
+}
+
+func TestServerDiagnostics(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	_, notifications := runServer(tw,
+		open(strings.Replace(testSource, "user.pk = ?", "user.pf = ?", 1)))
+
+	if len(notifications) != 1 {
+		tw.Fatalf("expected 1 notification, got %d", len(notifications))
+	}
+	var params publishDiagnosticsParams
+	tw.AssertNoError(json.Unmarshal(notifications[0].Params, &params))
+	if len(params.Diagnostics) != 1 {
+		tw.Fatalf("expected 1 diagnostic, got %+v", params.Diagnostics)
+	}
+	diag := params.Diagnostics[0]
+	tw.AssertContains(diag.Message, `did you mean "pk"?`)
+	if diag.Severity != severityError ||
+		diag.Range.Start != (position{Line: 8, Character: 7}) {
+		tw.Fatalf("unexpected diagnostic: %+v", diag)
+	}
+}
+
+func TestServerParseError(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	// the broken line moves everything down, so the references and
+	// operations of the last good parse no longer line up with the text.
+	responses, _ := runServer(tw,
+		open(testSource),
+		map[string]interface{}{
+			"notify": true,
+			"method": "textDocument/didChange",
+			"params": map[string]interface{}{
+				"textDocument": map[string]interface{}{"uri": testURI},
+				"contentChanges": []map[string]interface{}{
+					{"text": "model (\n" + testSource},
+				},
+			},
+		},
+		// "pk" in "where user.pk = ?"
+		at("textDocument/definition", 9, 13),
+		at("textDocument/hover", 8, 2),
+		// after "user." in the where clause
+		at("textDocument/completion", 9, 12),
+	)
+
+	if string(responses[3].Result) != "null" {
+		tw.Fatalf("expected no definition, got %s", responses[3].Result)
+	}
+	if string(responses[4].Result) != "null" {
+		tw.Fatalf("expected no hover, got %s", responses[4].Result)
+	}
+
+	var items []completionItem
+	tw.AssertNoError(json.Unmarshal(responses[5].Result, &items))
+	if len(items) != 2 || items[0].Label != "pk" || items[1].Label != "name" {
+		tw.Fatalf("unexpected completions: %+v", items)
+	}
+}
+
+func TestServerPositions(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	// the emoji is two UTF-16 code units but one rune, so positions after it
+	// on the same line differ between the two.
+	const uri = "file:///tmp/my%20schema.dbx"
+	line := "\twhere coalesce(user.name, \"\U0001F600\") = user.name"
+	source := strings.Replace(testSource, "\twhere user.pk = ?",
+		"\twhere user.pk = ?\n"+line, 1)
+	units := func(s string) int { return len(utf16.Encode([]rune(s))) }
+	name := units(line[:strings.LastIndex(line, "name")])
+
+	responses, notifications := runServer(tw,
+		map[string]interface{}{
+			"notify": true,
+			"method": "textDocument/didOpen",
+			"params": map[string]interface{}{
+				"textDocument": map[string]interface{}{
+					"uri": uri, "text": strings.Replace(source,
+						"= user.name", "= user.nme", 1),
+				},
+			},
+		},
+		map[string]interface{}{
+			"notify": true,
+			"method": "textDocument/didChange",
+			"params": map[string]interface{}{
+				"textDocument": map[string]interface{}{"uri": uri},
+				"contentChanges": []map[string]interface{}{
+					{"text": source},
+				},
+			},
+		},
+		// the last character of "name" at the end of the line
+		map[string]interface{}{
+			"method": "textDocument/definition",
+			"params": map[string]interface{}{
+				"textDocument": map[string]interface{}{"uri": uri},
+				"position": map[string]interface{}{
+					"line": 9, "character": name + 3,
+				},
+			},
+		},
+	)
+
+	var params publishDiagnosticsParams
+	tw.AssertNoError(json.Unmarshal(notifications[0].Params, &params))
+	if len(params.Diagnostics) != 1 {
+		tw.Fatalf("expected 1 diagnostic, got %+v", params.Diagnostics)
+	}
+	// the error points at "user" in "user.nme".
+	diag := params.Diagnostics[0]
+	if diag.Range != (lspRange{
+		Start: position{Line: 9, Character: name - 5},
+		End:   position{Line: 9, Character: name - 1},
+	}) {
+		tw.Fatalf("unexpected diagnostic range: %+v", diag.Range)
+	}
+
+	var definition location
+	tw.AssertNoError(json.Unmarshal(responses[3].Result, &definition))
+	if definition.URI != uri ||
+		definition.Range.Start != (position{Line: 3, Character: 1}) {
+		tw.Fatalf("unexpected definition: %+v", definition)
+	}
+}
@@ -16,18 +16,30 @@
 package main
 
 import (
+	dbsql "database/sql"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	cli "github.com/jawher/mow.cli"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/spacemonkeygo/errors"
 	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/code/proto"
+	"gopkg.in/spacemonkeygo/dbx.v1/diagram"
+	"gopkg.in/spacemonkeygo/dbx.v1/docs"
 	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/introspect"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	"gopkg.in/spacemonkeygo/dbx.v1/lint"
+	"gopkg.in/spacemonkeygo/dbx.v1/lsp"
+	"gopkg.in/spacemonkeygo/dbx.v1/migrations"
+	"gopkg.in/spacemonkeygo/dbx.v1/queryplan"
 	"gopkg.in/spacemonkeygo/dbx.v1/sql"
 	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
 	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
@@ -37,13 +49,43 @@ import (
 
 // dbx golang (-p package) (-d dialect) DBXFILE OUTDIR
 // dbx schema (-d dialect) DBXFILE OUTDIR
-
-var loadedData []byte
+// dbx proto (-p package) (--go-package path) (--convert package) DBXFILE OUTDIR
+// dbx explain (-d dialect) (--op method) DBXFILE
+// dbx verify-plans (-d sqlite3) (--threshold rows) (--rows table=rows) DBXFILE
+// dbx lint DBXFILE
+// dbx diagram (--format mermaid) DBXFILE
+// dbx docs (-d dialect) (--format markdown) DBXFILE
+// dbx lsp (-d dialect)
+// dbx import (-d dialect) DSN
+// dbx check (-d dialect) DBXFILE DSN
+
+var (
+	loadedData []byte
+	loadedPath string
+)
 
 func main() {
+	app := cli.App("dbx", "generate SQL schema and matching code")
+
+	max_errors_opt := app.IntOpt("max-errors", 10,
+		"maximum number of errors to report (0 for no limit)")
+
 	die := func(err error) {
-		if err != nil {
-			// if the error came from errutil, don't bother with the dbx prefix
+		if err == nil {
+			return
+		}
+		errs := errutil.Errors(err)
+		for i, err := range errs {
+			if *max_errors_opt > 0 && i >= *max_errors_opt {
+				fmt.Fprintf(os.Stderr, "too many errors (%d more)\n",
+					len(errs)-i)
+				break
+			}
+			if i > 0 {
+				fmt.Fprintln(os.Stderr)
+			}
+			// if the error came from errutil, don't bother with the dbx
+			// prefix
 			err_string := strings.TrimPrefix(errors.GetMessage(err), "dbx: ")
 			fmt.Fprintln(os.Stderr, err_string)
 			if context := errutil.GetContext(loadedData, err); context != "" {
@@ -51,11 +93,48 @@ func main() {
 				fmt.Fprintln(os.Stderr, "context:")
 				fmt.Fprintln(os.Stderr, context)
 			}
-			cli.Exit(1)
 		}
+		cli.Exit(1)
 	}
 
-	app := cli.App("dbx", "generate SQL schema and matching code")
+	// report runs the command and dies like die on its error, but writes
+	// the diagnostics in a machine readable format instead, if one is given.
+	// the format is checked before the command runs. without errors nothing
+	// is written, except that sarif writes a log with no results since its
+	// consumers expect one.
+	report := func(format string, run func() error) {
+		if format != "" {
+			die(errutil.CheckDiagnosticsFormat(format))
+		}
+		err := run()
+		if format == "" || (err == nil && format != "sarif") {
+			die(err)
+			return
+		}
+		if err == nil {
+			die(errutil.WriteDiagnostics(os.Stderr, format, nil))
+			return
+		}
+		diagnostics := errutil.DiagnosticsFromError(err)
+		for i := range diagnostics {
+			if loadedPath != "" &&
+				diagnostics[i].File == filepath.Base(loadedPath) {
+				diagnostics[i].File = loadedPath
+			}
+		}
+		if more := len(diagnostics) - *max_errors_opt; *max_errors_opt > 0 &&
+			more > 0 {
+			diagnostics = append(diagnostics[:*max_errors_opt],
+				errutil.Diagnostic{
+					Severity: "error",
+					Message:  fmt.Sprintf("too many errors (%d more)", more),
+				})
+		}
+		die(errutil.WriteDiagnostics(os.Stderr, format, diagnostics))
+		cli.Exit(1)
+	}
+
+	const diagnostics_desc = "write errors to stderr as json or sarif"
 
 	app.Command("golang", "generate Go code", func(cmd *cli.Cmd) {
 		package_opt := cmd.StringOpt("p package", "",
@@ -68,37 +147,175 @@ func main() {
 			"generate Rx support")
 		userdata_opt := cmd.BoolOpt("userdata", false,
 			"generate userdata interface and mutex on models")
+		prepared_opt := cmd.BoolOpt("prepared", false,
+			"cache prepared statements for generated queries")
+		migrations_opt := cmd.StringOpt("m migrations", "",
+			"directory of migration steps (defaults to DBXFILE's name "+
+				"with a .migrations extension, if it exists)")
+		diagnostics_opt := cmd.StringOpt("diagnostics", "",
+			diagnostics_desc)
 		dbxfile_arg := cmd.StringArg("DBXFILE", "",
 			"path to dbx file")
 		outdir_arg := cmd.StringArg("OUTDIR", "",
 			"output directory")
 		cmd.Action = func() {
-			die(golangCmd(*package_opt, *dialects_opt, *templatedir_opt,
-				*rx_opt, *userdata_opt, *dbxfile_arg, *outdir_arg))
+			report(*diagnostics_opt, func() error {
+				return golangCmd(*package_opt, *dialects_opt,
+					*templatedir_opt, *rx_opt, *userdata_opt, *prepared_opt,
+					*migrations_opt, *dbxfile_arg, *outdir_arg)
+			})
 		}
 	})
 
 	app.Command("schema", "generate table schema", func(cmd *cli.Cmd) {
 		dialects_opt := cmd.StringsOpt("d dialect", nil,
 			"SQL dialects (default is postgres)")
+		diagnostics_opt := cmd.StringOpt("diagnostics", "",
+			diagnostics_desc)
 		dbxfile_arg := cmd.StringArg("DBXFILE", "",
 			"path to dbx file")
 		outdir_arg := cmd.StringArg("OUTDIR", "",
 			"output directory")
 		cmd.Action = func() {
-			die(schemaCmd(*dialects_opt, *dbxfile_arg, *outdir_arg))
+			report(*diagnostics_opt, func() error {
+				return schemaCmd(*dialects_opt, *dbxfile_arg, *outdir_arg)
+			})
 		}
 	})
 
+	app.Command("proto", "generate protobuf messages for the models",
+		func(cmd *cli.Cmd) {
+			package_opt := cmd.StringOpt("p package", "",
+				"protobuf package of the messages")
+			gopackage_opt := cmd.StringOpt("go-package", "",
+				"import path of the Go code generated from the messages")
+			convert_opt := cmd.StringOpt("convert", "",
+				"also generate conversions in the package of the dbx Go code")
+			templatedir_opt := cmd.StringOpt("t templates", "",
+				"override the template directory")
+			dbxfile_arg := cmd.StringArg("DBXFILE", "",
+				"path to dbx file")
+			outdir_arg := cmd.StringArg("OUTDIR", "",
+				"output directory")
+			cmd.Action = func() {
+				die(protoCmd(*package_opt, *gopackage_opt, *convert_opt,
+					*templatedir_opt, *dbxfile_arg, *outdir_arg))
+			}
+		})
+
 	app.Command("format", "format dbx file on stdin", func(cmd *cli.Cmd) {
-		cmd.Action = func() { die(formatCmd()) }
+		diagnostics_opt := cmd.StringOpt("diagnostics", "",
+			diagnostics_desc)
+		cmd.Action = func() { report(*diagnostics_opt, formatCmd) }
 	})
 
+	app.Command("explain", "show the methods and SQL generated for operations",
+		func(cmd *cli.Cmd) {
+			dialect_opt := cmd.StringOpt("d dialect", "postgres",
+				"SQL dialect to explain")
+			ops_opt := cmd.StringsOpt("op", nil,
+				"only explain the generated methods with these names")
+			dbxfile_arg := cmd.StringArg("DBXFILE", "",
+				"path to dbx file")
+			cmd.Action = func() {
+				die(explainCmd(*dialect_opt, *ops_opt, *dbxfile_arg))
+			}
+		})
+
+	app.Command("verify-plans",
+		"check the generated queries do not scan large tables",
+		func(cmd *cli.Cmd) {
+			dialect_opt := cmd.StringOpt("d dialect", "sqlite3",
+				"SQL dialect to plan queries with (only sqlite3)")
+			threshold_opt := cmd.IntOpt("threshold", 100,
+				"number of rows above which a table may not be scanned")
+			rows_opt := cmd.StringsOpt("rows", nil,
+				"expected rows in a table as table=rows (tables without "+
+					"one are assumed to be large)")
+			dbxfile_arg := cmd.StringArg("DBXFILE", "",
+				"path to dbx file")
+			cmd.Action = func() {
+				die(verifyPlansCmd(*dialect_opt, *threshold_opt, *rows_opt,
+					*dbxfile_arg))
+			}
+		})
+
+	app.Command("lint", "warn about likely performance and safety problems",
+		func(cmd *cli.Cmd) {
+			diagnostics_opt := cmd.StringOpt("diagnostics", "",
+				diagnostics_desc)
+			dbxfile_arg := cmd.StringArg("DBXFILE", "",
+				"path to dbx file")
+			cmd.Action = func() {
+				report(*diagnostics_opt, func() error {
+					return lintCmd(*dbxfile_arg)
+				})
+			}
+		})
+
+	app.Command("diagram", "print an entity-relationship diagram of the models",
+		func(cmd *cli.Cmd) {
+			format_opt := cmd.StringOpt("format", "mermaid",
+				"diagram format ("+strings.Join(diagram.Formats, ", ")+")")
+			dbxfile_arg := cmd.StringArg("DBXFILE", "",
+				"path to dbx file")
+			cmd.Action = func() {
+				die(diagramCmd(*format_opt, *dbxfile_arg))
+			}
+		})
+
+	app.Command("docs", "print a reference of the schema and its methods",
+		func(cmd *cli.Cmd) {
+			dialects_opt := cmd.StringsOpt("d dialect", nil,
+				"SQL dialects to document (defaults to postgres)")
+			format_opt := cmd.StringOpt("format", "markdown",
+				"docs format ("+strings.Join(docs.Formats, ", ")+")")
+			dbxfile_arg := cmd.StringArg("DBXFILE", "",
+				"path to dbx file")
+			cmd.Action = func() {
+				die(docsCmd(*dialects_opt, *format_opt, *dbxfile_arg))
+			}
+		})
+
+	app.Command("lsp", "run a language server for dbx files over stdio",
+		func(cmd *cli.Cmd) {
+			dialect_opt := cmd.StringOpt("d dialect", "postgres",
+				"SQL dialect of the method signatures shown on hover")
+			templatedir_opt := cmd.StringOpt("t templates", "",
+				"override the template directory")
+			cmd.Action = func() {
+				die(lspCmd(*dialect_opt, *templatedir_opt))
+			}
+		})
+
+	app.Command("import", "generate dbx models from a live database",
+		func(cmd *cli.Cmd) {
+			dialect_opt := cmd.StringOpt("d dialect", "postgres",
+				"SQL dialect of the database")
+			dsn_arg := cmd.StringArg("DSN", "",
+				"data source name of the database")
+			cmd.Action = func() { die(importCmd(*dialect_opt, *dsn_arg)) }
+		})
+
+	app.Command("check", "compare a live database against the schema",
+		func(cmd *cli.Cmd) {
+			dialect_opt := cmd.StringOpt("d dialect", "postgres",
+				"SQL dialect of the database")
+			dbxfile_arg := cmd.StringArg("DBXFILE", "",
+				"path to dbx file")
+			dsn_arg := cmd.StringArg("DSN", "",
+				"data source name of the database")
+			cmd.Action = func() {
+				die(checkCmd(*dialect_opt, *dbxfile_arg, *dsn_arg))
+			}
+		})
+
 	die(app.Run(os.Args))
 }
 
 func golangCmd(pkg string, dialects_opt []string, template_dir string,
-	rx bool, userdata bool, dbxfile, outdir string) (err error) {
+	rx bool, userdata bool, prepared bool, migrations_dir string,
+	dbxfile, outdir string) (err error) {
 
 	if pkg == "" {
 		base := filepath.Base(dbxfile)
@@ -117,12 +334,19 @@ func golangCmd(pkg string, dialects_opt []string, template_dir string,
 		return err
 	}
 
+	steps, err := loadMigrations(migrations_dir, dbxfile)
+	if err != nil {
+		return err
+	}
+
 	loader := getLoader(template_dir)
 
 	renderer, err := golang.New(loader, &golang.Options{
 		Package:         pkg,
 		SupportRx:       rx,
 		SupportUserdata: userdata,
+		SupportPrepared: prepared,
+		Migrations:      steps,
 	})
 	if err != nil {
 		return err
@@ -154,6 +378,9 @@ func schemaCmd(dialects_opt []string, dbxfile, outdir string) (err error) {
 	}
 
 	for _, dialect := range dialects {
+		if err := sql.CheckSchemaSupport(root.Models, dialect); err != nil {
+			return err
+		}
 		err = fw.writeFile(dialect.Name()+".sql", renderSchema(dialect, root))
 		if err != nil {
 			return err
@@ -163,6 +390,48 @@ func schemaCmd(dialects_opt []string, dbxfile, outdir string) (err error) {
 	return nil
 }
 
+func protoCmd(pkg, go_package, convert_package, template_dir string,
+	dbxfile, outdir string) (err error) {
+
+	if pkg == "" {
+		base := filepath.Base(dbxfile)
+		pkg = base[:len(base)-len(filepath.Ext(base))]
+	}
+
+	fw := newFileWriter(outdir, dbxfile)
+
+	root, err := parseDBX(dbxfile)
+	if err != nil {
+		return err
+	}
+
+	renderer, err := proto.New(getLoader(template_dir), &proto.Options{
+		Package:        pkg,
+		GoPackage:      go_package,
+		ConvertPackage: convert_package,
+	})
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderer.RenderProto(root)
+	if err != nil {
+		return err
+	}
+	if err := fw.writeFile("proto", rendered); err != nil {
+		return err
+	}
+
+	if convert_package == "" {
+		return nil
+	}
+	rendered, err = renderer.RenderConvert(root)
+	if err != nil {
+		return err
+	}
+	return fw.writeFile("proto.go", rendered)
+}
+
 func formatCmd() (err error) {
 	data, err := ioutil.ReadAll(os.Stdin)
 	if err != nil {
@@ -178,6 +447,259 @@ func formatCmd() (err error) {
 	return err
 }
 
+func explainCmd(dialect_opt string, ops []string, dbxfile string) (
+	err error) {
+
+	root, err := parseDBX(dbxfile)
+	if err != nil {
+		return err
+	}
+
+	dialects, err := createDialects([]string{dialect_opt})
+	if err != nil {
+		return err
+	}
+
+	renderer, err := golang.New(getLoader(""), &golang.Options{
+		SupportRx: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	explanations, err := renderer.Explain(root, dialects[0])
+	if err != nil {
+		return err
+	}
+
+	if len(ops) > 0 {
+		by_name := map[string]*golang.Explanation{}
+		var names []string
+		for _, explanation := range explanations {
+			by_name[explanation.Name] = explanation
+			names = append(names, explanation.Name)
+		}
+		explanations = nil
+		for _, op := range ops {
+			explanation := by_name[op]
+			if explanation == nil {
+				if suggestion := errutil.Suggest(op, names); suggestion != "" {
+					return fmt.Errorf("no operation %q; did you mean %q?",
+						op, suggestion)
+				}
+				return fmt.Errorf("no operation %q", op)
+			}
+			explanations = append(explanations, explanation)
+		}
+	}
+
+	for i, explanation := range explanations {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Println(explanation.Signature)
+		switch explanation.Kind {
+		case "read", "delete":
+			fmt.Printf("  %s, distinct: %t\n", explanation.Kind,
+				explanation.Distinct)
+		default:
+			fmt.Printf("  %s\n", explanation.Kind)
+		}
+		for _, query := range explanation.Queries {
+			if len(query.Conditions) > 0 {
+				fmt.Printf("  when %s:\n",
+					strings.Join(query.Conditions, " and "))
+			}
+			fmt.Printf("    %s\n", query.SQL)
+		}
+	}
+
+	return nil
+}
+
+func verifyPlansCmd(dialect_opt string, threshold int, rows_opt []string,
+	dbxfile string) (err error) {
+
+	if dialect_opt != "sqlite3" {
+		return fmt.Errorf("verify-plans only supports sqlite3, not %q",
+			dialect_opt)
+	}
+
+	opts := queryplan.Options{
+		Rows:      map[string]int64{},
+		Threshold: int64(threshold),
+	}
+	for _, table_rows := range rows_opt {
+		parts := strings.SplitN(table_rows, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid --rows %q: expected table=rows",
+				table_rows)
+		}
+		rows, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid --rows %q: %v", table_rows, err)
+		}
+		opts.Rows[parts[0]] = rows
+	}
+
+	root, err := parseDBX(dbxfile)
+	if err != nil {
+		return err
+	}
+
+	renderer, err := golang.New(getLoader(""), &golang.Options{})
+	if err != nil {
+		return err
+	}
+	explanations, err := renderer.Explain(root, sql.SQLite3())
+	if err != nil {
+		return err
+	}
+
+	db, err := queryplan.Open()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return queryplan.Verify(db, root, explanations, opts)
+}
+
+func lintCmd(dbxfile string) (err error) {
+	root, err := parseDBX(dbxfile)
+	if err != nil {
+		return err
+	}
+	return lint.Lint(root)
+}
+
+func diagramCmd(format, dbxfile string) (err error) {
+	root, err := parseDBX(dbxfile)
+	if err != nil {
+		return err
+	}
+	return diagram.Render(os.Stdout, root, format)
+}
+
+func docsCmd(dialects_opt []string, format, dbxfile string) (err error) {
+	root, err := parseDBX(dbxfile)
+	if err != nil {
+		return err
+	}
+
+	dialects, err := createDialects(dialects_opt)
+	if err != nil {
+		return err
+	}
+
+	renderer, err := golang.New(getLoader(""), &golang.Options{
+		SupportRx: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var docs_dialects []docs.Dialect
+	for _, dialect := range dialects {
+		explanations, err := renderer.Explain(root, dialect)
+		if err != nil {
+			return err
+		}
+		docs_dialects = append(docs_dialects, docs.Dialect{
+			Dialect:      dialect,
+			Explanations: explanations,
+		})
+	}
+
+	title := strings.TrimSuffix(filepath.Base(dbxfile), filepath.Ext(dbxfile))
+	return docs.Render(os.Stdout, title, root, docs_dialects, format)
+}
+
+func lspCmd(dialect_opt, template_dir string) (err error) {
+	dialects, err := createDialects([]string{dialect_opt})
+	if err != nil {
+		return err
+	}
+
+	renderer, err := golang.New(getLoader(template_dir), &golang.Options{
+		SupportRx: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	return lsp.NewServer(os.Stdin, os.Stdout, renderer, dialects[0]).Serve()
+}
+
+func importCmd(dialect, dsn string) (err error) {
+	db, err := dbsql.Open(dialect, dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	schema, err := introspect.Introspect(db, dialect)
+	if err != nil {
+		return err
+	}
+
+	data, err := introspect.DBX(schema)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+func checkCmd(dialect_name, dbxfile, dsn string) (err error) {
+	root, err := parseDBX(dbxfile)
+	if err != nil {
+		return err
+	}
+
+	dialects, err := createDialects([]string{dialect_name})
+	if err != nil {
+		return err
+	}
+	dialect := dialects[0]
+
+	db, err := dbsql.Open(dialect_name, dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	schema, err := introspect.Introspect(db, dialect_name)
+	if err != nil {
+		return err
+	}
+
+	differences := introspect.Compare(
+		sql.SchemaFromIRModels(root.Models, dialect), dialect, schema)
+	for _, difference := range differences {
+		fmt.Println(difference)
+	}
+	if len(differences) > 0 {
+		return fmt.Errorf("database does not match %s: %d difference(s)",
+			dbxfile, len(differences))
+	}
+	return nil
+}
+
+// loadMigrations loads the steps in dir. without a dir, the steps are loaded
+// from the directory named like the dbx file with a .migrations extension if
+// it exists.
+func loadMigrations(dir, dbxfile string) ([]*migrations.Step, error) {
+	if dir == "" {
+		dir = strings.TrimSuffix(dbxfile, filepath.Ext(dbxfile)) +
+			".migrations"
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return nil, nil
+		}
+	}
+	return migrations.Load(dir)
+}
+
 func renderSchema(dialect sql.Dialect, root *ir.Root) []byte {
 	const schema_hdr = `-- AUTOGENERATED BY gopkg.in/spacemonkeygo/dbx.v1
 -- DO NOT EDIT`
@@ -195,6 +717,7 @@ func parseDBX(in string) (*ir.Root, error) {
 		return nil, err
 	}
 	loadedData = data
+	loadedPath = in
 
 	ast_root, err := syntax.Parse(in, data)
 	if err != nil {
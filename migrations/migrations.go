@@ -0,0 +1,122 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migrations loads the ordered migration steps for a dbx file from
+// a directory of SQL files.
+package migrations
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
+
+// Step is a single migration step. SQL applies to every dialect unless the
+// dialect has its own SQL in Dialects.
+type Step struct {
+	Version  int64
+	Name     string
+	SQL      string
+	Dialects map[string]string
+}
+
+// SQLFor returns the SQL to run for the dialect and if there is any.
+func (s *Step) SQLFor(dialect string) (sql string, ok bool) {
+	if sql, ok := s.Dialects[dialect]; ok {
+		return sql, true
+	}
+	return s.SQL, s.SQL != ""
+}
+
+// Load reads the steps from the .sql files in dir, sorted by version. Files
+// are named like "001_add_users.sql" to apply to every dialect or like
+// "001_add_users.postgres.sql" to apply to a single dialect.
+func Load(dir string) (steps []*Step, err error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errutil.Error.Wrap(err)
+	}
+
+	by_version := map[int64]*Step{}
+	for _, info := range infos {
+		if info.IsDir() || filepath.Ext(info.Name()) != ".sql" {
+			continue
+		}
+
+		version, name, dialect, err := parseFilename(info.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+		if err != nil {
+			return nil, errutil.Error.Wrap(err)
+		}
+
+		step := by_version[version]
+		if step == nil {
+			step = &Step{
+				Version:  version,
+				Name:     name,
+				Dialects: map[string]string{},
+			}
+			by_version[version] = step
+			steps = append(steps, step)
+		}
+		if step.Name != name {
+			return nil, errutil.Error.New(
+				"migration version %d used by both %q and %q",
+				version, step.Name, name)
+		}
+
+		sql := strings.TrimSpace(string(data))
+		if dialect == "" {
+			step.SQL = sql
+		} else {
+			step.Dialects[dialect] = sql
+		}
+	}
+
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].Version < steps[j].Version
+	})
+	return steps, nil
+}
+
+// parseFilename splits a filename like "001_add_users.postgres.sql" into its
+// version, name and optional dialect.
+func parseFilename(filename string) (version int64, name, dialect string,
+	err error) {
+
+	base := strings.TrimSuffix(filename, ".sql")
+	if dot := strings.IndexByte(base, '.'); dot >= 0 {
+		base, dialect = base[:dot], base[dot+1:]
+	}
+
+	underscore := strings.IndexByte(base, '_')
+	if underscore <= 0 {
+		return 0, "", "", errutil.Error.New(
+			"migration %q must be named like 001_name.sql", filename)
+	}
+	version, err = strconv.ParseInt(base[:underscore], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, "", "", errutil.Error.New(
+			"migration %q must start with a positive version", filename)
+	}
+	return version, base[underscore+1:], dialect, nil
+}
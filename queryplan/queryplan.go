@@ -0,0 +1,222 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queryplan checks the plans SQLite picks for the generated queries
+// so that missing indexes are caught without a real database.
+package queryplan
+
+import (
+	"database/sql"
+	"regexp"
+	"strings"
+	"text/scanner"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/spacemonkeygo/errors"
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/lint"
+	dbxsql "gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
+)
+
+var Error = errors.NewClass("queryplan")
+
+const driverName = "dbx_queryplan_sqlite3"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			// like the generated code, provide the regexp function that
+			// the REGEXP operator calls.
+			return conn.RegisterFunc("regexp", regexp.MatchString, true)
+		},
+	})
+}
+
+// Open returns an empty in-memory SQLite database to pass to Verify.
+func Open() (*sql.DB, error) {
+	db, err := sql.Open(driverName, ":memory:")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	// every connection to :memory: is a different database.
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+// Options tune which full table scans are allowed.
+type Options struct {
+	// Rows is the expected number of rows in each table, by table name.
+	// Tables without an entry are assumed to be large.
+	Rows map[string]int64
+
+	// Threshold is the number of rows above which a table may not be
+	// scanned.
+	Threshold int64
+}
+
+// Verify creates the schema for root in db, which must be a database from
+// Open, and checks the plan of every select, update and delete in the
+// explanations, which must be for the sqlite3 dialect, including each variant
+// of their nullable conditions. It returns an error for every query that
+// scans a table expected to have more rows than the threshold. Operations
+// with "nolint unindexed" and dynamic reads, whose where clause is only known
+// at runtime, are skipped.
+func Verify(db *sql.DB, root *ir.Root, explanations []*golang.Explanation,
+	opts Options) error {
+
+	dialect := dbxsql.SQLite3()
+	schema := dbxsql.SchemaFromIRModels(root.Models, dialect)
+	for _, stmt := range dbxsql.CreateSchemaSQL(schema, false) {
+		if _, err := db.Exec(sqlgen.Render(dialect, stmt)); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	tables := map[string]*ir.Model{}
+	for _, model := range root.Models {
+		tables[model.Table] = model
+	}
+
+	var diagnostics errutil.Diagnostics
+	for _, explanation := range explanations {
+		pos, model, ok := checked(explanation.Op)
+		if !ok {
+			continue
+		}
+		for _, query := range explanation.Queries {
+			stmt := strings.Replace(query.SQL, "<sets>",
+				model.Fields[0].Column+" = "+model.Fields[0].Column, -1)
+			scans, err := fullScans(db, stmt)
+			if err != nil {
+				return err
+			}
+			for _, scan := range scans {
+				if tables[scan.table] == nil {
+					continue
+				}
+				rows, known := opts.Rows[scan.table]
+				if known && rows <= opts.Threshold {
+					continue
+				}
+				var when string
+				if len(query.Conditions) > 0 {
+					when = " when " + strings.Join(query.Conditions, " and ")
+				}
+				diagnostics.Add(errutil.New(pos,
+					"%s scans table %q%s (%s)", explanation.Name,
+					scan.table, when, scan.detail))
+			}
+		}
+	}
+	return diagnostics.Err()
+}
+
+// checked returns the position and model of the operation if its plans
+// should be checked.
+func checked(op interface{}) (pos scanner.Position, model *ir.Model,
+	ok bool) {
+
+	var nolint []*ir.NoLint
+	switch op := op.(type) {
+	case *ir.Read:
+		if op.View == ir.Dynamic {
+			return pos, nil, false
+		}
+		pos, model, nolint = op.Pos, op.From, op.NoLint
+	case *ir.Update:
+		pos, model, nolint = op.Pos, op.Model, op.NoLint
+	case *ir.Delete:
+		pos, model, nolint = op.Pos, op.Model, op.NoLint
+	default:
+		return pos, nil, false
+	}
+	for _, suppressed := range nolint {
+		if suppressed.Rule == lint.Unindexed {
+			return pos, nil, false
+		}
+	}
+	return pos, model, true
+}
+
+type scan struct {
+	table  string
+	detail string
+}
+
+// fullScans returns the tables the query plan of stmt scans. Placeholders
+// are bound to null, which does not change the plan.
+func fullScans(db *sql.DB, stmt string) (scans []scan, err error) {
+	args := make([]interface{}, countPlaceholders(stmt))
+	rows, err := db.Query("EXPLAIN QUERY PLAN "+stmt, args...)
+	if err != nil {
+		return nil, Error.New("%s: %v", stmt, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for rows.Next() {
+		// the detail is the last column in every version of SQLite.
+		values := make([]interface{}, len(columns))
+		var detail string
+		for i := range values {
+			values[i] = new(interface{})
+		}
+		values[len(values)-1] = &detail
+		if err := rows.Scan(values...); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		// details look like "SCAN TABLE users" or "SCAN users AS u".
+		fields := strings.Fields(detail)
+		if len(fields) < 2 || fields[0] != "SCAN" {
+			continue
+		}
+		table := fields[1]
+		if table == "TABLE" && len(fields) > 2 {
+			table = fields[2]
+		}
+		scans = append(scans, scan{table: table, detail: detail})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scans, nil
+}
+
+// countPlaceholders returns the number of placeholders in stmt, skipping
+// question marks in string literals and quoted identifiers.
+func countPlaceholders(stmt string) (count int) {
+	var quote byte
+	for i := 0; i < len(stmt); i++ {
+		switch ch := stmt[i]; {
+		case quote != 0:
+			// a doubled quote is an escaped one, which closes and reopens
+			// the literal.
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == '?':
+			count++
+		}
+	}
+	return count
+}
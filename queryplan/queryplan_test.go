@@ -0,0 +1,163 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryplan
+
+import (
+	"testing"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/code/golang"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir/xform"
+	dbxsql "gopkg.in/spacemonkeygo/dbx.v1/sql"
+	"gopkg.in/spacemonkeygo/dbx.v1/syntax"
+	"gopkg.in/spacemonkeygo/dbx.v1/templates"
+	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
+	"gopkg.in/spacemonkeygo/dbx.v1/tmplutil"
+)
+
+const plansDBX = `
+model user (
+	key pk
+	unique email
+	field pk    serial64
+	field email text
+	field nick  text ( nullable, updatable )
+)
+
+model setting (
+	key pk
+	field pk   serial64
+	field name text
+)
+
+read one (
+	select user
+	where user.email = ?
+)
+
+read all (
+	select user
+	where user.nick = ?
+)
+
+read all (
+	select user
+	where user.nick = "admin"
+	nolint unindexed
+)
+
+read all (
+	select setting
+	where setting.name = ?
+)
+
+update user ( where user.email = ? )
+`
+
+func verify(t *testutil.T, source string, opts Options) error {
+	ast_root, err := syntax.Parse("plans.dbx", []byte(source))
+	t.AssertNoError(err)
+	root, err := xform.Transform(ast_root)
+	t.AssertNoError(err)
+
+	renderer, err := golang.New(tmplutil.BinLoader(templates.Asset),
+		&golang.Options{})
+	t.AssertNoError(err)
+	explanations, err := renderer.Explain(root, dbxsql.SQLite3())
+	t.AssertNoError(err)
+
+	db, err := Open()
+	t.AssertNoError(err)
+	defer db.Close()
+
+	return Verify(db, root, explanations, opts)
+}
+
+func TestVerify(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	errs := errutil.Errors(verify(tw, plansDBX, Options{
+		Rows:      map[string]int64{"settings": 10},
+		Threshold: 100,
+	}))
+
+	// both variants of the nullable nick condition scan users, while the
+	// suppressed read and the small settings table are allowed.
+	if len(errs) != 2 {
+		tw.Fatalf("expected 2 errors, got %d: %v", len(errs), errs)
+	}
+	tw.AssertContains(errs[0].Error(),
+		`All_User_By_Nick scans table "users" when users.nick = ?`)
+	tw.AssertContains(errs[1].Error(),
+		`All_User_By_Nick scans table "users" when users.nick is null`)
+}
+
+func TestVerifyThreshold(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	err := verify(tw, plansDBX, Options{
+		Rows:      map[string]int64{"settings": 1000, "users": 10},
+		Threshold: 100,
+	})
+	tw.AssertError(err, `All_Setting_By_Name scans table "settings"`)
+	if len(errutil.Errors(err)) != 1 {
+		tw.Fatalf("expected 1 error, got %v", err)
+	}
+}
+
+func TestVerifyOperators(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	// the REGEXP operator needs the regexp function, and question marks in
+	// literals are not placeholders, or the plans could not be made at all.
+	err := verify(tw, `
+model user (
+	key pk
+	index ( fields name )
+	field pk   serial64
+	field name text
+)
+
+read all (
+	select user
+	where user.name regexp ?
+	where user.name != "?"
+)
+`, Options{
+		Rows:      map[string]int64{"users": 10},
+		Threshold: 100,
+	})
+	tw.AssertNoError(err)
+}
+
+func TestCountPlaceholders(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	for stmt, count := range map[string]int{
+		`SELECT a FROM t WHERE a = ? AND b = ?`:        2,
+		`SELECT a FROM t WHERE a = '?' AND b = ?`:      1,
+		`SELECT a FROM t WHERE a = 'it''s?' AND b = ?`: 1,
+		`SELECT "a?" FROM t WHERE a = ?`:               1,
+	} {
+		if got := countPlaceholders(stmt); got != count {
+			tw.Errorf("%s: expected %d placeholders, got %d", stmt, count,
+				got)
+		}
+	}
+}
@@ -47,10 +47,11 @@ func testRunFile(t *testutil.T, dbx_file string) {
 	t.AssertNoError(err)
 	defer os.RemoveAll(dir)
 
-	t.Logf("[%s] generating... {rx:%t, userdata:%t}", dbx_file,
-		d.has("rx"), d.has("userdata"))
+	t.Logf("[%s] generating... {rx:%t, userdata:%t, prepared:%t}", dbx_file,
+		d.has("rx"), d.has("userdata"), d.has("prepared"))
 	err = golangCmd("main", []string{"sqlite3"}, "",
-		d.has("rx"), d.has("userdata"), dbx_file, dir)
+		d.has("rx"), d.has("userdata"), d.has("prepared"), "", dbx_file,
+		dir)
 	if d.has("fail_gen") {
 		t.AssertError(err, d.get("fail_gen"))
 		return
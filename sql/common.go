@@ -14,7 +14,10 @@
 
 package sql
 
-import "gopkg.in/spacemonkeygo/dbx.v1/ir"
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
+)
 
 type Features struct {
 	// Supports the RETURNING syntax on INSERT/UPDATE
@@ -25,6 +28,24 @@ type Features struct {
 
 	// Token used with LIMIT to mean "no limit"
 	NoLimitToken string
+
+	// Supports row locking clauses like FOR UPDATE on SELECT
+	RowLocking bool
+
+	// Supports the case insensitive ILIKE operator
+	ILike bool
+
+	// Supports the IS DISTINCT FROM operator
+	IsDistinctFrom bool
+
+	// Operator used to match a regular expression
+	RegexpOperator string
+
+	// Supports NULLS FIRST and NULLS LAST in ORDER BY
+	NullsOrdering bool
+
+	// Supports choosing the index method with USING
+	IndexMethods bool
 }
 
 type Dialect interface {
@@ -32,7 +53,15 @@ type Dialect interface {
 	Features() Features
 	RowId() string
 	ColumnType(field *ir.Field) string
+	// CatalogType returns how the database catalog reports the type of a
+	// column created with the given column type.
+	CatalogType(column_type string) string
 	Rebind(sql string) string
 	EscapeString(s string) string
 	BoolLit(v bool) string
+	Now() string
+	DateTrunc(unit string, expr sqlgen.SQL) sqlgen.SQL
+	// TimeValue wraps a timestamp so that it compares by the instant it
+	// names rather than by how it is written.
+	TimeValue(expr sqlgen.SQL) sqlgen.SQL
 }
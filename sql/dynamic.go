@@ -0,0 +1,60 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlcompile"
+)
+
+// DynamicWhereSQL returns the sql for each where of a dynamic read on its
+// own, in the same order as the wheres, so that they can be added to the
+// where hole as the caller chooses. wheres comparing against a nullable
+// field are returned as a condition named "cond".
+func DynamicWhereSQL(wheres []*ir.Where, dialect Dialect) (
+	out []sqlgen.SQL) {
+
+	for _, where := range wheres {
+		if where.NeedsCondition() {
+			out = append(out, &sqlgen.Condition{
+				Name:  "cond",
+				Left:  ExprSQL(where.Left, dialect).Render(),
+				Equal: where.Op == "=",
+				Right: ExprSQL(where.Right, dialect).Render(),
+			})
+			continue
+		}
+		out = append(out, sqlcompile.Compile(whereSQL(where, dialect, true)))
+	}
+	return out
+}
+
+// DynamicOrderBySQL returns the ORDER BY terms that sort on the order by
+// entry in the given direction, keeping its nulls ordering.
+func DynamicOrderBySQL(entry *ir.OrderByEntry, descending bool,
+	dialect Dialect) string {
+
+	order_by := OrderByFromIROrderBy(&ir.OrderBy{
+		Entries: []*ir.OrderByEntry{{
+			Field:      entry.Field,
+			Descending: descending,
+			Nulls:      entry.Nulls,
+		}},
+	}, dialect)
+	return strings.Join(order_by.Terms, ", ")
+}
@@ -23,6 +23,12 @@ import (
 )
 
 func ExprSQL(expr *ir.Expr, dialect Dialect) sqlgen.SQL {
+	return exprSQL(expr, dialect, true)
+}
+
+// exprSQL renders the expression with fields qualified by their table if
+// qualify is set. index definitions refer to bare columns.
+func exprSQL(expr *ir.Expr, dialect Dialect, qualify bool) sqlgen.SQL {
 	switch {
 	case expr.Null:
 		return L("NULL")
@@ -34,15 +40,35 @@ func ExprSQL(expr *ir.Expr, dialect Dialect) sqlgen.SQL {
 		return L(dialect.BoolLit(*expr.BoolLit))
 	case expr.Placeholder:
 		return L("?")
-	case expr.Field != nil:
+	case expr.Field != nil && qualify:
 		return L(expr.Field.ColumnRef())
+	case expr.Field != nil:
+		return L(expr.Field.Column)
 	case expr.FuncCall != nil:
-		var args []sqlgen.SQL
-		for _, arg := range expr.FuncCall.Args {
-			args = append(args, ExprSQL(arg, dialect))
-		}
-		return J("", L(expr.FuncCall.Name), L("("), J(", ", args...), L(")"))
+		return funcCallSQL(expr.FuncCall, dialect, qualify)
 	default:
 		panic(fmt.Sprintf("unhandled expression variant: %+v", expr))
 	}
 }
+
+func FuncCallSQL(call *ir.FuncCall, dialect Dialect) sqlgen.SQL {
+	return funcCallSQL(call, dialect, true)
+}
+
+func funcCallSQL(call *ir.FuncCall, dialect Dialect,
+	qualify bool) sqlgen.SQL {
+
+	switch call.Name {
+	case "now":
+		return L(dialect.Now())
+	case "date_trunc":
+		return dialect.DateTrunc(*call.Args[0].StringLit,
+			exprSQL(call.Args[1], dialect, qualify))
+	}
+
+	var args []sqlgen.SQL
+	for _, arg := range call.Args {
+		args = append(args, exprSQL(arg, dialect, qualify))
+	}
+	return J("", L(call.Name), L("("), J(", ", args...), L(")"))
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+)
+
+func LockFromIRLock(ir_lock *ir.Lock) string {
+	var clause string
+	switch ir_lock.Mode {
+	case consts.LockUpdate:
+		clause = "FOR UPDATE"
+	case consts.LockShare:
+		clause = "FOR SHARE"
+	default:
+		panic(fmt.Sprintf("unhandled lock mode %d", ir_lock.Mode))
+	}
+	if ir_lock.SkipLocked {
+		clause += " SKIP LOCKED"
+	}
+	return clause
+}
@@ -15,32 +15,58 @@
 package sql
 
 import (
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
 	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
 	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlcompile"
 	. "gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlhelpers"
 )
 
+// OrderBy holds the terms of an ORDER BY clause, each already rendered with
+// its direction and nulls ordering.
 type OrderBy struct {
-	Fields     []string
-	Descending bool
+	Terms []string
 }
 
-func OrderByFromIROrderBy(ir_order_by *ir.OrderBy) (order_by *OrderBy) {
-	order_by = &OrderBy{
-		Descending: ir_order_by.Descending,
-	}
-	for _, ir_field := range ir_order_by.Fields {
-		order_by.Fields = append(order_by.Fields, ir_field.ColumnRef())
+func OrderByFromIROrderBy(ir_order_by *ir.OrderBy, dialect Dialect) (
+	order_by *OrderBy) {
+
+	order_by = new(OrderBy)
+	for _, entry := range ir_order_by.Entries {
+		column := entry.Field.ColumnRef()
+		direction := ""
+		if entry.Descending {
+			direction = " DESC"
+		}
+
+		if entry.Nulls == consts.NullsDefault {
+			order_by.Terms = append(order_by.Terms, column+direction)
+			continue
+		}
+
+		if dialect.Features().NullsOrdering {
+			nulls := " NULLS LAST"
+			if entry.Nulls == consts.NullsFirst {
+				nulls = " NULLS FIRST"
+			}
+			order_by.Terms = append(order_by.Terms,
+				column+direction+nulls)
+			continue
+		}
+
+		// emulate the nulls ordering by sorting on whether the column is
+		// null first. false sorts before true.
+		is_null := column + " IS NULL"
+		if entry.Nulls == consts.NullsFirst {
+			is_null += " DESC"
+		}
+		order_by.Terms = append(order_by.Terms, is_null, column+direction)
 	}
 	return order_by
 }
 
 func SQLFromOrderBy(order_by *OrderBy) sqlgen.SQL {
 	stmt := Build(L("ORDER BY"))
-	stmt.Add(J(", ", Strings(order_by.Fields)...))
-	if order_by.Descending {
-		stmt.Add(L("DESC"))
-	}
+	stmt.Add(J(", ", Strings(order_by.Terms)...))
 	return sqlcompile.Compile(stmt.SQL())
 }
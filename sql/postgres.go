@@ -21,6 +21,8 @@ import (
 
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
+	. "gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlhelpers"
 )
 
 type postgres struct {
@@ -39,6 +41,12 @@ func (p *postgres) Features() Features {
 		Returning:           true,
 		PositionalArguments: true,
 		NoLimitToken:        "ALL",
+		RowLocking:          true,
+		ILike:               true,
+		IsDistinctFrom:      true,
+		RegexpOperator:      "~",
+		NullsOrdering:       true,
+		IndexMethods:        true,
 	}
 }
 
@@ -84,6 +92,23 @@ func (p *postgres) ColumnType(field *ir.Field) string {
 	}
 }
 
+// CatalogType returns the type in the form of the information schema with
+// any length appended, like "character varying(10)".
+func (p *postgres) CatalogType(column_type string) string {
+	switch column_type {
+	case "serial":
+		return "integer"
+	case "bigserial":
+		return "bigint"
+	case "timestamp":
+		return "timestamp without time zone"
+	}
+	if strings.HasPrefix(column_type, "varchar(") {
+		return "character varying" + column_type[len("varchar"):]
+	}
+	return column_type
+}
+
 func (p *postgres) Rebind(sql string) string {
 	out := make([]byte, 0, len(sql)+10)
 
@@ -120,3 +145,15 @@ func (p *postgres) BoolLit(v bool) string {
 	}
 	return "false"
 }
+
+func (p *postgres) Now() string {
+	return "now()"
+}
+
+func (p *postgres) DateTrunc(unit string, expr sqlgen.SQL) sqlgen.SQL {
+	return J("", Lf("date_trunc('%s', ", unit), expr, L(")"))
+}
+
+func (p *postgres) TimeValue(expr sqlgen.SQL) sqlgen.SQL {
+	return expr
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
 	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
 	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlcompile"
@@ -34,10 +35,25 @@ type Schema struct {
 }
 
 type Table struct {
+	Name        string
+	Columns     []Column
+	PrimaryKey  []string
+	Unique      [][]string
+	Checks      []Check
+	ForeignKeys []ForeignKey
+}
+
+type ForeignKey struct {
+	Columns    []string
+	Table      string
+	References []string
+	OnDelete   string
+	OnUpdate   string
+}
+
+type Check struct {
 	Name       string
-	Columns    []Column
-	PrimaryKey []string
-	Unique     [][]string
+	Conditions []string
 }
 
 type Column struct {
@@ -59,6 +75,8 @@ type Index struct {
 	Table   string
 	Columns []string
 	Unique  bool
+	Where   []string
+	Using   string
 }
 
 func SchemaFromIRModels(ir_models []*ir.Model, dialect Dialect) *Schema {
@@ -77,6 +95,16 @@ func SchemaFromIRModels(ir_models []*ir.Model, dialect Dialect) *Schema {
 			}
 			table.Unique = append(table.Unique, unique)
 		}
+		for _, ir_check := range ir_model.Checks {
+			check := Check{
+				Name: ir_check.Name,
+			}
+			for _, ir_where := range ir_check.Wheres {
+				check.Conditions = append(check.Conditions,
+					whereSQL(ir_where, dialect, false).Render())
+			}
+			table.Checks = append(table.Checks, check)
+		}
 		for _, ir_field := range ir_model.Fields {
 			column := Column{
 				Name:    ir_field.Column,
@@ -85,35 +113,45 @@ func SchemaFromIRModels(ir_models []*ir.Model, dialect Dialect) *Schema {
 			}
 			if ir_field.Relation != nil {
 				column.Reference = &Reference{
-					Table:  ir_field.Relation.Field.Model.Table,
-					Column: ir_field.Relation.Field.Column,
-				}
-				switch ir_field.Relation.Kind {
-				case consts.SetNull:
-					column.Reference.OnDelete = "SET NULL"
-
-				case consts.Cascade:
-					column.Reference.OnDelete = "CASCADE"
-
-				case consts.Restrict:
-					column.Reference.OnDelete = ""
-
-				default:
-					panic(fmt.Sprintf("unhandled relation kind %q",
-						ir_field.Relation.Kind))
+					Table:    ir_field.Relation.Field.Model.Table,
+					Column:   ir_field.Relation.Field.Column,
+					OnDelete: relationActionSQL(ir_field.Relation.Kind),
+					OnUpdate: relationActionSQL(ir_field.Relation.OnUpdate),
 				}
 			}
 			table.Columns = append(table.Columns, column)
 		}
+		for _, ir_foreign_key := range ir_model.ForeignKeys {
+			foreign_key := ForeignKey{
+				Table:    ir_foreign_key.ReferencedModel().Table,
+				OnDelete: relationActionSQL(ir_foreign_key.OnDelete),
+				OnUpdate: relationActionSQL(ir_foreign_key.OnUpdate),
+			}
+			for _, ir_field := range ir_foreign_key.Fields {
+				foreign_key.Columns = append(foreign_key.Columns,
+					ir_field.Column)
+			}
+			for _, ir_field := range ir_foreign_key.References {
+				foreign_key.References = append(foreign_key.References,
+					ir_field.Column)
+			}
+			table.ForeignKeys = append(table.ForeignKeys, foreign_key)
+		}
 		schema.Tables = append(schema.Tables, table)
 		for _, ir_index := range ir_model.Indexes {
 			index := Index{
 				Name:   ir_index.Name,
 				Table:  ir_index.Model.Table,
 				Unique: ir_index.Unique,
+				Using:  ir_index.Using,
+			}
+			for _, ir_column := range ir_index.Columns {
+				index.Columns = append(index.Columns,
+					indexColumnSQL(ir_column, dialect))
 			}
-			for _, ir_field := range ir_index.Fields {
-				index.Columns = append(index.Columns, ir_field.Column)
+			for _, ir_where := range ir_index.Where {
+				index.Where = append(index.Where,
+					whereSQL(ir_where, dialect, false).Render())
 			}
 			schema.Indexes = append(schema.Indexes, index)
 		}
@@ -122,7 +160,18 @@ func SchemaFromIRModels(ir_models []*ir.Model, dialect Dialect) *Schema {
 }
 
 func SQLFromSchema(schema *Schema) sqlgen.SQL {
-	var stmts []sqlgen.SQL
+	return sqlcompile.Compile(J("\n", CreateSchemaSQL(schema, false)...))
+}
+
+// CreateSchemaSQL returns the statements that create the tables, in the
+// order they depend on each other, followed by the indexes.
+func CreateSchemaSQL(schema *Schema, if_not_exists bool) (
+	stmts []sqlgen.SQL) {
+
+	var if_not_exists_sql string
+	if if_not_exists {
+		if_not_exists_sql = "IF NOT EXISTS "
+	}
 
 	for _, table := range schema.Tables {
 		var dirs []sqlgen.SQL
@@ -158,10 +207,32 @@ func SQLFromSchema(schema *Schema) sqlgen.SQL {
 			dirs = append(dirs, dir.SQL())
 		}
 
+		for _, foreign_key := range table.ForeignKeys {
+			dir := Build(L("FOREIGN KEY ("))
+			dir.Add(J(", ", Strings(foreign_key.Columns)...))
+			dir.Add(Lf(") REFERENCES %s (", foreign_key.Table))
+			dir.Add(J(", ", Strings(foreign_key.References)...))
+			dir.Add(L(")"))
+			if foreign_key.OnDelete != "" {
+				dir.Add(Lf("ON DELETE %s", foreign_key.OnDelete))
+			}
+			if foreign_key.OnUpdate != "" {
+				dir.Add(Lf("ON UPDATE %s", foreign_key.OnUpdate))
+			}
+			dirs = append(dirs, dir.SQL())
+		}
+
+		for _, check := range table.Checks {
+			dir := Build(Lf("CONSTRAINT %s CHECK (", check.Name))
+			dir.Add(J(" AND ", Strings(check.Conditions)...))
+			dir.Add(L(")"))
+			dirs = append(dirs, dir.SQL())
+		}
+
 		directives := J(",\n\t", dirs...)
 
 		stmt := J("",
-			Lf("CREATE TABLE %s (\n\t", table.Name),
+			Lf("CREATE TABLE %s%s (\n\t", if_not_exists_sql, table.Name),
 			directives,
 			Lf("\n);"),
 		)
@@ -174,12 +245,72 @@ func SQLFromSchema(schema *Schema) sqlgen.SQL {
 		if index.Unique {
 			stmt.Add(L("UNIQUE"))
 		}
-		stmt.Add(Lf("INDEX %s ON %s (", index.Name, index.Table))
+		stmt.Add(Lf("INDEX %s%s ON %s", if_not_exists_sql, index.Name,
+			index.Table))
+		if index.Using != "" {
+			stmt.Add(Lf("USING %s", index.Using))
+		}
+		stmt.Add(L("("))
 		stmt.Add(J(", ", Strings(index.Columns)...))
-		stmt.Add(L(");"))
+		if len(index.Where) > 0 {
+			stmt.Add(L(")"), L("WHERE"))
+			stmt.Add(J("", J(" AND ", Strings(index.Where)...), L(";")))
+		} else {
+			stmt.Add(L(");"))
+		}
 
 		stmts = append(stmts, stmt.SQL())
 	}
 
-	return sqlcompile.Compile(J("\n", stmts...))
+	return stmts
+}
+
+// DropSchemaSQL returns the statements that drop the tables in the reverse
+// of the order they were created in. indexes are dropped with their tables.
+func DropSchemaSQL(schema *Schema) (stmts []sqlgen.SQL) {
+	for i := len(schema.Tables) - 1; i >= 0; i-- {
+		stmts = append(stmts,
+			Lf("DROP TABLE IF EXISTS %s;", schema.Tables[i].Name))
+	}
+	return stmts
+}
+
+func relationActionSQL(kind consts.RelationKind) string {
+	switch kind {
+	case consts.SetNull:
+		return "SET NULL"
+	case consts.Cascade:
+		return "CASCADE"
+	case consts.Restrict:
+		return ""
+	default:
+		panic(fmt.Sprintf("unhandled relation kind %q", kind))
+	}
+}
+
+func indexColumnSQL(column *ir.IndexColumn, dialect Dialect) string {
+	sql := exprSQL(column.Expr, dialect, false).Render()
+	if column.Expr.Field == nil {
+		// expressions other than a bare column have to be parenthesized
+		sql = "(" + sql + ")"
+	}
+	if column.Descending {
+		sql += " DESC"
+	}
+	return sql
+}
+
+// CheckSchemaSupport returns an error for the first part of the schema of
+// the models that the dialect cannot create.
+func CheckSchemaSupport(ir_models []*ir.Model, dialect Dialect) error {
+	for _, ir_model := range ir_models {
+		for _, ir_index := range ir_model.Indexes {
+			if ir_index.Using != "" && !dialect.Features().IndexMethods {
+				return errutil.New(ir_index.Pos,
+					"dialect %q does not support index methods like %q",
+					dialect.Name(), ir_index.Using)
+			}
+		}
+	}
+	return nil
 }
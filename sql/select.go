@@ -52,7 +52,14 @@ type Select struct {
 	GroupBy *GroupBy
 	Limit   string
 	Offset  string
+	Lock    string
 	Has     bool
+
+	// Holes filled in at runtime in place of the where, order by and
+	// limit/offset clauses
+	WhereHole   *sqlgen.Hole
+	OrderByHole *sqlgen.Hole
+	LimitHole   *sqlgen.Hole
 }
 
 func SelectFromIRRead(ir_read *ir.Read, dialect Dialect) *Select {
@@ -67,14 +74,23 @@ func SelectFromIRRead(ir_read *ir.Read, dialect Dialect) *Select {
 	}
 
 	if ir_read.OrderBy != nil {
-		sel.OrderBy = OrderByFromIROrderBy(ir_read.OrderBy)
+		sel.OrderBy = OrderByFromIROrderBy(ir_read.OrderBy, dialect)
 	}
 	if ir_read.GroupBy != nil {
 		sel.GroupBy = GroupByFromIRGroupBy(ir_read.GroupBy)
 	}
+	if ir_read.Lock != nil {
+		sel.Lock = LockFromIRLock(ir_read.Lock)
+	}
 
 	switch ir_read.View {
-	case ir.All:
+	case ir.All, ir.Iterate:
+	case ir.Dynamic:
+		sel.Where = nil
+		sel.OrderBy = nil
+		sel.WhereHole = Hole("where")
+		sel.OrderByHole = Hole("order_by")
+		sel.LimitHole = Hole("limit")
 	case ir.One, ir.Scalar:
 		if !ir_read.Distinct() {
 			sel.Limit = "2"
@@ -94,7 +110,7 @@ func SelectFromIRRead(ir_read *ir.Read, dialect Dialect) *Select {
 			},
 		}}, dialect)...)
 		sel.OrderBy = &OrderBy{
-			Fields: []string{pk.ColumnRef()},
+			Terms: []string{pk.ColumnRef()},
 		}
 		sel.Limit = "?"
 		sel.Fields = append(sel.Fields, pk.SelectRefs()...)
@@ -133,10 +149,18 @@ func SQLFromSelect(sel *Select) sqlgen.SQL {
 		stmt.Add(L("WHERE"), J(" AND ", sel.Where...))
 	}
 
+	if sel.WhereHole != nil {
+		stmt.Add(sel.WhereHole)
+	}
+
 	if sel.OrderBy != nil {
 		stmt.Add(SQLFromOrderBy(sel.OrderBy))
 	}
 
+	if sel.OrderByHole != nil {
+		stmt.Add(sel.OrderByHole)
+	}
+
 	if sel.GroupBy != nil {
 		stmt.Add(SQLFromGroupBy(sel.GroupBy))
 	}
@@ -149,6 +173,14 @@ func SQLFromSelect(sel *Select) sqlgen.SQL {
 		stmt.Add(Lf("OFFSET %s", sel.Offset))
 	}
 
+	if sel.LimitHole != nil {
+		stmt.Add(sel.LimitHole)
+	}
+
+	if sel.Lock != "" {
+		stmt.Add(L(sel.Lock))
+	}
+
 	if sel.Has {
 		stmt.Add(L(")"))
 	}
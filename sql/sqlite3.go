@@ -20,6 +20,8 @@ import (
 
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
 	"gopkg.in/spacemonkeygo/dbx.v1/ir"
+	"gopkg.in/spacemonkeygo/dbx.v1/sqlgen"
+	. "gopkg.in/spacemonkeygo/dbx.v1/sqlgen/sqlhelpers"
 )
 
 type sqlite3 struct {
@@ -37,6 +39,18 @@ func (s *sqlite3) Features() Features {
 	return Features{
 		Returning:    false,
 		NoLimitToken: "-1",
+		RowLocking:   false,
+
+		// ILIKE is emulated with lower() and IS DISTINCT FROM with IS NOT,
+		// and REGEXP calls the regexp function registered on every
+		// connection.
+		ILike:          false,
+		IsDistinctFrom: false,
+		RegexpOperator: "REGEXP",
+
+		// NULLS FIRST and NULLS LAST are emulated with an IS NULL term.
+		NullsOrdering: false,
+		IndexMethods:  false,
 	}
 }
 
@@ -67,6 +81,12 @@ func (s *sqlite3) ColumnType(field *ir.Field) string {
 	}
 }
 
+// CatalogType returns the column type unchanged because sqlite reports the
+// declared type of columns.
+func (s *sqlite3) CatalogType(column_type string) string {
+	return column_type
+}
+
 func (s *sqlite3) Rebind(sql string) string {
 	return sql
 }
@@ -85,3 +105,31 @@ func (p *sqlite3) BoolLit(v bool) string {
 	}
 	return "0"
 }
+
+// times are stored as text in the format the driver writes them in, which
+// keeps the zone offset of the time. the current time and truncated times
+// are written in UTC the same way, and wheres that use them compare through
+// TimeValue so that times written with other offsets compare correctly.
+func (p *sqlite3) Now() string {
+	return "STRFTIME('%Y-%m-%d %H:%M:%f+00:00', 'now')"
+}
+
+var sqlite3DateTruncFormats = map[string]string{
+	"year":   "%Y-01-01 00:00:00+00:00",
+	"month":  "%Y-%m-01 00:00:00+00:00",
+	"day":    "%Y-%m-%d 00:00:00+00:00",
+	"hour":   "%Y-%m-%d %H:00:00+00:00",
+	"minute": "%Y-%m-%d %H:%M:00+00:00",
+}
+
+func (p *sqlite3) DateTrunc(unit string, expr sqlgen.SQL) sqlgen.SQL {
+	format, ok := sqlite3DateTruncFormats[unit]
+	if !ok {
+		panic(fmt.Sprintf("unhandled date_trunc unit %q", unit))
+	}
+	return J("", Lf("STRFTIME('%s', ", format), expr, L(")"))
+}
+
+func (p *sqlite3) TimeValue(expr sqlgen.SQL) sqlgen.SQL {
+	return J("", L("JULIANDAY("), expr, L(")"))
+}
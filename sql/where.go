@@ -32,10 +32,7 @@ func WhereSQL(wheres []*ir.Where, dialect Dialect) (out []sqlgen.SQL) {
 		if where.NeedsCondition() {
 			continue
 		}
-		out = append(out,
-			J(" ", ExprSQL(where.Left, dialect),
-				opSQL(where.Op, where.Left, where.Right),
-				ExprSQL(where.Right, dialect)))
+		out = append(out, whereSQL(where, dialect, true))
 	}
 
 	conditions := 0
@@ -45,9 +42,9 @@ func WhereSQL(wheres []*ir.Where, dialect Dialect) (out []sqlgen.SQL) {
 		}
 		out = append(out, &sqlgen.Condition{
 			Name:  fmt.Sprintf("cond_%d", conditions),
-			Left:  ExprSQL(where.Left, dialect).Render(),
+			Left:  operandSQL(where, where.Left, dialect, true).Render(),
 			Equal: where.Op == "=",
-			Right: ExprSQL(where.Right, dialect).Render(),
+			Right: operandSQL(where, where.Right, dialect, true).Render(),
 		})
 		conditions++
 	}
@@ -55,7 +52,61 @@ func WhereSQL(wheres []*ir.Where, dialect Dialect) (out []sqlgen.SQL) {
 	return out
 }
 
-func opSQL(op consts.Operator, left, right *ir.Expr) sqlgen.SQL {
+func whereSQL(where *ir.Where, dialect Dialect, qualify bool) sqlgen.SQL {
+	left := operandSQL(where, where.Left, dialect, qualify)
+	right := operandSQL(where, where.Right, dialect, qualify)
+
+	switch where.Op {
+	case consts.Between:
+		return J(" ", left, L("BETWEEN"), right, L("AND"),
+			operandSQL(where, where.Upper, dialect, qualify))
+	case consts.ILike:
+		if !dialect.Features().ILike {
+			return J(" ", lowerSQL(left), L("LIKE"), lowerSQL(right))
+		}
+	}
+
+	return J(" ", left, opSQL(where.Op, where.Left, where.Right, dialect),
+		right)
+}
+
+// operandSQL renders one side of the where. the time functions may render
+// their values differently from how the driver stores times, so wheres that
+// use them compare every side through the dialect's TimeValue.
+func operandSQL(where *ir.Where, expr *ir.Expr, dialect Dialect,
+	qualify bool) sqlgen.SQL {
+
+	sql := exprSQL(expr, dialect, qualify)
+	if usesTimeFunc(where.Left) || usesTimeFunc(where.Right) ||
+		(where.Upper != nil && usesTimeFunc(where.Upper)) {
+		return dialect.TimeValue(sql)
+	}
+	return sql
+}
+
+func usesTimeFunc(expr *ir.Expr) bool {
+	if expr.FuncCall == nil {
+		return false
+	}
+	switch expr.FuncCall.Name {
+	case "now", "date_trunc":
+		return true
+	}
+	for _, arg := range expr.FuncCall.Args {
+		if usesTimeFunc(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+func lowerSQL(sql sqlgen.SQL) sqlgen.SQL {
+	return J("", L("lower("), sql, L(")"))
+}
+
+func opSQL(op consts.Operator, left, right *ir.Expr,
+	dialect Dialect) sqlgen.SQL {
+
 	switch op {
 	case consts.EQ:
 		if left.Null || right.Null {
@@ -65,6 +116,12 @@ func opSQL(op consts.Operator, left, right *ir.Expr) sqlgen.SQL {
 		if left.Null || right.Null {
 			return L("is not")
 		}
+	case consts.IsDistinctFrom:
+		if !dialect.Features().IsDistinctFrom {
+			return L("IS NOT")
+		}
+	case consts.Regexp:
+		return L(dialect.Features().RegexpOperator)
 	}
 	return L(strings.ToUpper(string(op)))
 }
@@ -16,10 +16,12 @@ package syntax
 
 import (
 	"sort"
+	"strconv"
 
 	"github.com/spacemonkeygo/errors"
 	"gopkg.in/spacemonkeygo/dbx.v1/ast"
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
 )
 
 var (
@@ -46,6 +48,27 @@ func tupleFlagField(kind, field string, val **ast.Bool) func(*tupleNode) error {
 	}
 }
 
+func tupleIntField(kind, field string, val **ast.Int) func(*tupleNode) error {
+	return func(node *tupleNode) error {
+		if *val != nil {
+			return previouslyDefined(node.getPos(), kind, field, (*val).Pos)
+		}
+
+		int_token, err := node.consumeToken(Int)
+		if err != nil {
+			return err
+		}
+		value, err := strconv.Atoi(int_token.text)
+		if err != nil {
+			return errutil.New(int_token.getPos(),
+				"unable to parse integer %q: %v", int_token.text, err)
+		}
+		*val = intFromValue(int_token, value)
+
+		return nil
+	}
+}
+
 func tokenFlagField(kind, field string, val **ast.Bool) func(*tokenNode) error {
 	return func(node *tokenNode) error {
 		if *val != nil {
@@ -158,6 +181,20 @@ func relationKindFromValue(n node, val consts.RelationKind) *ast.RelationKind {
 	}
 }
 
+func lockModeFromValue(n node, val consts.LockMode) *ast.LockMode {
+	return &ast.LockMode{
+		Pos:   n.getPos(),
+		Value: val,
+	}
+}
+
+func nullsOrderFromValue(n node, val consts.NullsOrder) *ast.NullsOrder {
+	return &ast.NullsOrder{
+		Pos:   n.getPos(),
+		Value: val,
+	}
+}
+
 func operatorFromValue(n node, val consts.Operator) *ast.Operator {
 	return &ast.Operator{
 		Pos:   n.getPos(),
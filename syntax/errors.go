@@ -24,9 +24,11 @@ func expectedKeyword(pos scanner.Position, actual string, expected ...string) (
 	err error) {
 
 	if len(expected) == 1 {
-		return errutil.New(pos, "expected %q, got %q", expected[0], actual)
+		return errutil.NewSuggest(pos, pos, actual, expected,
+			"expected %q, got %q", expected[0], actual)
 	} else {
-		return errutil.New(pos, "expected one of %q, got %q", expected, actual)
+		return errutil.NewSuggest(pos, pos, actual, expected,
+			"expected one of %q, got %q", expected, actual)
 	}
 }
 
@@ -63,7 +63,7 @@ func tupleGroups(node *listNode) (groups [][]*tupleNode, err error) {
 		}
 
 		last_line := group[len(group)-1].pos.Line
-		if tuple.pos.Line-last_line < 2 {
+		if tuple.startLine()-last_line < 2 {
 			group = append(group, tuple)
 			continue
 		}
@@ -119,6 +119,12 @@ func formatTupleGroup(indent int, group []*tupleNode) (
 	for i, words := range wordss {
 		list := lists[i]
 
+		for _, doc := range group[i].doc {
+			formatted = append(formatted, strings.Repeat("\t", indent)...)
+			formatted = append(formatted, doc...)
+			formatted = append(formatted, '\n')
+		}
+
 		line = append(line, strings.Join(words, " ")...)
 
 		if list == nil {
@@ -169,7 +175,7 @@ func stringifyTuple(tuple *tupleNode) (words []string, list *listNode,
 		switch node := token.(type) {
 		case *tokenNode:
 			switch node.tok {
-			case Ident:
+			case Ident, Int:
 				if dot {
 					word += node.text
 					dot = false
@@ -187,7 +193,7 @@ func stringifyTuple(tuple *tupleNode) (words []string, list *listNode,
 				word += "."
 				dot = true
 
-			case Question:
+			case Question, Float, String:
 				dot = false
 				operator = false
 				if word != "" {
@@ -213,10 +219,25 @@ func stringifyTuple(tuple *tupleNode) (words []string, list *listNode,
 
 		case *listNode:
 			if i != len(tuple.value)-1 {
-				invalid := tuple.value[i+1]
-				return nil, nil, errutil.New(invalid.getPos(),
-					"expected end of tuple. got a %s: %s",
-					invalid.nodeType(), invalid)
+				// lists in the middle of a tuple, like the field lists of
+				// a foreign key, are kept inline as a word.
+				if isListMultiLine(node) {
+					invalid := tuple.value[i+1]
+					return nil, nil, errutil.New(invalid.getPos(),
+						"expected end of tuple. got a %s: %s",
+						invalid.nodeType(), invalid)
+				}
+				formatted_list, err := formatSingleLineList(node)
+				if err != nil {
+					return nil, nil, err
+				}
+				if word != "" {
+					words = append(words, word)
+				}
+				word = string(formatted_list)
+				dot = false
+				operator = false
+				continue
 			}
 
 			if word != "" {
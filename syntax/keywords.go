@@ -0,0 +1,69 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import (
+	"sort"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+)
+
+// Keywords returns the words that name a tuple or an option in one, sorted,
+// for editors to complete.
+func Keywords() []string {
+	var words []string
+	for _, cases := range []tupleCases{
+		rootCases(new(ast.Root)),
+		modelCases(new(ast.Model)),
+		fieldCases(new(ast.Field)),
+		relationCases(new(ast.Field)),
+		indexCases(new(ast.Index)),
+		createCases(new(ast.Create)),
+		readCases(new(ast.Read)),
+		updateCases(new(ast.Update)),
+		deleteCases(new(ast.Delete)),
+	} {
+		words = append(words, cases.idents()...)
+	}
+	for _, cases := range []tokenCases{
+		relationKindCases(nil),
+		foreignKeyOptionCases(nil, new(ast.ForeignKey)),
+		lockModeCases(new(ast.Lock)),
+		lockOptionCases(new(ast.Lock)),
+	} {
+		words = append(words, cases.idents()...)
+	}
+	// these are matched in place rather than with cases.
+	words = append(words, "references", "asc", "desc", "nulls")
+
+	sort.Strings(words)
+	out := words[:0]
+	for i, word := range words {
+		if i == 0 || word != words[i-1] {
+			out = append(out, word)
+		}
+	}
+	return out
+}
+
+// Views returns the kinds of reads, sorted.
+func Views() []string {
+	return viewCases(new(ast.View)).idents()
+}
+
+// FieldTypes returns the names of the field types, sorted.
+func FieldTypes() []string {
+	return validFieldTypes()
+}
@@ -36,6 +36,7 @@ type listNode struct {
 
 type tupleNode struct {
 	pos   scanner.Position
+	doc   []string
 	value []node
 }
 
@@ -53,6 +54,21 @@ func (l listNode) getPos() scanner.Position  { return l.pos }
 func (t tupleNode) getPos() scanner.Position { return t.pos }
 func (t tokenNode) getPos() scanner.Position { return t.pos }
 
+// docText returns the doc comment of the tuple without the comment markers.
+func (t *tupleNode) docText() string {
+	var lines []string
+	for _, line := range t.doc {
+		line = strings.TrimPrefix(line, "//")
+		lines = append(lines, strings.TrimPrefix(line, " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// startLine returns the line the tuple starts on, including its doc comment.
+func (t *tupleNode) startLine() int {
+	return t.pos.Line - len(t.doc)
+}
+
 func (l listNode) String() string {
 	return fmt.Sprintf("(%s)", stringNodes(l.value, ", "))
 }
@@ -153,6 +169,7 @@ func newListNode(scanner *Scanner) (*listNode, error) {
 func newTupleNode(scanner *Scanner) (*tupleNode, error) {
 	t := &tupleNode{
 		pos: scanner.Pos(),
+		doc: scanner.DocComment(scanner.Pos()),
 	}
 
 	for {
@@ -331,6 +348,26 @@ func (t *tupleNode) consumeIfToken(kinds ...Token) *tokenNode {
 	return token
 }
 
+// consumeIfKeyword consumes the next token if it is the identifier text and
+// does not begin a dotted reference like "text.field".
+func (t *tupleNode) consumeIfKeyword(text string) *tokenNode {
+	if len(t.value) == 0 {
+		return nil
+	}
+	token, err := expectToken(t.value[0])
+	if err != nil || token.tok != Ident || token.text != text {
+		return nil
+	}
+	if len(t.value) > 1 {
+		next, err := expectToken(t.value[1])
+		if err == nil && next.tok == Dot {
+			return nil
+		}
+	}
+	t.consume()
+	return token
+}
+
 func (t *tupleNode) consumeTokenOrEmpty(kinds ...Token) (*tokenNode, error) {
 	if len(t.value) == 0 {
 		return nil, nil
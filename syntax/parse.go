@@ -14,7 +14,10 @@
 
 package syntax
 
-import "gopkg.in/spacemonkeygo/dbx.v1/ast"
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
 
 func Parse(path string, data []byte) (root *ast.Root, err error) {
 	scanner, err := NewScanner(path, data)
@@ -33,7 +36,24 @@ func parseRoot(scanner *Scanner) (*ast.Root, error) {
 
 	root := new(ast.Root)
 
-	err = list.consumeAnyTuples(tupleCases{
+	cases := rootCases(root)
+
+	// every top level tuple is parsed even after an error so that all of
+	// their errors are reported.
+	var diagnostics errutil.Diagnostics
+	for len(list.value) > 0 {
+		diagnostics.Add(list.consumeAnyTuple(cases))
+	}
+	if err := diagnostics.Err(); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// rootCases returns the top level tuples, parsed into root.
+func rootCases(root *ast.Root) tupleCases {
+	return tupleCases{
 		"model": func(node *tupleNode) error {
 			model, err := parseModel(node)
 			if err != nil {
@@ -79,10 +99,5 @@ func parseRoot(scanner *Scanner) (*ast.Root, error) {
 
 			return nil
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	return root, nil
 }
@@ -0,0 +1,62 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
+
+func parseCheck(node *tupleNode, on_field bool) (*ast.Check, error) {
+	check := new(ast.Check)
+	check.Pos = node.getPos()
+
+	list, err := node.consumeList()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tuple, err := list.consumeTupleOrEmpty()
+		if err != nil {
+			return nil, err
+		}
+		if tuple == nil {
+			break
+		}
+
+		var where *ast.Where
+		if on_field {
+			where = new(ast.Where)
+			where.Pos = tuple.getPos()
+			err = parseWhereOp(tuple, where)
+		} else {
+			where, err = parseWhere(tuple)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := tuple.assertEmpty(); err != nil {
+			return nil, err
+		}
+		check.Wheres = append(check.Wheres, where)
+	}
+
+	if len(check.Wheres) == 0 {
+		return nil, errutil.New(check.Pos, "check has no conditions")
+	}
+
+	return check, nil
+}
@@ -19,6 +19,7 @@ import "gopkg.in/spacemonkeygo/dbx.v1/ast"
 func parseCreate(node *tupleNode) (*ast.Create, error) {
 	cre := new(ast.Create)
 	cre.Pos = node.getPos()
+	cre.Doc = node.docText()
 
 	model_ref_token, err := node.consumeToken(Ident)
 	if err != nil {
@@ -31,7 +32,17 @@ func parseCreate(node *tupleNode) (*ast.Create, error) {
 		return nil, err
 	}
 
-	err = list_token.consumeAnyTuples(tupleCases{
+	err = list_token.consumeAnyTuples(createCases(cre))
+	if err != nil {
+		return nil, err
+	}
+
+	return cre, nil
+}
+
+// createCases returns the tuples of a create, parsed into cre.
+func createCases(cre *ast.Create) tupleCases {
+	return tupleCases{
 		"raw":      tupleFlagField("create", "raw", &cre.Raw),
 		"noreturn": tupleFlagField("create", "noreturn", &cre.NoReturn),
 		"suffix": func(node *tupleNode) error {
@@ -48,10 +59,5 @@ func parseCreate(node *tupleNode) (*ast.Create, error) {
 
 			return nil
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	return cre, nil
 }
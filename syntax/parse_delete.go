@@ -19,6 +19,7 @@ import "gopkg.in/spacemonkeygo/dbx.v1/ast"
 func parseDelete(node *tupleNode) (*ast.Delete, error) {
 	del := new(ast.Delete)
 	del.Pos = node.getPos()
+	del.Doc = node.docText()
 
 	model_ref_token, err := node.consumeToken(Ident)
 	if err != nil {
@@ -31,7 +32,18 @@ func parseDelete(node *tupleNode) (*ast.Delete, error) {
 		return nil, err
 	}
 
-	err = list_token.consumeAnyTuples(tupleCases{
+	err = list_token.consumeAnyTuples(deleteCases(del))
+	if err != nil {
+		return nil, err
+	}
+
+	return del, nil
+}
+
+// deleteCases returns the tuples of a delete, parsed into del.
+func deleteCases(del *ast.Delete) tupleCases {
+	return tupleCases{
+		"nolint": noLintCase("delete", &del.NoLint),
 		"where": func(node *tupleNode) error {
 			where, err := parseWhere(node)
 			if err != nil {
@@ -64,10 +76,5 @@ func parseDelete(node *tupleNode) (*ast.Delete, error) {
 
 			return nil
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	return del, nil
 }
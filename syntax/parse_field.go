@@ -15,8 +15,6 @@
 package syntax
 
 import (
-	"strconv"
-
 	"gopkg.in/spacemonkeygo/dbx.v1/ast"
 	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
 )
@@ -24,6 +22,7 @@ import (
 func parseField(node *tupleNode) (*ast.Field, error) {
 	field := new(ast.Field)
 	field.Pos = node.getPos()
+	field.Doc = node.docText()
 
 	field_name_token, err := node.consumeToken(Ident)
 	if err != nil {
@@ -46,55 +45,7 @@ func parseField(node *tupleNode) (*ast.Field, error) {
 
 	attributes_list := node.consumeIfList()
 	if attributes_list != nil {
-		err := attributes_list.consumeAnyTuples(tupleCases{
-			"column": func(node *tupleNode) error {
-				if field.Column != nil {
-					return previouslyDefined(node.getPos(), "field", "column",
-						field.Column.Pos)
-				}
-
-				name_token, err := node.consumeToken(Ident)
-				if err != nil {
-					return err
-				}
-				field.Column = stringFromToken(name_token)
-
-				return nil
-			},
-			"nullable": tupleFlagField("field", "nullable",
-				&field.Nullable),
-			"autoinsert": tupleFlagField("field", "autoinsert",
-				&field.AutoInsert),
-			"autoupdate": tupleFlagField("field", "autoupdate",
-				&field.AutoUpdate),
-			"updatable": tupleFlagField("field", "updatable",
-				&field.Updatable),
-			"length": func(node *tupleNode) error {
-				if field.Length != nil {
-					return previouslyDefined(node.getPos(), "field", "length",
-						field.Length.Pos)
-				}
-
-				length_token, err := node.consumeToken(Int)
-				if err != nil {
-					return err
-				}
-				value, err := strconv.Atoi(length_token.text)
-				if err != nil {
-					return errutil.New(length_token.getPos(),
-						"unable to parse integer %q: %v",
-						length_token.text, err)
-				}
-				field.Length = intFromValue(length_token, value)
-
-				return nil
-			},
-
-			// TODO(jeff): do something with these values instead of allowing
-			// anything.
-			"default":    debugConsume,
-			"sqldefault": debugConsume,
-		})
+		err := attributes_list.consumeAnyTuples(fieldCases(field))
 		if err != nil {
 			return nil, err
 		}
@@ -131,3 +82,52 @@ func parseFieldType(node *tupleNode) (*ast.FieldType, *ast.FieldRef, error) {
 		Field: stringFromToken(second),
 	}, nil
 }
+
+// fieldCases returns the attributes of a field, parsed into field.
+func fieldCases(field *ast.Field) tupleCases {
+	return tupleCases{
+		"column": func(node *tupleNode) error {
+			if field.Column != nil {
+				return previouslyDefined(node.getPos(), "field", "column",
+					field.Column.Pos)
+			}
+
+			name_token, err := node.consumeToken(Ident)
+			if err != nil {
+				return err
+			}
+			field.Column = stringFromToken(name_token)
+
+			return nil
+		},
+		"nullable": tupleFlagField("field", "nullable",
+			&field.Nullable),
+		"autoinsert": tupleFlagField("field", "autoinsert",
+			&field.AutoInsert),
+		"autoupdate": tupleFlagField("field", "autoupdate",
+			&field.AutoUpdate),
+		"updatable": tupleFlagField("field", "updatable",
+			&field.Updatable),
+		"length": tupleIntField("field", "length", &field.Length),
+		"proto":  tupleIntField("field", "proto", &field.ProtoNumber),
+		"check": func(node *tupleNode) error {
+			if field.Check != nil {
+				return previouslyDefined(node.getPos(), "field", "check",
+					field.Check.Pos)
+			}
+
+			check, err := parseCheck(node, true)
+			if err != nil {
+				return err
+			}
+			field.Check = check
+
+			return nil
+		},
+
+		// TODO(jeff): do something with these values instead of allowing
+		// anything.
+		"default":    debugConsume,
+		"sqldefault": debugConsume,
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
+
+func parseForeignKey(node *tupleNode) (*ast.ForeignKey, error) {
+	foreign_key := new(ast.ForeignKey)
+	foreign_key.Pos = node.getPos()
+
+	fields, err := parseFieldRefList(node)
+	if err != nil {
+		return nil, err
+	}
+	foreign_key.Fields = fields
+
+	err = node.consumeTokenNamed(tokenCases{
+		{Ident, "references"}: func(*tokenNode) error { return nil },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	model_token, err := node.consumeToken(Ident)
+	if err != nil {
+		return nil, err
+	}
+	foreign_key.Model = stringFromToken(model_token)
+
+	references, err := parseFieldRefList(node)
+	if err != nil {
+		return nil, err
+	}
+	foreign_key.References = references
+
+	err = node.consumeTokensNamed(foreignKeyOptionCases(node, foreign_key))
+	if err != nil {
+		return nil, err
+	}
+
+	return foreign_key, nil
+}
+
+// foreignKeyOptionCases returns the options that follow the references of a
+// foreign key, parsed from node into foreign_key.
+func foreignKeyOptionCases(node *tupleNode,
+	foreign_key *ast.ForeignKey) tokenCases {
+
+	return tokenCases{
+		{Ident, "ondelete"}: func(token *tokenNode) error {
+			if foreign_key.OnDelete != nil {
+				return previouslyDefined(token.getPos(), "foreignkey",
+					"ondelete", foreign_key.OnDelete.Pos)
+			}
+			return node.consumeTokenNamed(
+				relationKindCases(&foreign_key.OnDelete))
+		},
+		{Ident, "onupdate"}: func(token *tokenNode) error {
+			if foreign_key.OnUpdate != nil {
+				return previouslyDefined(token.getPos(), "foreignkey",
+					"onupdate", foreign_key.OnUpdate.Pos)
+			}
+			return node.consumeTokenNamed(
+				relationKindCases(&foreign_key.OnUpdate))
+		},
+	}
+}
+
+// parseFieldRefList parses a list of relative field references like
+// "( a b )" or "( a, b )".
+func parseFieldRefList(node *tupleNode) (*ast.RelativeFieldRefs, error) {
+	list, err := node.consumeList()
+	if err != nil {
+		return nil, err
+	}
+
+	refs := new(ast.RelativeFieldRefs)
+	refs.Pos = list.getPos()
+	for {
+		tuple, err := list.consumeTupleOrEmpty()
+		if err != nil {
+			return nil, err
+		}
+		if tuple == nil {
+			break
+		}
+		tuple_refs, err := parseRelativeFieldRefs(tuple)
+		if err != nil {
+			return nil, err
+		}
+		refs.Refs = append(refs.Refs, tuple_refs.Refs...)
+	}
+
+	if len(refs.Refs) == 0 {
+		return nil, errutil.New(refs.Pos,
+			"must specify some field references")
+	}
+	return refs, nil
+}
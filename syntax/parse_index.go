@@ -14,7 +14,12 @@
 
 package syntax
 
-import "gopkg.in/spacemonkeygo/dbx.v1/ast"
+import (
+	"text/scanner"
+
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
 
 func parseIndex(node *tupleNode) (*ast.Index, error) {
 	index := new(ast.Index)
@@ -25,7 +30,18 @@ func parseIndex(node *tupleNode) (*ast.Index, error) {
 		return nil, err
 	}
 
-	err = list_token.consumeAnyTuples(tupleCases{
+	err = list_token.consumeAnyTuples(indexCases(index))
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// indexCases returns the tuples of an index, parsed into index.
+func indexCases(index *ast.Index) tupleCases {
+	var fields_pos *scanner.Position
+	return tupleCases{
 		"name": func(node *tupleNode) error {
 			if index.Name != nil {
 				return previouslyDefined(node.getPos(), "index", "name",
@@ -41,24 +57,90 @@ func parseIndex(node *tupleNode) (*ast.Index, error) {
 			return nil
 		},
 		"fields": func(node *tupleNode) error {
-			if index.Fields != nil {
+			if fields_pos != nil {
 				return previouslyDefined(node.getPos(), "index", "fields",
-					index.Fields.Pos)
+					*fields_pos)
+			}
+			pos := node.getPos()
+			fields_pos = &pos
+
+			columns, err := parseIndexFields(node)
+			if err != nil {
+				return err
 			}
+			index.Columns = append(index.Columns, columns...)
+
+			return nil
+		},
+		"expr": func(node *tupleNode) error {
+			column := new(ast.IndexColumn)
+			column.Pos = node.getPos()
 
-			fields, err := parseRelativeFieldRefs(node)
+			expr, err := parseExpr(node)
 			if err != nil {
 				return err
 			}
-			index.Fields = fields
+			column.Expr = expr
+			column.Descending = parseIndexDirection(node)
+			index.Columns = append(index.Columns, column)
+
+			return nil
+		},
+		"where": func(node *tupleNode) error {
+			where, err := parseWhere(node)
+			if err != nil {
+				return err
+			}
+			index.Where = append(index.Where, where)
+			return nil
+		},
+		"using": func(node *tupleNode) error {
+			if index.Using != nil {
+				return previouslyDefined(node.getPos(), "index", "using",
+					index.Using.Pos)
+			}
+
+			using_token, err := node.consumeToken(Ident)
+			if err != nil {
+				return err
+			}
+			index.Using = stringFromToken(using_token)
 
 			return nil
 		},
 		"unique": tupleFlagField("index", "unique", &index.Unique),
-	})
-	if err != nil {
-		return nil, err
 	}
+}
 
-	return index, nil
+// parseIndexFields parses a list of fields, each optionally followed by a
+// direction, like "fields name created_at desc".
+func parseIndexFields(node *tupleNode) (
+	columns []*ast.IndexColumn, err error) {
+
+	for len(node.value) > 0 {
+		ref_token, err := node.consumeToken(Ident)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, &ast.IndexColumn{
+			Pos:        ref_token.getPos(),
+			Field:      relativeFieldRefFromToken(ref_token),
+			Descending: parseIndexDirection(node),
+		})
+	}
+	if len(columns) == 0 {
+		return nil, errutil.New(node.getPos(),
+			"must specify some field references")
+	}
+	return columns, nil
+}
+
+func parseIndexDirection(node *tupleNode) *ast.Bool {
+	if token := node.consumeIfKeyword("desc"); token != nil {
+		return boolFromValue(token, true)
+	}
+	if token := node.consumeIfKeyword("asc"); token != nil {
+		return boolFromValue(token, false)
+	}
+	return nil
 }
@@ -0,0 +1,60 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+)
+
+func parseLock(node *tupleNode) (*ast.Lock, error) {
+	lock := new(ast.Lock)
+	lock.Pos = node.getPos()
+
+	err := node.consumeTokenNamed(lockModeCases(lock))
+	if err != nil {
+		return nil, err
+	}
+
+	err = node.consumeTokensNamed(lockOptionCases(lock))
+	if err != nil {
+		return nil, err
+	}
+
+	return lock, nil
+}
+
+// lockModeCases returns the modes of a lock, parsed into lock.
+func lockModeCases(lock *ast.Lock) tokenCases {
+	return tokenCases{
+		{Ident, "update"}: func(token *tokenNode) error {
+			lock.Mode = lockModeFromValue(token, consts.LockUpdate)
+			return nil
+		},
+		{Ident, "share"}: func(token *tokenNode) error {
+			lock.Mode = lockModeFromValue(token, consts.LockShare)
+			return nil
+		},
+	}
+}
+
+// lockOptionCases returns the options that follow the mode of a lock, parsed
+// into lock.
+func lockOptionCases(lock *ast.Lock) tokenCases {
+	return tokenCases{
+		{Ident, "skiplocked"}: tokenFlagField("lock", "skiplocked",
+			&lock.SkipLocked),
+	}
+}
@@ -21,6 +21,7 @@ import (
 func parseModel(node *tupleNode) (*ast.Model, error) {
 	model := new(ast.Model)
 	model.Pos = node.getPos()
+	model.Doc = node.docText()
 
 	name_token, err := node.consumeToken(Ident)
 	if err != nil {
@@ -33,7 +34,18 @@ func parseModel(node *tupleNode) (*ast.Model, error) {
 		return nil, err
 	}
 
-	err = list_token.consumeAnyTuples(tupleCases{
+	err = list_token.consumeAnyTuples(modelCases(model))
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// modelCases returns the tuples of a model, parsed into model.
+func modelCases(model *ast.Model) tupleCases {
+	return tupleCases{
+		"nolint": noLintCase("model", &model.NoLint),
 		"table": func(node *tupleNode) error {
 			if model.Table != nil {
 				return previouslyDefined(node.getPos(), "model", "table",
@@ -77,6 +89,22 @@ func parseModel(node *tupleNode) (*ast.Model, error) {
 			model.Unique = append(model.Unique, unique)
 			return nil
 		},
+		"foreignkey": func(node *tupleNode) error {
+			foreign_key, err := parseForeignKey(node)
+			if err != nil {
+				return err
+			}
+			model.ForeignKeys = append(model.ForeignKeys, foreign_key)
+			return nil
+		},
+		"check": func(node *tupleNode) error {
+			check, err := parseCheck(node, false)
+			if err != nil {
+				return err
+			}
+			model.Checks = append(model.Checks, check)
+			return nil
+		},
 		"index": func(node *tupleNode) error {
 			index, err := parseIndex(node)
 			if err != nil {
@@ -85,10 +113,5 @@ func parseModel(node *tupleNode) (*ast.Model, error) {
 			model.Indexes = append(model.Indexes, index)
 			return nil
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	return model, nil
 }
@@ -0,0 +1,51 @@
+// Copyright (C) 2017 Space Monkey, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import "gopkg.in/spacemonkeygo/dbx.v1/ast"
+
+func parseNoLint(node *tupleNode) (*ast.NoLint, error) {
+	nolint := new(ast.NoLint)
+	nolint.Pos = node.getPos()
+
+	tokens, err := node.consumeTokens(Ident)
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		nolint.Rules = append(nolint.Rules, stringFromToken(token))
+	}
+
+	return nolint, nil
+}
+
+// noLintCase returns the tuple case parsing a nolint attribute of kind into
+// *nolint.
+func noLintCase(kind string, nolint **ast.NoLint) func(*tupleNode) error {
+	return func(node *tupleNode) error {
+		if *nolint != nil {
+			return previouslyDefined(node.getPos(), kind, "nolint",
+				(*nolint).Pos)
+		}
+
+		parsed, err := parseNoLint(node)
+		if err != nil {
+			return err
+		}
+		*nolint = parsed
+
+		return nil
+	}
+}
@@ -14,28 +14,75 @@
 
 package syntax
 
-import "gopkg.in/spacemonkeygo/dbx.v1/ast"
+import (
+	"gopkg.in/spacemonkeygo/dbx.v1/ast"
+	"gopkg.in/spacemonkeygo/dbx.v1/consts"
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
+)
 
 func parseOrderBy(node *tupleNode) (*ast.OrderBy, error) {
 	order_by := new(ast.OrderBy)
 	order_by.Pos = node.getPos()
 
-	err := node.consumeTokenNamed(tokenCases{
-		{Ident, "asc"}: func(token *tokenNode) error { return nil },
+	// the direction applies to every field that follows it until the next
+	// direction, so "orderby desc a.x a.y asc a.z" sorts a.x and a.y
+	// descending and a.z ascending.
+	var descending *ast.Bool
+	direction := tokenCases{
+		{Ident, "asc"}: func(token *tokenNode) error {
+			descending = boolFromValue(token, false)
+			return nil
+		},
 		{Ident, "desc"}: func(token *tokenNode) error {
-			order_by.Descending = boolFromValue(token, true)
+			descending = boolFromValue(token, true)
 			return nil
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	field_refs, err := parseFieldRefs(node, true)
-	if err != nil {
+	if err := node.consumeTokenNamed(direction); err != nil {
 		return nil, err
 	}
-	order_by.Fields = field_refs
 
-	return order_by, nil
+	for {
+		field_ref, err := parseFieldRef(node, true)
+		if err != nil {
+			return nil, err
+		}
+		entry := &ast.OrderByEntry{
+			Pos:        field_ref.Pos,
+			Field:      field_ref,
+			Descending: descending,
+		}
+		order_by.Entries = append(order_by.Entries, entry)
+
+		if nulls := node.consumeIfKeyword("nulls"); nulls != nil {
+			err := node.consumeTokenNamed(tokenCases{
+				{Ident, "first"}: func(token *tokenNode) error {
+					entry.Nulls = nullsOrderFromValue(nulls, consts.NullsFirst)
+					return nil
+				},
+				{Ident, "last"}: func(token *tokenNode) error {
+					entry.Nulls = nullsOrderFromValue(nulls, consts.NullsLast)
+					return nil
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(node.value) == 0 {
+			return order_by, nil
+		}
+
+		for _, text := range []string{"asc", "desc"} {
+			if token := node.consumeIfKeyword(text); token != nil {
+				descending = boolFromValue(token, text == "desc")
+				if len(node.value) == 0 {
+					return nil, errutil.New(token.getPos(),
+						"expected a field after %q", text)
+				}
+				break
+			}
+		}
+	}
 }
@@ -19,6 +19,7 @@ import "gopkg.in/spacemonkeygo/dbx.v1/ast"
 func parseRead(node *tupleNode) (*ast.Read, error) {
 	read := new(ast.Read)
 	read.Pos = node.getPos()
+	read.Doc = node.docText()
 
 	view, err := parseView(node)
 	if err != nil {
@@ -31,7 +32,18 @@ func parseRead(node *tupleNode) (*ast.Read, error) {
 		return nil, err
 	}
 
-	err = list_token.consumeAnyTuples(tupleCases{
+	err = list_token.consumeAnyTuples(readCases(read))
+	if err != nil {
+		return nil, err
+	}
+
+	return read, nil
+}
+
+// readCases returns the tuples of a read, parsed into read.
+func readCases(read *ast.Read) tupleCases {
+	return tupleCases{
+		"nolint": noLintCase("read", &read.NoLint),
 		"select": func(node *tupleNode) error {
 			if read.Select != nil {
 				return previouslyDefined(node.getPos(), "read", "select",
@@ -92,6 +104,20 @@ func parseRead(node *tupleNode) (*ast.Read, error) {
 
 			return nil
 		},
+		"lock": func(node *tupleNode) error {
+			if read.Lock != nil {
+				return previouslyDefined(node.getPos(), "read", "lock",
+					read.Lock.Pos)
+			}
+
+			lock, err := parseLock(node)
+			if err != nil {
+				return err
+			}
+			read.Lock = lock
+
+			return nil
+		},
 		"suffix": func(node *tupleNode) error {
 			if read.Suffix != nil {
 				return previouslyDefined(node.getPos(), "read", "suffix",
@@ -106,10 +132,5 @@ func parseRead(node *tupleNode) (*ast.Read, error) {
 
 			return nil
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	return read, nil
 }
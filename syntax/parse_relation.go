@@ -19,47 +19,32 @@ import (
 	"gopkg.in/spacemonkeygo/dbx.v1/consts"
 )
 
-func parseRelation(node *tupleNode, field *ast.Field) error {
-	err := node.consumeTokenNamed(tokenCases{
+func relationKindCases(kind **ast.RelationKind) tokenCases {
+	return tokenCases{
 		{Ident, "setnull"}: func(token *tokenNode) error {
-			field.RelationKind = relationKindFromValue(token, consts.SetNull)
+			*kind = relationKindFromValue(token, consts.SetNull)
 			return nil
 		},
 		{Ident, "cascade"}: func(token *tokenNode) error {
-			field.RelationKind = relationKindFromValue(token, consts.Cascade)
+			*kind = relationKindFromValue(token, consts.Cascade)
 			return nil
 		},
 		{Ident, "restrict"}: func(token *tokenNode) error {
-			field.RelationKind = relationKindFromValue(token, consts.Restrict)
+			*kind = relationKindFromValue(token, consts.Restrict)
 			return nil
 		},
-	})
+	}
+}
+
+func parseRelation(node *tupleNode, field *ast.Field) error {
+	err := node.consumeTokenNamed(relationKindCases(&field.RelationKind))
 	if err != nil {
 		return err
 	}
 
 	attributes_list := node.consumeIfList()
 	if attributes_list != nil {
-		err := attributes_list.consumeAnyTuples(tupleCases{
-			"column": func(node *tupleNode) error {
-				if field.Column != nil {
-					return previouslyDefined(node.getPos(), "relation", "column",
-						field.Column.Pos)
-				}
-
-				name_token, err := node.consumeToken(Ident)
-				if err != nil {
-					return err
-				}
-				field.Column = stringFromToken(name_token)
-
-				return nil
-			},
-			"nullable": tupleFlagField("relation", "nullable",
-				&field.Nullable),
-			"updatable": tupleFlagField("relation", "updatable",
-				&field.Updatable),
-		})
+		err := attributes_list.consumeAnyTuples(relationCases(field))
 		if err != nil {
 			return err
 		}
@@ -67,3 +52,36 @@ func parseRelation(node *tupleNode, field *ast.Field) error {
 
 	return nil
 }
+
+// relationCases returns the attributes of a relation, parsed into field.
+func relationCases(field *ast.Field) tupleCases {
+	return tupleCases{
+		"column": func(node *tupleNode) error {
+			if field.Column != nil {
+				return previouslyDefined(node.getPos(), "relation", "column",
+					field.Column.Pos)
+			}
+
+			name_token, err := node.consumeToken(Ident)
+			if err != nil {
+				return err
+			}
+			field.Column = stringFromToken(name_token)
+
+			return nil
+		},
+		"nullable": tupleFlagField("relation", "nullable",
+			&field.Nullable),
+		"updatable": tupleFlagField("relation", "updatable",
+			&field.Updatable),
+		"proto": tupleIntField("relation", "proto", &field.ProtoNumber),
+		"onupdate": func(node *tupleNode) error {
+			if field.OnUpdate != nil {
+				return previouslyDefined(node.getPos(), "relation",
+					"onupdate", field.OnUpdate.Pos)
+			}
+			return node.consumeTokenNamed(
+				relationKindCases(&field.OnUpdate))
+		},
+	}
+}
@@ -17,6 +17,7 @@ package syntax
 import (
 	"testing"
 
+	"gopkg.in/spacemonkeygo/dbx.v1/errutil"
 	"gopkg.in/spacemonkeygo/dbx.v1/testutil"
 )
 
@@ -27,3 +28,122 @@ func TestEmptyParse(t *testing.T) {
 	_, err := Parse("", nil)
 	tw.AssertNoError(err)
 }
+
+func TestParseMultipleErrors(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	_, err := Parse("", []byte(`
+model a (
+	field b bogus
+)
+
+model c (
+	field d bogus
+)
+`))
+	errs := errutil.Errors(err)
+	if len(errs) != 2 {
+		tw.Fatalf("expected 2 errors, got %d: %v", len(errs), err)
+	}
+	first, second := errutil.GetErrorPosition(errs[0]),
+		errutil.GetErrorPosition(errs[1])
+	if first == nil || second == nil || first.Line != 3 || second.Line != 7 {
+		tw.Fatalf("errors out of order: %v", err)
+	}
+}
+
+func TestParseDocComments(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	root, err := Parse("", []byte(`
+// A user
+// of the site.
+model user (
+	key pk
+
+	// The surrogate key.
+	field pk serial64 // not a doc comment
+
+	// Not a doc comment either.
+
+	field name text ( nullable )
+)
+
+// Creates a user.
+create user ( )
+`))
+	tw.AssertNoError(err)
+
+	model := root.Models[0]
+	if model.Doc != "A user\nof the site." {
+		tw.Fatalf("unexpected model doc: %q", model.Doc)
+	}
+	if model.Fields[0].Doc != "The surrogate key." {
+		tw.Fatalf("unexpected field doc: %q", model.Fields[0].Doc)
+	}
+	if model.Fields[1].Doc != "" {
+		tw.Fatalf("unexpected field doc: %q", model.Fields[1].Doc)
+	}
+	if root.Creates[0].Doc != "Creates a user." {
+		tw.Fatalf("unexpected create doc: %q", root.Creates[0].Doc)
+	}
+}
+
+func TestFormatKeepsDocComments(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	formatted, err := Format("", []byte(`
+// A user.
+model user (
+	key pk
+	// The surrogate key.
+	field pk serial64 // dropped
+	field name text
+)
+`))
+	tw.AssertNoError(err)
+
+	expected := `// A user.
+model user (
+	key   pk
+	// The surrogate key.
+	field pk   serial64
+	field name text
+)
+
+`
+	if string(formatted) != expected {
+		tw.Fatalf("unexpected format:\n%s", formatted)
+	}
+}
+
+func TestFormatKeepsLiterals(t *testing.T) {
+	tw := testutil.Wrap(t)
+	tw.Parallel()
+
+	formatted, err := Format("", []byte(`
+model user (
+	key pk
+	field pk serial64 ( proto 1 )
+	field name text ( length 5, proto 2 )
+)
+read all ( select user where user.name = "x" where user.pk > 1.5 )
+`))
+	tw.AssertNoError(err)
+
+	expected := `model user (
+	key   pk
+	field pk   serial64 ( proto 1 )
+	field name text     ( length 5, proto 2 )
+)
+
+read all ( select user where user.name = "x" where user.pk > 1.5 )
+
+`
+	if string(formatted) != expected {
+		tw.Fatalf("unexpected format:\n%s", formatted)
+	}
+}
@@ -19,6 +19,7 @@ import "gopkg.in/spacemonkeygo/dbx.v1/ast"
 func parseUpdate(node *tupleNode) (*ast.Update, error) {
 	upd := new(ast.Update)
 	upd.Pos = node.getPos()
+	upd.Doc = node.docText()
 
 	model_ref_token, err := node.consumeToken(Ident)
 	if err != nil {
@@ -31,7 +32,18 @@ func parseUpdate(node *tupleNode) (*ast.Update, error) {
 		return nil, err
 	}
 
-	err = list_token.consumeAnyTuples(tupleCases{
+	err = list_token.consumeAnyTuples(updateCases(upd))
+	if err != nil {
+		return nil, err
+	}
+
+	return upd, nil
+}
+
+// updateCases returns the tuples of an update, parsed into upd.
+func updateCases(upd *ast.Update) tupleCases {
+	return tupleCases{
+		"nolint": noLintCase("update", &upd.NoLint),
 		"where": func(node *tupleNode) error {
 			where, err := parseWhere(node)
 			if err != nil {
@@ -65,10 +77,5 @@ func parseUpdate(node *tupleNode) (*ast.Update, error) {
 
 			return nil
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	return upd, nil
 }
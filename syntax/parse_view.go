@@ -20,7 +20,17 @@ func parseView(node *tupleNode) (*ast.View, error) {
 	view := new(ast.View)
 	view.Pos = node.getPos()
 
-	err := node.consumeTokensNamedUntilList(tokenCases{
+	err := node.consumeTokensNamedUntilList(viewCases(view))
+	if err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
+
+// viewCases returns the kinds of a read, parsed into view.
+func viewCases(view *ast.View) tokenCases {
+	return tokenCases{
 		{Ident, "all"}:   tokenFlagField("view", "all", &view.All),
 		{Ident, "paged"}: tokenFlagField("view", "paged", &view.Paged),
 		{Ident, "count"}: tokenFlagField("view", "count", &view.Count),
@@ -30,10 +40,9 @@ func parseView(node *tupleNode) (*ast.View, error) {
 		{Ident, "scalar"}: tokenFlagField("view", "scalar", &view.Scalar),
 		{Ident, "one"}:    tokenFlagField("view", "one", &view.One),
 		{Ident, "first"}:  tokenFlagField("view", "first", &view.First),
-	})
-	if err != nil {
-		return nil, err
+		{Ident, "iterate"}: tokenFlagField("view", "iterate",
+			&view.Iterate),
+		{Ident, "dynamic"}: tokenFlagField("view", "dynamic",
+			&view.Dynamic),
 	}
-
-	return view, nil
 }
@@ -28,6 +28,14 @@ func parseWhere(node *tupleNode) (where *ast.Where, err error) {
 		return nil, err
 	}
 
+	if err := parseWhereOp(node, where); err != nil {
+		return nil, err
+	}
+	return where, nil
+}
+
+// parseWhereOp parses the operator and right hand side of a where clause.
+func parseWhereOp(node *tupleNode, where *ast.Where) (err error) {
 	err = node.consumeTokenNamed(tokenCases{
 		Exclamation.tokenCase(): func(token *tokenNode) error {
 			_, err := node.consumeToken(Equal)
@@ -41,6 +49,44 @@ func parseWhere(node *tupleNode) (where *ast.Where, err error) {
 			where.Op = operatorFromValue(token, consts.Like)
 			return nil
 		},
+		{Ident, "not"}: func(token *tokenNode) error {
+			err := node.consumeTokenNamed(tokenCases{
+				{Ident, "like"}: func(*tokenNode) error { return nil },
+			})
+			if err != nil {
+				return err
+			}
+			where.Op = operatorFromValue(token, consts.NotLike)
+			return nil
+		},
+		{Ident, "ilike"}: func(token *tokenNode) error {
+			where.Op = operatorFromValue(token, consts.ILike)
+			return nil
+		},
+		{Ident, "between"}: func(token *tokenNode) error {
+			where.Op = operatorFromValue(token, consts.Between)
+			return nil
+		},
+		{Ident, "is"}: func(token *tokenNode) error {
+			err := node.consumeTokenNamed(tokenCases{
+				{Ident, "distinct"}: func(*tokenNode) error { return nil },
+			})
+			if err != nil {
+				return err
+			}
+			err = node.consumeTokenNamed(tokenCases{
+				{Ident, "from"}: func(*tokenNode) error { return nil },
+			})
+			if err != nil {
+				return err
+			}
+			where.Op = operatorFromValue(token, consts.IsDistinctFrom)
+			return nil
+		},
+		{Ident, "regexp"}: func(token *tokenNode) error {
+			where.Op = operatorFromValue(token, consts.Regexp)
+			return nil
+		},
 		Equal.tokenCase(): func(token *tokenNode) error {
 			where.Op = operatorFromValue(token, consts.EQ)
 			return nil
@@ -63,13 +109,27 @@ func parseWhere(node *tupleNode) (where *ast.Where, err error) {
 		},
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	where.Right, err = parseExpr(node)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return where, nil
+	if where.Op.Value == consts.Between {
+		err = node.consumeTokenNamed(tokenCases{
+			{Ident, "and"}: func(*tokenNode) error { return nil },
+		})
+		if err != nil {
+			return err
+		}
+
+		where.Upper, err = parseExpr(node)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
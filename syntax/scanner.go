@@ -17,6 +17,7 @@ package syntax
 import (
 	"bytes"
 	"path/filepath"
+	"strings"
 	"text/scanner"
 )
 
@@ -89,18 +90,26 @@ type token struct {
 type Scanner struct {
 	tokens []token
 	pos    int
+
+	// comments holds the // comments that are alone on their line and
+	// starts the column of the first token on each line, to find the doc
+	// comment directly above a token.
+	comments map[int]string
+	starts   map[int]int
 }
 
 func NewScanner(filename string, data []byte) (*Scanner, error) {
 	var s scanner.Scanner
 	s.Init(bytes.NewReader(data))
 	s.Mode = scanner.ScanInts | scanner.ScanIdents | scanner.ScanComments |
-		scanner.SkipComments | scanner.ScanStrings
+		scanner.ScanStrings
 	s.Whitespace = 0
 
 	base_filename := filepath.Base(filename)
 
 	var tokens []token
+	comments := map[int]string{}
+	starts := map[int]int{}
 
 	var tok rune
 	for tok != scanner.EOF {
@@ -112,6 +121,14 @@ func NewScanner(filename string, data []byte) (*Scanner, error) {
 			continue
 		}
 
+		if tok == scanner.Comment {
+			text := s.TokenText()
+			if strings.HasPrefix(text, "//") && starts[pos.Line] == 0 {
+				comments[pos.Line] = strings.TrimRight(text, " \t\r")
+			}
+			continue
+		}
+
 		// insert a comma at newlines and eof unless we already have a comma
 		// or we have a list opening
 		if tok == '\n' || tok == scanner.EOF {
@@ -131,6 +148,9 @@ func NewScanner(filename string, data []byte) (*Scanner, error) {
 			}
 		}
 
+		if starts[pos.Line] == 0 {
+			starts[pos.Line] = pos.Column
+		}
 		tokens = append(tokens, token{
 			tok:  convertToken(tok),
 			pos:  pos,
@@ -142,7 +162,9 @@ func NewScanner(filename string, data []byte) (*Scanner, error) {
 		return nil, Error.New("%d errors encountered", s.ErrorCount)
 	}
 	return &Scanner{
-		tokens: tokens,
+		tokens:   tokens,
+		comments: comments,
+		starts:   starts,
 	}, nil
 }
 
@@ -150,6 +172,18 @@ func (s *Scanner) Pos() scanner.Position {
 	return s.tokens[s.pos].pos
 }
 
+// DocComment returns the lines of the // comments directly above the token at
+// pos, if it is the first token on its line.
+func (s *Scanner) DocComment(pos scanner.Position) (lines []string) {
+	if s.starts[pos.Line] != pos.Column {
+		return nil
+	}
+	for line := pos.Line - 1; s.comments[line] != ""; line-- {
+		lines = append([]string{s.comments[line]}, lines...)
+	}
+	return lines
+}
+
 func (s *Scanner) Scan() (token Token, pos scanner.Position, text string) {
 	return s.scan()
 }
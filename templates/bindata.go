@@ -11,8 +11,10 @@
 // golang.footer.tmpl
 // golang.get-all.tmpl
 // golang.get-count.tmpl
+// golang.get-dynamic.tmpl
 // golang.get-first.tmpl
 // golang.get-has.tmpl
+// golang.get-iterate.tmpl
 // golang.get-last.tmpl
 // golang.get-limitoffset.tmpl
 // golang.get-one-all.tmpl
@@ -22,7 +24,9 @@
 // golang.get-scalar.tmpl
 // golang.header.tmpl
 // golang.misc.tmpl
+// golang.proto-convert.tmpl
 // golang.update.tmpl
+// proto.tmpl
 // DO NOT EDIT!
 
 package templates
@@ -90,7 +94,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _golangCreateRawTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x54\xcd\x8a\xdb\x30\x10\x3e\xdb\x4f\x31\xcd\xc9\x86\xac\x1e\xa0\xb0\x87\x52\xb6\x10\x28\x85\x26\xbd\x0b\x25\x1a\xa7\x6a\x64\xc9\x3b\x52\xfe\x08\x79\xf7\x8e\x2c\x27\xeb\x25\x24\x14\xba\x87\xc0\x68\x46\xfa\xfe\x2c\xe5\x74\x7a\x02\x8d\x8d\x71\x08\x13\xa7\x5a\x9c\xc0\xd3\xf9\x5c\xce\xd5\xfe\x2b\xa1\x8a\x78\x3a\x81\x69\xc0\xf9\x08\x62\x8e\x71\x4b\x0e\xce\xe7\x1f\x3e\x97\x3c\x43\xa7\xb9\x21\xb9\x12\x8b\x6d\xd3\x98\x03\xaf\xca\x04\x99\x06\x09\xa8\x1c\xe3\x07\xb3\x76\x8a\x4f\x0e\x24\x69\x14\xb1\xed\x2c\xf3\x5c\xc8\x05\x03\x54\x0c\xb7\x8a\x87\x4e\x91\x6a\x41\x7c\xa1\x35\xf7\x6a\xa8\xca\x22\x8b\x79\x13\xc2\xeb\x61\xd3\xb5\x35\x85\xab\x2a\x24\x02\xfe\x79\xaa\xef\x2b\x32\x6e\xe7\x37\xff\x26\x47\xb1\x8e\x8b\x98\x72\x20\xb9\xc1\x5b\x7a\x7d\xcc\x68\xbd\x58\x67\xa2\xc3\x3d\x88\x6f\x06\xad\x0e\x29\x9b\xbe\x8f\xed\x12\x35\xf3\xac\xf0\xb7\xb7\x1a\x29\x80\x98\xb9\xc6\xc3\x70\xac\x1f\x87\x57\x3b\x74\x27\x52\xf6\x1d\x19\x62\x1b\x27\x19\x64\xa7\x08\x64\xdf\x80\xe7\x54\xbc\xda\xe5\xd6\x69\x8b\x72\xce\xb2\x90\x2a\xbf\xfc\x23\xb4\x51\x16\x57\x71\x0a\xe3\xf3\x75\x59\xa4\x99\xf5\xeb\x05\xaf\xaa\x8c\xd1\x87\xd6\xfb\xbb\x0a\xad\xcb\x4b\xdc\xef\xbf\x7d\x59\xc8\x69\x4a\x95\x69\x7b\x0e\x32\x3b\x24\xf1\x72\xc0\xd5\x1d\xac\x04\x55\x30\x4c\x3a\xf3\xe9\x19\x9c\xb1\x70\x2a\x8b\x82\x32\x60\xc2\x68\xd5\x06\x5f\x88\x2a\xde\xc1\x5b\x99\x61\x98\xf1\xd6\x1c\x87\x0d\xf8\x16\x69\x93\x6e\x5a\xd7\x79\x8a\x21\x8b\x32\x6e\x0d\xa3\xbc\xdf\x69\xbd\x11\xfa\x73\x8b\x74\x9c\xfb\xfd\x03\xe3\x62\xb1\x52\x2e\x7d\x73\xa5\x35\xf9\x06\xaa\x86\x2f\x44\x44\x77\x01\xae\xe1\xb1\x25\x5e\x4e\x1f\xfa\xba\x50\x8e\xee\xec\xad\x55\x29\x09\x43\x4e\xfa\xf3\xc7\x44\x7d\x5f\x97\x94\xdd\xe6\x4a\xd5\x13\x8b\xef\x2a\xc4\x99\x0b\x48\x71\xa6\xab\xff\x72\x9b\x66\x6b\x8c\x09\x30\xfd\x4b\x64\xd3\xe2\xd7\xb1\xc3\xf4\xb4\xf8\x5d\xa5\xfb\xd9\x6d\xea\xec\x7f\x78\x53\xe3\x7a\x54\xfe\x0d\x00\x00\xff\xff\xa6\x7b\x5d\x9d\xac\x04\x00\x00")
+var _golangCreateRawTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x4f\x6b\xdc\x3c\x10\xc6\xcf\xd2\xa7\x98\xd7\xf0\x82\x0d\x8e\xa1\x50\x7a\x08\xec\x21\x84\x14\x42\xff\x40\x77\x73\x37\x5a\x6b\xbc\x51\xd7\x1e\x39\xf2\x6c\x76\x83\xd0\x77\x2f\xb2\xbd\xce\x06\xb6\x69\x1a\xe8\x4d\xd6\x48\xcf\x3c\xf3\x9b\xb1\xbc\xbf\x00\x8d\xb5\x21\x84\x84\x54\x8b\x09\x5c\x84\x20\x97\x6a\x7f\xed\x50\x31\x7a\x0f\xa6\x06\xb2\x0c\xc5\x12\x79\xe7\x08\x42\xf8\x6e\xc7\xa5\xf7\x80\xa4\x21\x84\xd2\x7b\x28\x56\xbb\xba\x36\x07\x08\x41\x46\xc9\x18\x88\x42\xf2\x54\xbf\x37\x1b\x52\xbc\x73\x53\x92\x18\x62\x6c\xbb\x46\xf1\x9c\xbc\x80\x10\x52\xef\xa1\xe2\x43\xa7\x9c\x6a\xa1\xb8\x72\x1b\x08\x21\x83\x54\x8a\xd1\xcc\xb3\x11\xef\x61\x3a\x34\x6f\xe5\x30\xbb\x42\xe7\x00\x9d\xb3\x2e\xfb\xbd\x23\x43\x8f\x76\xfb\x36\x3b\xca\x6d\x66\x33\xd2\xfb\xf3\x7a\x6b\xab\x9f\x46\xb5\xc1\x2c\x19\x26\xdc\x43\xf1\xd9\x60\xa3\xfb\xc8\x66\x28\x02\xdb\x35\xea\xae\x51\x15\xde\xdb\x46\xa3\xeb\xa1\xb8\xa5\xda\xc2\x74\x6d\x08\xf7\x0f\xcd\xb4\x9b\x94\xe5\xb0\x53\xf6\xdc\x72\x12\x0f\x49\xf1\xa8\x1c\x94\xc3\x06\x2c\xe2\xe2\xa1\x59\xef\x48\x37\x58\x2e\x91\x34\xba\xd4\xae\x7f\x16\xda\xa8\x06\x2b\xce\xe1\xf4\x7e\x26\x45\x8c\x35\x76\xb3\xe2\x96\xd3\x51\x63\x80\x36\xd4\x37\x1b\xcd\xa4\x28\x4b\x6d\x09\xe1\x72\x01\xf1\x86\x5e\x17\xf7\xd6\x6e\x7f\xec\xd0\x3d\xa5\x15\x1f\x72\x18\x96\xd1\xa1\x97\x42\x7c\x43\xbe\xb7\xfa\x12\x00\x20\xf1\xfe\x2c\xc7\x24\x97\x42\x7c\x31\x34\x9e\x82\xf1\x7e\xfc\x2e\xc7\x51\x8b\xe1\x3b\xb5\x6e\x70\x8c\xc7\xe6\x3a\x43\x5c\x43\xf2\xff\x43\x02\xc5\x10\x8a\x1d\x96\x42\xac\x58\x31\xb6\x48\x7c\x39\x51\xc8\xa5\x08\xd9\x11\x4b\x65\x77\xc4\x60\x88\x3f\x7d\x94\x42\x63\x8d\x0e\xea\x1d\x55\x69\x06\x1e\xc6\xa2\xd2\xe9\x50\x1e\x07\x24\x83\x90\x66\x52\x8a\x73\xa3\x1e\x29\x38\xec\x87\x73\x33\x09\x67\x1e\xd1\x15\x37\x07\xac\xae\x2d\x31\x1e\x78\xe4\x71\x9e\x65\x9c\x16\x61\xea\x41\xe0\xbf\x05\x90\x69\x20\xf2\x72\x63\x86\xa8\xd7\xaa\x2d\xde\x38\x97\x46\x2b\x52\x04\x29\x4e\xcd\x0d\xed\x75\xd8\x17\x4b\xbb\xef\xaf\xea\x1a\x2b\x46\x9d\xfe\xb5\xe4\x14\x23\xd3\x0c\x75\x62\xd3\xe3\xf3\x94\xd6\xf1\xe7\xed\x3a\xeb\xb8\x1f\xff\x23\x43\x9b\xe3\x2c\x1a\x32\x2f\x79\xc4\xa4\x2f\x38\x0c\x6d\x5c\xda\xfd\x1b\x58\xc4\xb9\x2a\x56\x95\xa2\xf8\x87\x2b\xad\x9d\xad\x21\xad\x1b\xc5\x8c\x74\x4c\x92\xc1\xeb\xc4\xc8\x34\xf9\xab\xd8\x60\x01\x1f\xe6\x82\x8f\xf9\xe7\x02\xf2\x33\x0c\xfe\x6d\x8b\xff\x68\xf8\x9d\x7d\x7e\x4d\xb7\xdb\xce\xe5\x8c\xaa\x5f\x55\xcf\xb7\xd4\xa3\xe3\xdb\xf7\xaa\x4e\x07\x22\x9e\x0d\x72\x14\x8c\xaf\xfe\x08\xb6\xb8\x7b\xea\x30\x3e\x95\x13\xa5\x6e\x9b\x49\x71\xf2\x46\x9e\xae\xbd\x07\x24\x0d\x17\x21\xc8\x5f\x03\x00\x69\x6d\xcf\xff\x7c\x06\x00\x00")
 
 func golangCreateRawTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -105,12 +109,12 @@ func golangCreateRawTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.create-raw.tmpl", size: 1196, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.create-raw.tmpl", size: 1660, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x54\x41\x8f\xda\x3c\x10\x3d\x27\xbf\x62\x3e\x4e\x89\xc4\xfa\x07\xac\xb4\x87\x4f\xab\xad\x8a\x54\x21\x15\xb6\x67\xcb\xe0\x49\xea\x92\xd8\x59\xdb\xb0\x20\xc4\x7f\xef\x4c\x9c\x00\x2b\xc4\xaa\x52\x7b\x40\x1a\xcf\x8c\xdf\x7b\xf3\x32\xe6\x78\x7c\x00\x8d\x95\xb1\x08\x13\xab\x5a\x9c\xc0\xc3\xe9\x94\x3f\x7b\x54\x11\x8f\x47\x30\x15\x58\x17\x41\x2c\x30\x6e\xbd\x85\xd3\x69\xee\x52\x48\x35\xb4\x9a\x12\x92\x22\xb1\xdc\x56\x95\xd9\xd3\x29\x67\x3c\x2e\x30\x4a\x7e\x0d\x1e\x4c\x6d\x15\xdd\x1c\x18\xb8\x14\xb1\xed\x1a\xe2\x19\x99\x05\x01\x14\x04\xb7\x8e\xfb\x4e\x79\xd5\x82\xf8\xdf\xd7\x81\x92\x25\x14\x79\x96\xd4\x5c\x94\xd0\x79\xe8\x3a\xa7\xa6\x70\x96\x85\xde\x03\xfd\x9c\x2f\xef\x4b\x32\x76\xe7\x36\x7f\xa6\x47\xf9\xfa\xa2\x26\x1f\x58\x6e\x00\x57\x4e\x1f\x12\x5c\xc6\x69\x96\x3b\x47\xd4\x61\xee\xde\xd9\x9b\x4c\x4a\x4b\xd1\xe3\x13\xb8\xd5\x2f\xa1\x57\xe2\xab\x73\x9b\x20\xa8\x5a\x94\xe2\xc7\xeb\x73\x51\xf6\x53\x8e\xd0\xfd\xc4\xd6\x44\x8b\xef\x20\xbe\x18\x6c\x34\xb3\xe7\xa9\xa7\x5d\xa1\x26\xb1\x6b\xfc\xe9\x1a\x8d\x3e\x80\x98\xd9\xca\xc1\x70\xad\x2f\x87\xb7\x66\xc8\x4e\xa4\xec\x33\x32\xc4\x36\x4e\x12\xc8\x4e\x79\x90\x7d\x02\x9e\x38\x78\x6b\x56\x5b\xab\x1b\x94\x0b\xe2\x47\x5f\xf4\x12\x8d\x6a\x70\x1d\xa7\x70\x7d\x9f\x44\x72\xad\x71\xf5\x92\x4e\x45\xc2\xe8\x9d\xef\x4d\x3a\x0b\x2d\xf3\xf1\x9b\x7d\xdc\x20\xb2\x61\xca\x9f\x06\x06\x1b\xbc\xd9\xa1\x17\x2f\x7b\x5c\xdf\xc1\x62\xa8\x8c\x60\xf8\xce\x7f\x4f\x60\x4d\x03\xc7\x3c\xcb\x7c\x02\x64\x8c\x56\x6d\xf0\xc5\xfb\x82\x3a\xa8\x95\x18\x86\x1a\xb5\x26\x3b\x9a\x80\x17\x4b\x2b\xde\xd7\xae\x73\x3e\x86\x24\xca\xd8\x1a\xae\xfc\xfe\xa0\xf5\x46\xe8\xf7\x2d\xfa\xc3\x82\x3e\xd9\xfd\xc1\xc5\x72\xad\x2c\x2f\x8e\xd2\xda\xbb\x0a\x8a\x8a\xb6\x2a\xa2\x1d\x81\x4b\xf8\x7c\x24\x3a\x4e\x3f\x9d\x6b\xa4\xbc\x5a\xfc\xdb\x51\xa5\xf4\x18\x92\xd3\x8f\xff\xc6\xea\xfb\xba\xa4\xec\x36\x67\xaa\x9e\x58\x7c\x53\x21\xce\x6c\x40\x1f\x67\xba\xf8\xab\x69\xb9\x56\x63\x64\x40\xfe\xaf\x49\x43\x8b\xd7\x43\x87\xfc\x3e\xe9\x71\xf2\x7e\x76\x9b\xdb\xc7\x33\xc6\x57\xe1\xef\x00\x00\x00\xff\xff\xc0\xb8\xac\xa4\xef\x04\x00\x00")
+var _golangCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x95\xd1\x6b\xdb\x3e\x10\xc7\x9f\xa5\xbf\xe2\x7e\x86\x1f\xd8\xe0\x0a\x06\x63\x0f\x85\x3c\x94\xd2\xb1\xb2\x2d\xb0\xa4\x7b\x36\x8a\x75\x4e\xb5\xd8\x92\x2b\x2b\x4d\x8a\xd0\xff\x3e\xce\x76\x9c\x94\x65\x5d\x57\xd8\x9b\xa2\x3b\x7d\xf5\xbd\xcf\x5d\xe4\x10\x2e\x40\x61\xa5\x0d\x42\x62\x64\x83\x09\x5c\xc4\xc8\xaf\x1d\x4a\x8f\x21\x80\xae\xc0\x58\x0f\x62\x81\x7e\xeb\x0c\xc4\x38\xb7\xc3\x32\x04\x40\xa3\x20\xc6\x22\x04\x10\xcb\x6d\x55\xe9\x3d\xc4\xc8\x49\x8f\x02\xa4\xc2\x4f\xc5\x3b\xbd\x36\xd2\x6f\xdd\x78\x03\x85\x3c\x36\x6d\x2d\xfd\x74\xb3\x80\x18\xd3\x10\xa0\xf4\xfb\x56\x3a\xd9\x80\xb8\x72\xeb\x0e\x62\xcc\x20\xe5\x6c\x70\x73\x74\x12\x02\x8c\x59\xd3\x56\x0e\x93\x2d\x74\x0e\xd0\x39\xeb\xb2\xdf\x5b\xd2\xe6\xd1\x6e\x5e\xe7\x47\xba\xf5\xd1\x0d\x0f\xe1\xbc\xe0\xca\xaa\xa7\x41\x8e\xd1\xb6\xae\x40\xcc\x11\x55\x37\xb7\x3b\x62\xc3\x8a\xc2\xd8\x1d\x5c\xce\xc0\xae\x7e\x08\xb5\x12\x9f\xac\xdd\x74\x62\x6e\x77\x69\x26\xbe\xdf\x5d\xa7\x19\x67\x27\xd2\xb4\xd6\x46\x7b\x83\x3b\x10\x1f\x35\xd6\x8a\x58\xf0\x21\xa7\x59\xa1\x6a\x6b\x59\xe2\xbd\xad\x15\xba\x0e\xc4\xad\xa9\x2c\x8c\xc7\xfa\x70\xf7\x50\x8f\xbb\x49\x51\xf4\x3b\x45\xe7\x1b\x9f\x50\x12\x67\x8f\xd2\x41\xd1\x6f\xc0\x8c\x16\x0f\xf5\x6a\x6b\x54\x8d\xc5\x02\x8d\x42\x97\xf6\x16\xb5\xac\xb1\xf4\x39\x9c\x9e\xcf\x38\xa3\x58\x6d\xd7\x4b\xdf\xf8\x74\xd0\xe8\xc9\xf7\x90\x26\xa3\x19\x67\x45\xa1\xac\xc1\x93\x82\xef\xad\xdd\x7c\xdb\xa2\x7b\x4a\x4b\xbf\xcf\xa1\x5f\x92\xc3\xc0\x19\xfb\x8a\xfe\xde\xaa\x4b\x00\x80\x24\x84\xb3\xcd\x48\x72\xce\xd8\x67\x6d\x86\x2c\x18\xce\xd3\xef\x62\x18\x58\x0a\xdf\xc9\x55\x8d\x43\x9c\x26\xc4\x69\xe3\x2b\x48\xfe\x7f\x48\x40\xf4\x21\x1a\x13\xce\xd8\xd2\x4b\x8f\x0d\x1a\x7f\x39\x52\xc8\x39\x8b\xd9\x01\x4b\x69\xb7\xc6\x83\x36\xfe\xc3\x7b\xce\x14\x56\xe8\xa0\xda\x9a\x32\xcd\x20\xc0\x50\x54\x3a\x26\xe5\x34\x65\x19\xc4\x34\xe3\x9c\x9d\xfb\xc3\x10\x05\x87\x5d\x9f\x37\x91\x70\xfa\x11\x9d\xb8\xd9\x63\x79\x6d\x8d\xc7\xbd\x1f\x78\x9c\x67\x19\xc9\x97\xae\x7a\x81\xff\x66\x60\x74\x0d\xc4\xcb\x0d\x53\x4f\x7a\x8d\xdc\xe0\x8d\x73\x29\x59\xe1\xac\x1f\xb4\xa3\xb9\xbe\xbd\x0e\x3b\xb1\xb0\xbb\xee\xaa\xaa\xb0\xf4\xa8\xd2\xbf\x96\x1c\x63\x46\xd7\x7d\x9d\x58\x77\x78\x9c\xd2\x8a\x9e\x80\xb6\xb5\xce\x77\x43\xe1\xda\xac\x0f\xb3\x48\x23\xfc\x8c\x07\xd5\xf1\x8c\x43\xdf\xc6\x85\xdd\xbd\x82\x05\xcd\x95\x58\x96\xd2\xd0\x3b\x21\x95\x72\xb6\x82\xb4\xaa\xa5\xf7\x68\x0e\x97\x64\xf0\x32\x31\xa3\xeb\xfc\x45\x6c\x30\x83\x77\x53\xc1\x87\xfb\xa7\x02\xf2\x33\x0c\xfe\x6d\x8b\xff\x68\xf8\x8d\x7d\x7e\x49\xb7\xdd\x4c\xe5\x0c\xaa\x5f\x64\xe7\x6f\x4d\x87\xce\xdf\xbe\x55\x75\x4c\x20\x3c\x6b\xf4\x24\x48\xdf\x8e\x01\xac\xb8\x7b\x6a\x91\xde\xdb\x91\x52\xbb\xf9\xf5\x31\x3c\xac\x43\x00\x34\x0a\x2e\x62\xe4\x3f\x07\x00\x38\x58\xad\x16\xbf\x06\x00\x00")
 
 func golangCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -125,7 +129,7 @@ func golangCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.create.tmpl", size: 1263, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.create.tmpl", size: 1727, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -150,7 +154,7 @@ func golangDeclTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _golangDeleteAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x92\x41\x8b\xdb\x30\x10\x85\xcf\xf6\xaf\x98\x0d\x39\xd8\xe0\x15\x3d\x94\x1e\x0a\x39\x04\xba\x87\x5e\xf6\xb0\x7b\x2c\xc5\xc8\xd6\xc8\x55\x57\x96\xb2\x23\x39\x4d\x31\xf9\xef\x1d\x29\x6e\xf0\x42\x68\x61\x0f\x81\x68\xe6\xf9\x9b\x37\x8f\x99\xe7\x7b\x50\xa8\x8d\x43\xd8\x04\x33\x38\x19\x27\xc2\x0d\xdc\x9f\xcf\xe5\x17\xb4\x18\xb1\x9d\x67\x10\xcf\x93\xd6\xe6\x04\xe7\x73\xc5\xaf\x3e\x9e\x0e\x92\xe4\x08\x62\x6f\xed\x9e\x86\xc0\xf5\x1a\xaa\xb2\xe8\xfd\xe4\x22\x18\x17\x3f\x7d\x6c\x00\x89\xd2\xcf\x53\x5d\xa6\x19\xe8\x54\x86\x96\xeb\x81\xc6\x1d\xfd\xcb\xff\xa7\x49\x1a\xde\xcc\x62\xc6\x6d\x5e\xe7\xd5\xef\x0b\xad\x48\x92\xb1\x43\x75\xb0\xb2\xc7\x1f\xde\x2a\xa4\x00\xe2\xab\xd3\x1e\xd6\xed\xf0\x6a\x97\xea\xa6\x6d\x73\xa5\x0d\x71\x8c\x9b\x24\x2a\x8b\xa3\x24\x68\xdb\xa3\xb4\x13\x06\xf8\xf6\x9d\x37\x43\xd2\xcc\x9b\x99\x70\xad\xef\x40\x1e\x0e\x6c\xa7\xfa\x5b\x69\x80\xe9\xda\xa0\x55\xf9\xcd\xfb\x44\x19\x4d\x7f\x75\x9f\xa7\x93\x74\x03\xc2\xd6\x34\xb0\x4d\xeb\x7d\xde\x81\x78\x9c\xac\x95\x9d\xc5\x45\x58\x16\x46\xc3\x1d\x4b\x93\x40\x3c\xca\x11\xb9\x28\x4c\x70\x2c\xab\x6a\x98\xcb\x82\x3d\xf4\xde\xa9\x94\xd9\xd6\xa4\x66\x22\xb0\x1f\x2d\x6d\xc0\xdc\xfe\xa7\xc5\x37\xdc\x5c\xaf\xea\xba\x2c\x96\x74\x38\xdf\x55\x06\x29\x14\xc6\xf0\x9f\x57\xdb\x4d\x4e\x59\x6c\x9f\x58\x82\x54\xf9\xee\xa7\x50\x46\x5a\xec\x63\x03\xeb\x0c\x19\x95\x7a\xd6\x0f\xcf\xfc\xaa\x2e\x8c\xe6\x9a\xa7\x10\x22\x45\xd1\xb6\x94\xec\xa4\x6b\xe1\x0c\x32\x8c\xcc\x11\x49\x3c\x9c\xb0\xbf\xfd\x51\xca\x25\xe9\xef\x76\xe0\x8c\xcd\x41\x10\xf2\xd9\x3a\xf8\xd0\x64\xc2\x28\x5f\xf0\x81\xa8\x62\x51\xde\x67\xb9\xcc\xcb\x94\xb4\x04\x8f\x14\x4f\xfe\x57\xd8\x6b\xcd\xb6\x51\x55\xef\x82\x2e\xfd\x85\xcd\x5f\xad\xef\xf2\x4f\x00\x00\x00\xff\xff\x5a\xc7\x38\xe5\x58\x03\x00\x00")
+var _golangDeleteAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x41\x6b\xdc\x3c\x10\x3d\x4b\xbf\x62\x62\xf2\x81\x0d\x8e\xf8\x0e\xa5\x87\x85\x3d\x84\x36\x87\x52\x1a\x68\xd2\x5b\x29\x46\xb6\x46\x1b\x35\xb2\xb4\x91\xe5\xed\x06\xa1\xff\x5e\x46\x76\xc2\x06\x16\x02\x3d\x2c\xac\x34\xcf\xef\xcd\xbc\x37\x4a\xe9\x0a\x14\x6a\xe3\x10\xaa\xc9\xec\x9c\x8c\x73\xc0\x0a\xae\x72\xe6\x9f\xd1\x62\xc4\x2e\x25\x10\xf7\xb3\xd6\xe6\x08\x39\xd7\x29\xc1\x10\x8f\x7b\x19\xe4\x08\xe2\xda\xda\xeb\xb0\x9b\x20\xe7\x06\x6a\xce\x06\x3f\xbb\x08\xc6\xc5\x8f\x1f\x5a\xc0\x10\xe8\xe7\x43\xc3\x49\x03\x9d\x2a\xa4\xfc\x54\xd0\xb8\x83\x7f\x7c\x5f\x4d\x86\xdd\x1b\x2d\x9e\xd2\x79\xbe\xde\xab\xe7\x85\x8d\x11\x64\xec\x51\xed\xad\x1c\xf0\xc1\x5b\x85\x61\x02\xf1\xc5\x69\x0f\xa7\xe5\xe9\xc9\xae\xb7\x55\xd7\x95\x9b\x6e\x8a\x63\xac\x08\xc4\xd9\x41\x06\xe8\xba\x83\xb4\x33\x4e\xf0\xf3\x97\x71\x11\x83\x96\x03\xa6\xcc\xd9\xeb\xfd\x16\xe4\x7e\x8f\x4e\xd5\x2f\x37\x2d\xa4\x04\xda\xa0\x55\xe5\x0c\xe2\x3e\xca\x68\x86\xd7\xee\x8b\x7a\x90\x6e\x87\x70\x69\x5a\xb8\xa4\xf1\x36\x5b\x10\xb7\xb3\xb5\xb2\xb7\xb8\x02\x39\x33\x1a\x2e\x52\x2a\x00\x71\x2b\x47\x84\x9c\x85\x99\xdc\x6c\x6d\xdd\x40\xe2\x8c\x75\xdd\xe0\x9d\xa2\x84\x2e\x0d\x15\x89\x01\xb6\xa0\xa5\x9d\x90\xb3\xf7\x5a\x7c\xc3\x5b\x5a\xad\x9b\x86\xb3\xd5\x1d\xa7\x4e\x3d\x20\x53\x60\x0b\x5d\x37\x3d\xd9\x7e\x76\xca\x62\x77\x87\x4e\x61\xa8\x7d\xff\x5b\x28\x23\x2d\x0e\xb1\x85\x53\x0f\x1b\xce\xa8\x66\xfd\xee\x3e\x8e\xb1\x5e\x38\xda\x57\x3f\x85\x10\x0d\xb9\xa8\xbc\x43\xd8\x6c\x81\xb0\xaa\x17\x0f\xde\x3f\x7e\x9f\x31\x3c\xd7\x43\x3c\xb6\x50\xfe\x52\x3e\x34\xee\x37\x8c\x0f\x5e\x6d\x00\x00\xaa\x73\xeb\x52\xb5\x9c\xb1\xaf\xc6\x2d\x10\x58\x3e\xa6\x73\xb7\xa0\xa9\xfc\x83\x1c\x5e\xea\x29\xc1\x3e\x18\x17\x35\x54\xff\x3d\x55\x20\x4a\x09\x72\x26\x18\x45\x86\x23\xba\xb8\x59\x87\x6f\x39\xcb\x0d\x67\x0a\x35\x06\xd0\xb3\x1b\x28\x02\x58\xda\xaf\xcb\xe2\x97\x95\x6f\x20\xd7\x0d\xa7\xb9\x02\x2d\x02\x3d\x82\x97\xd9\x82\x39\x60\x10\x37\x47\x1c\x3e\x79\x17\xf1\x18\x97\x09\xcf\xfb\x62\x34\xb1\xc1\xc5\x16\x9c\xb1\x25\xeb\x80\x71\x0e\x0e\xfe\x6f\x0b\xdb\x28\x1f\xf1\x26\x84\x9a\x24\x29\xb2\xf5\xf1\x2d\x8a\x94\x53\xc0\x49\xdc\xf9\x3f\xd3\xb5\xd6\x38\x44\x54\xf5\x3f\x91\xae\xf5\x95\xdb\x19\x7b\xfa\xf4\xfe\x0e\x00\x32\x02\xf1\x3c\x3b\x04\x00\x00")
 
 func golangDeleteAllTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -165,12 +169,12 @@ func golangDeleteAllTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.delete-all.tmpl", size: 856, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.delete-all.tmpl", size: 1083, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangDeleteWorldTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x90\xc1\x4e\x03\x21\x10\x86\xcf\xec\x53\x8c\x9b\x98\xd0\xd8\x12\x0f\xc6\xdb\x1e\x1a\xd3\x9b\x17\xeb\x03\x34\x5b\x18\x1a\x14\xc1\x0e\x6c\x5d\x43\xfa\xee\xb2\xd0\x6d\x7a\xf6\x40\x48\x60\xbe\xef\x9f\x99\x94\x56\xa0\x50\x1b\x87\xd0\x06\x73\x70\x7d\x1c\x08\x5b\x58\x9d\xcf\x8d\x42\x8b\x11\xd7\xd6\x72\x19\x47\x90\xde\x45\x1c\xa3\x78\xa9\xf7\x02\xb8\xf4\x83\x8b\x60\x5c\x7c\x7e\x5a\x02\x12\x4d\xc7\xd3\xa2\x99\x94\xe8\x54\x71\x34\xb7\xfe\xbd\x57\xbf\x55\xcd\x4e\x3d\xc1\x6e\x47\x18\x20\x1c\xad\xd8\x62\x18\x6c\x9c\x5f\x6f\xbc\x95\xa7\xde\x1d\x10\xc4\xfb\xdb\x6b\x80\x09\x2e\x60\x8d\xec\xc0\xef\x3f\x84\x22\x73\x42\x12\x9b\x11\x25\x4f\x09\xbe\x29\xc3\x1a\xda\xfb\x63\x0b\x22\x13\x8b\x86\x19\x5d\xca\xef\x3a\x70\xc6\x42\x6a\x18\x23\xcc\x93\x3a\x78\x5c\x16\xc3\x57\xff\x89\x1b\x22\x9e\x8b\x72\x75\xee\x9b\x5d\xfa\x98\x63\x4a\xa6\xd8\xfa\x9f\xb0\xd6\x1a\x65\x44\xc5\xff\xa1\x65\x75\xb6\x87\x6e\x1e\xf3\xba\xac\x69\x57\x33\x7c\x09\xce\xca\xfc\x7d\x5d\xe5\x5f\x00\x00\x00\xff\xff\xab\x74\xf7\x3d\xaa\x01\x00\x00")
+var _golangDeleteWorldTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\xcd\x8a\xdb\x30\x10\x3e\x4b\x4f\x31\x35\x14\x6c\xea\x88\x3d\x94\x1e\x16\x72\x58\xda\x3d\x94\xb6\x87\x4d\x7a\x0f\xb6\x35\x4e\xd4\x28\xa3\x66\x2c\xe7\x07\xe3\x77\x2f\x92\xec\x10\x68\xd8\x43\x88\x3d\x33\xdf\xcf\x7c\xe3\x61\x58\x80\xc6\xd6\x10\x42\xd6\x99\x2d\x55\xbe\x67\xcc\x60\x31\x8e\x52\xa3\x45\x8f\x2f\xd6\xe6\x8d\xbf\x40\xe3\xc8\xe3\xc5\xab\xaf\xe9\xbf\x80\xbc\x71\x3d\x79\x30\xe4\xbf\x7c\x2e\x01\x99\xc3\xcf\x71\x21\x03\x25\x92\x8e\x1c\xf2\x9e\xbf\x76\xfa\x9a\xa8\xc5\xa9\x62\xd8\x6c\x18\x3b\xe8\x8e\x56\xad\xb0\xeb\xad\x9f\xab\x77\xbc\x73\x49\x3b\x42\x68\x7b\x6a\x72\x76\xe7\xee\x81\x66\xd4\xe1\x8a\xb6\x08\xea\x5b\xf4\xdd\x41\x90\x17\x13\x76\x09\xae\xfe\xa3\x74\xad\x76\xce\xed\xdf\x7a\xe4\x6b\x58\xaa\x84\xf8\xf8\x9d\x5a\x37\x48\x21\x7e\xa1\xdf\x39\xfd\x0c\x00\x90\xdd\x96\xcf\x4a\x29\xc4\x0f\x43\xa9\x0e\x09\x11\xde\x37\x49\x27\xb4\x7f\x57\xb5\xc5\xd4\x1f\x06\xf8\xcb\x86\x7c\x0b\xd9\xc7\x63\x06\x2a\xb6\x60\x1c\xc3\xd8\xda\x57\x1e\x0f\x48\xfe\xf9\xbf\xb1\xf5\xdb\xcf\x34\x34\x16\x52\xc4\x60\xd2\x7a\x93\x6f\x36\x27\x64\xf5\x7a\xc1\x66\x8a\x3f\xb9\x7f\xcc\x52\x48\x61\xda\x88\xfe\xb0\x04\x32\x16\xc2\x6e\x29\x87\xfc\x29\xd2\x16\x52\x08\x46\xdf\x33\xc1\x53\x19\x15\x0e\xd5\x1e\x5f\x99\xf3\xd4\x4c\xc1\xc5\x3b\xcc\x36\xa2\x27\xb5\x72\xe7\xee\xa5\x6d\xb1\xf1\xa8\xf3\x62\x4e\x37\xbf\x9f\x7d\xa8\xfe\xbe\x98\x88\x68\xf8\xb4\x9c\x8f\x7f\xfb\x84\xe2\x09\x27\xf0\x24\x41\xc6\xca\x61\x00\x24\x0d\x8b\x71\x94\xff\x06\x00\x44\xda\x3c\xdb\xc0\x02\x00\x00")
 
 func golangDeleteWorldTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -185,12 +189,12 @@ func golangDeleteWorldTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.delete-world.tmpl", size: 426, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.delete-world.tmpl", size: 704, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x92\x4f\x8b\xdb\x30\x10\xc5\xcf\xd6\xa7\x98\x0d\x39\xd8\xe0\x15\x3d\x17\x52\x08\x74\x0f\xbd\xec\x61\xf7\x58\x8a\x91\xad\x91\xab\xae\x2c\x65\x47\x72\x9a\x62\xf2\xdd\x3b\x52\xdc\x90\x85\xfe\x81\x1e\x0c\xd6\xe8\xcd\xef\xcd\x3c\xb4\x2c\xf7\xa0\xd1\x58\x8f\xb0\x89\x76\xf4\x2a\xcd\x84\x1b\xb8\x3f\x9f\xc5\x47\x74\x98\xb0\x5b\x16\x90\xcf\xb3\x31\xf6\x04\xe7\x73\xcd\xa7\x21\x9d\x0e\x8a\xd4\x04\x72\xef\xdc\x9e\xc6\xc8\xf5\x06\x6a\x51\xe9\xd2\xa0\xa1\x0f\xc1\xb5\x80\x44\xf9\x0b\xd4\x88\x6c\x82\x5e\x17\xaa\xb8\x75\xb4\xfe\x18\x5e\xfe\x6d\xa7\x68\x7c\x63\xf6\x67\x60\x1f\xf4\x8f\x0b\xae\xe2\x56\x9c\x7a\xd4\x07\xa7\x06\xfc\x1a\x9c\x46\x8a\x20\x3f\x79\x13\xe0\xf6\x3a\xbe\xba\xb5\xba\xe9\xba\x52\xe9\x62\x9a\xd2\x26\x8b\x44\x75\x54\x04\x5d\x77\x54\x6e\xc6\x08\x9f\xbf\x58\x9f\x90\x0c\xf3\x16\x26\x5c\xeb\x3b\x50\x87\x03\x8f\x53\xff\xaa\xb4\xc0\x74\x63\xd1\xe9\x72\xe6\x85\x92\x4a\x76\xb8\x8e\x5f\xdc\x49\xf9\x11\x61\x6b\x5b\xd8\xe6\xfd\xde\xef\x40\x3e\xce\xce\xa9\xde\xe1\x2a\x14\x95\x35\x70\xc7\xd2\x2c\x90\x8f\x6a\x42\x2e\x4a\x1b\x3d\xcb\xea\x06\x16\x51\xf1\x0c\x43\xf0\x3a\x87\xb6\xb5\xf9\x32\x13\x78\x1e\xa3\x5c\xc4\x72\xfd\xd7\x11\xdf\x70\x4b\xbd\x6e\x1a\x51\xad\xe9\x70\xbe\x37\x19\xe4\x50\x18\xc3\x3f\xaf\xae\x9f\xbd\x76\xd8\x3d\xb1\x04\xa9\x0e\xfd\x37\xa9\xad\x72\x38\xa4\x16\x6e\x33\x64\x54\xbe\x73\x61\x7c\xe6\x53\x7d\x61\xb4\xd7\x3c\xa5\x94\x39\x8a\xae\xa3\x3c\x4e\x7e\x2e\x9c\x41\x81\x91\x3d\x22\xc9\x87\x13\x0e\xbf\x6f\xca\xb9\x64\xfd\xdd\x0e\xbc\x75\x25\x08\x42\x7e\xb8\xfe\xb2\x78\x5b\x28\x93\x7a\xc1\x07\xa2\x9a\x85\x65\x27\x51\xc2\x9a\x7d\xba\x7a\x15\x67\xf9\x14\xbe\xc7\xbd\x31\x3c\x3d\xea\xfa\xbf\xd9\xab\x66\xb5\x80\x0f\xf0\xae\xcd\xfd\x62\x0d\x32\xbf\xc8\x9f\x01\x00\x00\xff\xff\x94\xcf\x73\x61\x6b\x03\x00\x00")
+var _golangDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x41\x6b\xdc\x3c\x10\x3d\x4b\xbf\x62\x62\xf2\x81\x0d\x8e\xf8\x0e\xa5\x87\x85\x2d\x84\x36\x87\x52\x1a\x68\xd2\x5b\x29\x46\xb6\x46\x1b\x35\xb2\xb4\x91\xe5\xed\x06\xa1\xff\x5e\x46\x76\xc2\x06\x52\x02\x3d\x18\x2c\xcd\x9b\x37\x33\xef\x8d\x52\xba\x00\x85\xda\x38\x84\x6a\x32\x3b\x27\xe3\x1c\xb0\x82\x8b\x9c\xf9\x27\xb4\x18\xb1\x4b\x09\xc4\xed\xac\xb5\x39\x42\xce\x75\x4a\x30\xc4\xe3\x5e\x06\x39\x82\xb8\xb4\xf6\x32\xec\x26\xc8\xb9\x81\x9a\x33\x55\x12\x14\xf4\xde\xdb\x16\x30\x04\xfa\x7c\x68\x38\x15\x41\xa7\x0a\x2b\x3f\xad\x68\xdc\xc1\xdf\xbf\x5d\x4e\x86\xdd\x8b\x62\x7f\x27\xec\xbd\x7a\x5c\xe8\x58\x4a\x80\x63\x8f\x6a\x6f\xe5\x80\x77\xde\x2a\x0c\x13\x88\xcf\x4e\x7b\x38\x0d\x4f\x0f\x76\xbd\xad\xba\xae\x24\x74\x53\x1c\x63\x45\x20\xce\x0e\x32\x40\xd7\x1d\xa4\x9d\x71\x82\x1f\x3f\x8d\x8b\x18\xb4\x1c\x30\x65\xce\x9e\xef\xb7\x20\xf7\x7b\x74\xaa\x7e\xba\x69\x21\x25\xd0\x06\xad\x2a\x67\x10\xb7\x51\x46\x33\x3c\xb7\x5f\xaa\x07\xe9\x76\x08\xe7\xa6\x85\x73\x9a\x6f\xb3\x05\x71\x3d\x5b\x2b\x7b\x8b\x2b\x90\x33\xa3\xe1\x2c\xa5\x02\x10\xd7\x72\x44\xc8\x59\x98\xc9\xcd\xd6\xd6\x0d\x24\xce\x58\xd7\x0d\xde\x29\xf2\xe8\xdc\x50\x90\x18\x60\x0b\x5a\xda\x09\x39\x7b\xab\xc5\x17\xbc\xa5\xd5\xba\x69\x38\x5b\xd5\x71\xea\x54\x03\x12\x05\xb6\xd0\x75\xd3\x83\xed\x67\xa7\x2c\x76\x37\xe8\x14\x86\xda\xf7\xbf\x84\x32\xd2\xe2\x10\x5b\x38\xd5\xb0\xe1\x8c\x62\xd6\xef\x6e\xe3\x18\xeb\x85\xa3\x7d\xd6\x53\x08\xd1\x90\x8a\xca\x3b\x84\xcd\x16\x08\xab\x7a\x71\xe7\xfd\xfd\xb7\x19\xc3\x63\x3d\xc4\x63\x0b\xe5\x97\xfc\xa1\x71\xbf\x62\xbc\xf3\x6a\x03\x00\x50\xbd\xb6\x2f\x55\xcb\x19\xfb\x62\xdc\x02\x81\x25\x99\xce\xdd\x82\xa6\xf0\x77\x52\x78\x89\xa7\x04\xfb\x60\x5c\xd4\x50\xfd\xf7\x50\x81\x28\x21\xc8\x99\x60\x64\x19\x8e\xe8\xe2\x66\x1d\xbe\xe5\x2c\x37\x4f\x6a\x0c\x7e\x76\x11\x8c\x8b\xef\xdf\xd1\xde\x6b\x0c\xa0\x67\x37\x90\x2b\xb0\x4c\x54\xaf\xa0\xf2\x10\x1a\xc8\x75\xc3\x69\xd8\x40\xdb\x41\x4f\xe3\x69\xe0\x60\x0e\x18\xc4\xd5\x11\x87\x8f\xde\x45\x3c\xc6\x65\xec\xd7\xc5\x32\x9a\xd8\xe0\x6c\x0b\xce\xd8\xb2\x00\x01\xe3\x1c\xdc\x62\x78\x5b\x18\x47\x79\x8f\x57\x21\xd4\x54\x96\xbc\xe4\xec\xb4\x93\x62\x61\xc0\x49\xdc\xf8\xdf\xd3\xa5\xd6\x38\x44\x54\xf5\x3f\x53\xaf\x98\xb5\x02\x7c\x80\xff\x5b\xca\xe7\x29\x01\x3a\x05\x17\x39\xf3\x3f\x03\x00\x69\x90\x8d\x83\x62\x04\x00\x00")
 
 func golangDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -205,12 +209,12 @@ func golangDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.delete.tmpl", size: 875, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.delete.tmpl", size: 1122, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangDialectPostgresTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x90\xb1\x6e\x83\x30\x18\x84\x67\xfc\x14\xbf\x3c\xd9\x11\x38\x52\xbb\xb5\xca\xd2\xb4\x73\xa5\xbe\x41\x80\x1f\x6a\xd5\xb1\xc1\x36\x5d\x2c\xde\xbd\xbf\x9d\x90\x28\x43\x25\x04\xc2\x77\xdf\x9d\xce\x29\x35\xd0\xe3\xa0\x2d\x02\xd7\xe7\xc9\xf9\xc8\x61\x5d\x59\xc5\x47\x1d\xbf\x97\x56\x75\xee\xbc\x37\xba\xdd\x4f\x33\x67\x29\x01\xda\x1e\x1a\xd2\xd9\x03\x17\x9a\xce\xd9\x10\xfd\x49\xdb\xd8\xa0\xf7\xce\x97\x90\x61\xb1\x1d\x08\x4a\x35\x40\xa8\xfa\xc2\x0e\xf5\x2f\x7a\x92\x24\xe8\x70\xbc\x21\x1f\x99\x10\xc4\x41\x61\x25\x08\x56\xdd\x03\x81\xbe\xda\x8e\x35\xb8\x1f\x68\x9d\x33\x12\x12\xab\xf4\x00\x58\x4e\x5e\x0e\x19\x52\x62\x37\xcd\xaa\xe4\xc8\xd7\x7c\x4c\x96\xaa\x98\xd4\xd1\xf5\xf4\x32\xa7\x10\x84\x84\xc3\x01\xf8\xd3\x33\xbf\xc8\x95\xc7\xb8\x78\x5b\x3c\x5b\x59\x0d\xd1\x2f\x98\x55\xba\x03\x7a\xae\x16\xce\x6b\x18\x4e\x26\x20\x5b\xff\xbd\x05\x37\xa1\xbd\xcf\xce\x7f\x93\x0b\x71\xf4\x18\x44\x70\x8b\xef\xf0\x3a\x84\xe6\xed\xc2\x6c\xd4\xfb\x5b\xbd\xed\x4d\xb7\xa2\x2c\x7c\x12\x2a\xf8\x06\x53\xf3\x05\x97\x0f\xdd\x7f\x01\x00\x00\xff\xff\xc1\x0a\x5b\x7f\xb8\x01\x00\x00")
+var _golangDialectPostgresTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xdf\x6f\xdb\x36\x10\x7e\x26\xff\x8a\x9b\x80\xa6\x52\xa0\x28\xd8\xd6\xed\x21\x85\x1f\x52\x57\xc1\x82\xe5\xc7\x66\xbb\xdb\x82\x61\x50\x68\xe9\x6c\x13\xa5\x48\x85\xa4\x12\x67\x86\xff\xf7\x81\x14\x15\xcb\x49\xbb\x15\x43\xfd\x62\xf1\x7e\x7c\x77\xdf\xdd\x27\x6a\xb3\x39\x82\x0a\x17\x5c\x22\x44\xbc\x6e\x94\xb6\x11\x6c\xb7\x94\x44\x4b\x6e\x57\xed\x3c\x2b\x55\x7d\x2c\xf8\xfc\xb8\xb9\x8b\xe8\x66\x03\x28\x2b\x38\xda\x6e\x29\xdd\xcb\x33\x47\xa5\x92\xc6\x6a\xc6\xa5\x3d\x42\xad\x95\xf6\x20\x8b\x56\x96\x10\xf3\xba\x11\xb0\xd9\x40\x36\xc1\x12\xf9\x3d\x6a\xd8\x6e\x13\xe0\x66\xfc\x94\x92\xbb\x8c\x18\xb5\x06\x9f\x9b\x40\x4c\xc9\x0e\x10\x8c\xd5\x5c\x2e\x53\x28\x57\x58\x7e\x84\xb9\x52\x22\x05\xd5\x3d\x24\xb0\xa1\x84\x2f\x00\xbd\xe5\x64\xe4\x00\xb2\xf8\xb0\xb9\xcb\x3c\x66\xf2\xd6\x99\x37\x94\x10\x1f\x94\x8d\x55\x85\xd9\x58\x30\x63\xe2\x04\x46\x23\x88\xbe\xfb\x3e\x72\x08\x84\x10\x8d\xb6\xd5\x12\x30\xdb\xb5\x95\x86\x8c\x10\xf9\xc3\xb7\x6f\xa2\x14\xac\x6e\xd1\x25\x6c\x29\xd9\xd2\x3e\x2b\x8a\x52\x58\x30\x61\x30\xfc\xd1\xed\x67\x67\x65\xca\x15\xd6\xec\xa8\x64\x96\x09\xb5\xfc\xcf\x31\x75\xe1\xe3\x2e\x3a\x2e\xed\x1a\x4a\x25\x2d\xae\xad\xeb\xd3\xfd\x77\xc3\xea\xfc\x70\xb8\x17\x9e\xc2\x60\xa4\x9b\x5d\xd4\x08\x24\x3e\x4c\xf7\x80\x13\x4a\x89\x56\x0f\xa6\x4b\x39\x19\x81\x5b\x5a\x56\x69\xd7\x47\xf6\x6b\x8b\xfa\x31\x94\x73\x2d\xa4\x94\x90\xdb\x69\x7e\x91\x8f\x67\x60\xd9\x5c\x60\x21\x59\x8d\x29\x94\x4a\xb4\xb5\xec\x0e\x6e\x44\x15\xb3\xac\xb0\x8f\x8d\x73\xad\x98\x66\xa5\x45\x5d\xd4\x6c\xcd\xeb\xb6\x2e\x04\xca\xa5\x5d\xa5\xc0\x4d\x21\x5b\x21\x1c\x0c\x25\xe4\x6c\x72\x7d\x09\x5c\x2e\x94\xae\x99\xe5\x4a\x16\x1d\xa1\xac\x83\x36\x94\x90\xdf\x7f\xca\x27\x79\x28\xdb\x39\x61\x04\x65\xab\x35\x4a\x1b\xa2\xe3\xe4\x36\xe9\x54\xa1\x35\x7c\x33\x02\xc9\x85\x5f\x72\x58\x96\xe4\xc2\xd3\xf4\x0b\xac\x70\x81\x1a\x1c\xf5\x6c\x2c\x94\x41\x3f\x89\x85\x0a\xa6\x2b\xc7\xd8\xcf\x8e\xdc\x33\xdd\x55\xed\x79\xa6\x30\xe0\x37\x60\x11\xe4\x1a\x52\x3a\x9a\x60\xee\x44\x76\xd5\x0a\x71\x2e\xed\x8f\x6f\x28\x21\x61\xca\xbe\xc8\xb4\x64\x32\x3e\x08\xd8\x07\x3d\xf8\xc1\x00\xfd\xa0\x1f\xd6\xc1\xa0\x4e\x42\xc9\x27\x38\xbe\x24\xe9\x58\xba\xc0\x0e\x23\xfb\x8d\x09\x5e\xc1\x66\x6f\x3f\x30\x82\x45\x6d\xb3\x69\xa3\xb9\xb4\x8b\x38\x7a\x65\xe2\x57\x55\x12\xed\x31\x0c\xe9\x9e\x40\x12\x50\x83\xa0\x32\x56\x55\x63\xdf\x76\x1c\x58\x04\x19\x7a\x9b\x2f\xe5\x34\x71\x02\xee\x17\xf8\x39\xa3\x7d\x6c\x3a\xdb\xa0\x8e\xb3\xf7\x0c\x4f\x86\xe2\xf0\x2f\xe1\x4d\x3e\x8d\x9c\xfa\xb6\x89\x5f\x5e\xa0\xdf\x0f\x32\xd7\x3a\x4e\xde\x7e\xc1\xd6\x29\xe1\xb2\xc2\x75\xf1\xff\x15\xef\xf8\xa4\xe0\x51\xdc\x63\xaf\xdc\x66\x59\x78\x1b\x1a\xe8\x74\xda\x0d\xc2\x85\x7c\x05\x95\xee\x9a\x7e\xa6\xd5\x81\xe3\xd3\x8a\xf5\x01\x3b\x61\xee\xe6\x36\xc8\xdc\x97\xa1\x77\xbc\x1c\xe6\xcb\xee\x9e\x0b\xe1\xdc\x25\xf6\x3a\xe8\x50\x9e\xed\x6a\x50\xf3\x8b\x37\xd6\xdb\x42\xa1\xd4\x39\xff\xe5\x92\xad\xf9\x52\x33\x8b\xfe\x76\x3d\x3e\x06\xbb\x42\x60\xd5\x3d\x37\x4a\x3f\x82\x50\xe5\x47\xe0\x06\x56\x28\x2a\x68\xa5\xe5\xc2\xfb\x1d\x8e\x5a\x00\xb2\x72\x05\x5d\x3a\x57\x12\xac\x66\xd2\xb0\xd2\x5d\x45\x19\xbd\x67\x1a\x1a\x65\xec\x52\xa3\xb9\xf4\x21\x38\x45\xdb\x36\x30\x82\x3f\xff\xea\x86\xbb\xa1\x4f\x3a\x69\x96\x45\x5f\xb3\x58\xb3\xd2\x16\xae\x70\xbc\x62\x66\xe5\xae\xed\xf8\x75\x35\x5f\x17\xf7\xa8\x0d\x57\xd2\xbc\x4e\x92\xdb\x94\x92\xdb\xf1\x24\x3f\x9d\xe5\x30\x3b\x7d\x77\x91\xc3\xf9\x19\x5c\x5d\xcf\x20\xff\xe3\x7c\x3a\x9b\xc2\x30\xdc\x5d\xf9\x24\x1c\x60\xce\x97\x5c\x5a\x1f\x7a\xf5\xe1\xe2\xc2\xbd\x1e\x5e\x6f\xae\xca\x9e\x95\x35\x8d\xe0\x58\x15\xcc\x82\xe5\x35\x1a\xcb\xea\x06\x1e\xb8\x5d\xf9\x23\xfc\xad\x24\x3e\xc5\xc3\xfb\xfc\xec\xf4\xc3\xc5\x0c\xa4\x7a\x88\x13\x87\xf9\xcb\xe4\xfc\xf2\x74\x72\x03\x3f\xe7\x37\x10\x43\x5f\x3c\xa1\xc4\x75\xfe\xf9\x4d\xa8\x06\xe5\xee\x23\xe7\x4e\xfd\x04\x63\xa3\x5a\x5d\x62\x50\x65\x02\xf1\xa1\xbb\x24\xdf\xbf\x4b\x07\x5f\xac\xb0\x75\xe7\xb8\x6e\x50\xc6\x51\x9f\x1c\xa5\xd0\xa5\x27\x7b\xb5\xff\x19\x00\x6b\xf3\xce\xbc\xcd\x08\x00\x00")
 
 func golangDialectPostgresTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -225,12 +229,12 @@ func golangDialectPostgresTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.dialect-postgres.tmpl", size: 440, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.dialect-postgres.tmpl", size: 2253, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangDialectSqlite3Tmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\x5d\x6b\xdb\x40\x10\x7c\xb6\x7e\xc5\x56\xd0\x22\xb5\xb2\x4c\x08\xf4\xc1\xc5\x0f\x69\x12\xfa\x41\x3e\xda\xb8\xd0\x87\x10\xcc\x59\x5a\xcb\x57\x4b\x77\xee\xdd\x29\xb1\x31\xfe\xef\xdd\xbd\x93\x13\xbb\x4d\x0b\x05\x83\xd1\xed\xce\xcc\xde\xcc\xde\x66\xd3\x87\x12\x67\x52\x21\xc4\xb2\x59\x6a\xe3\x62\xd8\x6e\xa3\x5e\xdc\x08\x37\x1f\x18\xa1\xca\x98\x3e\x2a\xe9\xe6\xed\x34\x2f\x74\x33\xa0\x73\xa7\x06\x95\xee\xdb\x9f\xb5\x74\x78\x1c\x47\x9b\x0d\xa0\x2a\xa1\x4f\xb0\xe8\x80\xce\xf6\x0b\xad\xac\x33\x42\x2a\xd7\x47\x63\xb4\xf1\xdc\xb3\x56\x15\x90\x90\x58\x0d\x04\xcd\x6f\xb0\x40\x79\x8f\x86\x4a\x29\x48\x7b\xfa\x08\x39\x67\x44\x42\x38\xf0\xd8\x14\x92\xa8\xf7\x44\x08\xf4\x2f\x55\x95\x81\x5e\xc0\x54\xeb\x3a\x85\x4d\xd4\x93\x33\x40\x7f\x32\x1c\x31\x28\x4f\xba\x21\x73\xcf\x95\xbe\xe3\x12\xb5\xf5\x7c\x63\x7e\xaa\x4b\x84\xd1\x08\xf6\x9a\x9e\xd4\x43\x5f\xaf\xb1\xd5\x8e\x2c\xcc\x93\xfa\xe3\x42\xd7\x5a\x71\x21\x4c\x61\xf3\x0b\x61\xdd\x27\x55\xe2\x2a\x21\x44\x06\xf1\x30\x0e\x8d\x24\x14\x7a\x5f\x8c\xa0\x7f\xd4\x91\xf6\x0c\xba\xd6\xa8\x47\xf0\x37\x23\x9b\xf1\x52\x14\xc8\xe0\x5b\xdf\x3f\xbc\x4b\x33\x70\xa6\x45\x0f\xd8\x46\x7b\xa8\x38\x7e\xaa\x50\x81\x7e\x7b\x85\x99\xa8\x2d\x46\xdb\xbf\x86\xa2\x97\xa8\x7c\x0a\xf7\xc2\xec\x2e\x7e\x66\xd8\xff\x2b\xd1\x90\x1b\xc0\xe9\x24\x69\x37\x1a\xcf\xcb\x8d\xb2\x84\xdb\xa3\xb7\x77\xd3\xb5\x23\x55\x5e\x0a\x4a\x4d\x94\x89\x2c\x6f\x69\xce\x47\xfd\x59\xe3\xf2\xf1\x92\x70\x6e\x96\xc4\x1d\xf7\xe4\xe5\x8a\xa6\x0a\x6c\x5d\x7f\x1a\x6d\xc9\xc5\xb0\x06\x52\x49\x97\xf8\xe8\xa8\x9f\x48\x2b\x69\x1d\x9a\xe4\x8f\xc1\x32\x78\xb5\x4b\x69\xfc\xf5\x82\xfe\x43\x89\xed\xa4\xc8\x14\x16\xee\xa3\xd6\x8b\xe1\xee\x46\x63\x1a\x68\xc9\x85\x8c\xfc\x21\xbd\x28\x1a\x0c\x20\x00\x8f\x3f\x6b\x9a\x55\xd4\x97\x1c\x3e\xad\x93\x33\xba\xb6\xe0\xe6\x08\x3f\x42\x61\xd2\x70\x65\x69\x44\xd5\x08\x98\x69\x03\xa2\xae\x41\xe1\x03\x37\xb3\x90\xa4\x15\xc9\x3d\x9f\x54\x05\x82\x74\xb4\xb4\x60\xc8\x0e\x78\xa0\x57\xa2\x5b\x07\x02\x9a\xd6\xe1\x2a\xe3\x5a\xd3\x5a\x07\x53\x52\x9a\x0b\x55\x61\x09\x4e\x7b\xad\x7b\x51\xb7\x08\x6b\xdd\xc2\x83\x50\x8e\xd9\xa6\x48\x5a\x08\x42\xad\xe1\x9a\x32\x82\x82\x64\x49\x87\xdd\x7f\x66\xf0\x11\xc4\xdf\x4f\x2e\xe2\xce\xc5\xdf\x6f\x9d\xf0\xa8\xf0\xfa\xd0\x31\x2e\xd0\x1b\xda\x7b\x4f\xe4\xde\x24\xe3\x0f\xa2\x63\x44\x7e\xbe\xc2\x22\x89\xbf\xdc\x9c\x7c\xb8\x3c\xe1\xab\xa3\xac\xd4\x64\x81\x6b\x4b\x0d\xd7\x57\x94\xa3\x92\x75\x1a\x5e\x19\x81\x68\xa3\xe9\xdb\xaf\x74\xb7\x00\x8d\x58\x20\xbd\x12\xd6\x48\xfd\x62\xfe\x83\xfe\xc0\x6d\xba\x0e\xbc\x79\xe6\x9e\xff\xaf\xd8\x9d\x53\x1b\xa7\xee\xdd\xe1\x95\xef\xac\x48\x2c\x71\x53\x68\x61\x1f\xc9\x0d\xf6\x28\x3f\x7b\x9f\xed\x59\xb2\x7b\x9b\x54\xe0\x20\x9e\xdb\xc5\xc0\x92\x1e\x3c\xb3\x5f\x01\x00\x00\xff\xff\xee\x90\xf0\xb1\x49\x05\x00\x00")
+var _golangDialectSqlite3Tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x51\x6f\xe3\xb8\x11\x7e\x96\x7e\xc5\x9c\x80\x66\xa5\xab\x22\xdf\xe1\x80\x3e\xf8\x2a\x14\x3e\xaf\x76\x2f\xad\xed\xcd\xd9\x5e\xdc\x1e\x16\x0b\x87\x96\xc6\x36\x6b\x89\xd4\x92\x54\x62\xc3\xf0\x7f\x2f\x86\x94\x15\x3b\x9b\xa4\xbd\xe6\x25\x12\xf9\xcd\xf0\xe3\xcc\x7c\x33\xf2\xe1\x70\x0d\x05\xae\xb8\x40\x08\x78\x55\x4b\x65\x02\x38\x1e\x7d\x2f\xa8\x98\xd9\xf4\x14\x13\x45\xe0\x7b\x81\xc2\x35\xee\x6a\x7a\x5a\x73\xb3\x69\x96\x49\x2e\xab\x5e\xc5\x8c\x11\xbd\xb5\xbc\xd6\x5f\x4b\x6e\xf0\xa7\xc0\x3f\x1c\x00\x45\x01\xd7\xc7\xa3\xef\x5f\x38\xd6\xd7\xb9\x14\xda\x28\xc6\x85\xb9\x46\xa5\xa4\xb2\xa7\xac\x1a\x91\x43\xc8\xab\xba\x84\xc3\x01\x92\x29\xe6\xc8\xef\x51\xc1\xf1\x18\x01\xd7\xc3\xce\x24\x23\x8b\x10\x95\x02\x6b\x1b\x41\xe8\x7b\x8f\x0e\x41\x1b\xc5\xc5\x3a\x86\x7c\x83\xf9\x16\x96\x52\x96\x31\x48\xf7\x10\xc1\xc1\xf7\xf8\x0a\xd0\xae\xf4\x53\x72\x90\x84\x2d\xe1\xc4\xfa\x8d\x7e\xa6\xad\x83\xef\x79\x16\x98\x0c\x65\x81\x90\xa6\x70\x06\x7a\x64\x42\xee\x3c\xcf\x73\x27\x91\xbb\x24\xdb\x19\x14\x05\x16\xaf\x9a\x0d\x09\x6f\x2d\x2b\xbd\x3e\xd1\xb0\xa7\x87\x91\x5d\xce\x65\x29\x05\x6d\xb8\xbb\xe8\x64\xc4\xb4\xb9\x11\x05\xee\xc2\x4a\xaf\x63\x08\xfa\x81\x03\xf2\x15\x38\xec\x77\x29\x5c\xff\xd8\xd2\xf1\x14\x9a\x46\x89\xce\x78\xae\x78\x35\xab\x59\x8e\x64\xfc\xd9\xe2\xfb\x5f\xa2\x36\x40\x31\x18\xd5\xa0\xb5\x3b\xfa\x67\xc6\x41\xf0\x0d\xe0\xe8\x7b\x47\xff\x7c\x7f\xc5\x4a\x8d\xed\x3f\xff\xf8\x62\xbe\x75\xbe\xc1\x8a\x5d\xe7\xcc\xb0\x52\xae\xff\x6b\xaa\x1d\x7c\xe8\xd0\x61\x6e\x76\x90\x4b\x61\x70\x67\x92\xa1\xfb\xef\x12\xee\xf6\xe1\xfb\x0b\x78\x0c\x67\x65\x71\x78\x44\xa5\x20\xf0\x61\x76\xe1\x38\xf2\x7d\x4f\xc9\x07\xed\x4c\xfa\x29\x50\xe1\x25\x85\x22\x1e\xc9\x6f\x0d\xaa\x7d\x7b\x1c\x51\x88\x7d\xcf\xbb\x9b\x65\xa3\x6c\x38\x07\xb3\xaf\x31\x06\xc1\x2a\x8c\xc1\x2c\xcb\x05\x3d\xc1\xbb\xe9\x87\x71\x9b\xec\x45\xc5\xb4\x41\xe5\x7b\xde\xef\xbf\x66\xd3\xcc\xe2\xe1\x66\x02\xe1\x1b\xc3\x96\x25\xbe\x89\xe1\x0d\xa7\x54\xbe\x89\xee\x22\x57\x8d\x4a\xc1\x77\x29\x08\x5e\xda\x04\xb6\x01\x16\xbc\xb4\xd4\x6c\xd0\xef\x99\x02\x6b\xad\xe1\xf3\x17\x97\x57\xdf\x5b\x49\x05\x74\x83\x64\x42\x2c\xed\x7d\x1d\x70\x5f\x77\xfc\xc8\xa6\x2d\x04\xdf\x3b\x1d\xd6\x4f\x9d\xdd\x2c\x67\x22\xbc\xb2\xf0\x2b\x87\xbf\xb2\x87\x44\x3f\x3f\xe5\x64\x23\x95\x0c\x4b\xa9\xd1\x95\xe8\x37\x24\x89\x25\xf9\x37\xfb\x9a\xea\x3e\xb0\x8e\x02\xcb\xc9\x6b\x99\xa7\xc0\xea\x1a\x45\x11\xba\x77\xc7\x91\xbc\x1d\x01\x4b\x8d\x0e\xdb\x66\x2c\x61\x45\xe1\x0a\xde\x82\xcf\xb0\x36\x1e\x4f\x2e\x92\x29\x15\x7e\x4b\xfa\x09\xe7\xe7\xe2\x7a\x01\xf1\xbd\x5e\x0f\xcc\x06\x4f\x91\x66\x0a\x41\x21\x2b\x80\xad\x0c\x2a\xc8\x4b\xa9\xb9\x58\x5b\x44\xad\xf0\x9e\xcb\x46\xdb\x38\x82\x96\x60\x36\xcc\x00\x13\xc0\x85\xf5\x52\x61\x25\xd5\x1e\x0a\x66\xd8\x92\x69\x04\xae\x41\x48\x03\x5f\x1b\x54\x1c\x0b\x58\x29\x59\x01\x03\x8d\xb9\x14\x05\x95\xb7\xc0\xdc\x70\x29\x12\x97\xd4\xc5\x29\x71\x94\x28\x26\xd6\x1d\xa3\xc3\x45\x0e\x6d\xc1\x5e\x94\xff\x9c\x88\xdb\x7a\x85\x36\x8e\x31\xbc\x98\xd1\xe7\x32\xf8\xa8\xef\xce\x5e\xf0\xd2\x3f\xfa\xff\xbb\x62\x3b\x0e\x4f\x65\x1b\xbf\x2c\xda\xf3\x32\x8d\xe0\xbc\xb5\x1f\xfe\x5f\x95\xb6\xf5\x6f\xb5\x1a\x08\x69\x44\x53\x96\x41\x0c\xf5\xd6\x49\xb5\x56\x6c\x5d\xb1\x85\x3d\x78\xc1\xc5\x4a\x86\xff\x88\xee\x4e\xc1\x7a\x45\x95\xa7\xc2\x29\x70\x85\x0a\x9e\x94\xcf\x4b\x82\xec\xb8\x3c\x2a\xd1\x2e\x4b\xb3\x20\x5a\x96\x15\x17\xe6\x25\x81\x9e\xa4\xe9\x74\xda\x19\x5d\xd5\xdb\x97\xd3\xfa\xa8\xc9\x36\xe6\x24\xa8\xa1\x2c\x9b\x4a\x9c\x14\xd5\xe6\xc0\xae\x59\x53\x3a\xa7\x0f\xf4\x47\x4f\x14\x4c\xcf\xec\x6b\xb7\x42\x5d\xcc\xae\x10\x61\xb2\xef\x77\xf4\x49\xee\x3f\xc0\xd5\x15\xdd\x82\x1e\x09\x76\x8c\xce\x6b\xe9\x51\xa3\xaf\x4c\x89\x8a\xaf\x15\x33\x68\xc7\x43\xab\xc3\xa6\x2e\x98\xa1\xe2\xdf\xa2\xb6\x0b\x0f\x8a\x1b\x84\x52\xe6\x5b\x90\xc2\xae\x74\x0a\x6b\x84\xe1\xa5\x5d\x22\xef\x72\x05\xc8\xf2\x8d\x4f\x52\xb4\x7e\x39\xe1\x15\x13\x9a\xb5\x42\xa3\x04\xb4\xc3\x79\x6c\x11\x38\x43\xd3\xd4\x90\x76\x0d\xf6\xe0\x7b\x77\xc3\x69\x36\x98\x67\x30\x1f\xfc\x32\xca\xe0\xe6\x1d\x4c\x3e\xcc\x21\xfb\x74\x33\x9b\xcf\xa0\x58\xee\x16\xf7\xa8\x34\x97\x42\xd3\x40\xf2\xda\x17\xb8\x99\xcc\xb3\xf7\xd9\xd4\x62\x27\x1f\x47\x23\x8a\x07\x05\x14\xe6\xd9\xa7\xf9\xc5\x2a\xab\xeb\x92\x63\xb1\x60\x06\xe6\x37\xe3\x6c\x36\x1f\x8c\x6f\x3b\x00\xbc\xcd\xde\x0d\x3e\x8e\xe6\x30\xfc\x38\x9d\x66\x93\xf9\xa2\x83\x90\xc3\xdb\xe9\xcd\x78\x30\xfd\x03\xfe\x95\xfd\x01\x21\x9c\x8e\x8e\x7c\x2f\xba\x8b\x7d\xef\xee\xe3\xed\x5b\xe2\x7d\xc1\x71\x96\xcd\x3b\x60\xda\x3d\xb9\x19\x75\x7a\xfb\x3b\xfc\x70\x17\xbf\x92\x24\x59\xa3\xb0\x19\x3a\x0b\xdf\x5b\x2b\xc6\x09\xdd\x30\x05\x6a\x13\x61\xd4\xd6\x39\x35\x1a\x02\xf2\x02\x3e\xff\xf8\xb7\x2f\xcb\xbd\x41\xdf\xa3\x8f\xc8\x64\x8a\xac\x08\x79\xf1\xb9\xff\x25\xea\xaa\x64\x55\x99\x64\x56\x2b\x2e\xcc\x2a\x0c\x5a\xdf\x8b\xbf\xec\x82\xb8\xf5\xd6\xe2\x23\xff\x48\x62\xa3\x83\x80\x0b\xde\xea\x4c\x7f\x2d\x93\x29\xae\x39\x4d\xde\xf0\x1b\x62\x31\x5c\xb5\x6b\xc9\xec\xb7\x11\x37\xe8\xb6\xa8\xea\x87\xae\xf9\xfe\x2a\xe5\xb6\x7f\xba\x91\xad\x04\xda\x88\x7d\xef\x18\x51\xf7\xeb\xf5\xc0\x19\xfe\xf4\x4f\xd9\x28\xc1\xca\x31\x7d\xdf\x51\x83\x53\xb2\x74\xb5\xf9\x6f\xb7\xb1\xa8\x68\xc7\xb5\x17\xa0\x86\xc0\xca\x92\x3e\x3e\xce\xba\xbc\x4e\xa8\x2e\x67\x5c\xe4\x08\xdc\xd0\x6c\xb0\x53\xe6\x81\x9b\x8d\x6c\x0c\x30\xa8\x1a\x83\xbb\x98\xf6\xaa\x46\x1b\x58\x22\xe4\x1b\x1a\x03\x05\x18\x9a\x34\x08\xf7\xac\x6c\x10\xf6\xb2\x81\x07\x26\x0c\x79\x5b\xe2\x4a\x2a\x04\x26\xf6\xf0\xa1\x46\x6a\xdf\x65\xa9\x13\xff\x9e\xa9\xe7\x88\xa7\x10\xfc\x3e\x18\x05\x6d\x14\x9f\xde\x3a\x24\xaa\xf0\xfd\x65\xc4\x68\xe3\x69\x6b\xf6\x16\x76\x92\x42\x6a\x2f\x97\x64\x3b\xcc\xc3\xe0\x76\x3a\x78\x3f\x1e\xd0\xd5\x91\xaf\xc5\x62\x8b\x7b\x0d\x29\x7c\x98\x04\x31\xcd\x9e\xd7\x7a\x6b\xc5\xb6\x48\x93\x1c\x95\x72\xed\xe3\x15\xf7\x17\xd1\x4e\x21\x80\xbf\x3e\x73\xcf\x3f\x7f\x62\xaf\x77\xaa\x01\x90\xa2\xdc\xc3\x86\xb9\xe4\xea\xbd\x30\x6c\x47\x97\xb2\xaf\xd3\xec\x7d\xf6\xe9\x16\x64\x8d\x8a\x19\xca\xb1\x28\x00\x77\x35\xe6\xc6\xc2\xad\x1f\xf7\x23\xc9\x0a\x82\x3a\x0e\xa5\x6e\x49\x85\x21\xef\x79\x81\x45\xe2\x7b\x67\x57\x3b\x55\xee\x3b\x52\xcf\xe9\xe7\x55\x0c\xee\x21\x19\x33\x93\x6f\x66\xed\xaf\x1a\xfa\x5a\xff\x53\x57\x6a\xd7\xcf\xc7\x38\xa9\xb8\xbd\x66\xa8\x65\xa3\xf2\xb3\xd9\x4b\x69\x4f\xde\xfe\x12\x9f\x65\xb9\xf5\x40\x1b\x54\x5b\xcf\xc9\xcb\x79\xb9\x6c\xef\xff\x19\x00\x24\x48\x5f\x41\x4c\x0e\x00\x00")
 
 func golangDialectSqlite3TmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -245,12 +249,12 @@ func golangDialectSqlite3Tmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.dialect-sqlite3.tmpl", size: 1353, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.dialect-sqlite3.tmpl", size: 3660, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangFooterTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x94\xc1\x8e\x9b\x30\x10\x86\xcf\xf6\x53\xb8\x37\x58\x6d\x39\x55\x3d\xb4\xca\xa1\xbb\xdb\x43\x0f\x55\xa5\x24\x3d\x57\x06\x26\x59\x14\xc7\xb0\xc6\x6c\x5d\xa1\xbc\x7b\x67\x00\x63\x02\xa4\xab\x1e\x22\xb0\x3d\xf3\xff\xdf\x78\x86\xb4\xad\x28\x0e\x22\xd9\x35\x55\x55\x1a\xbb\x75\xe2\x72\xe1\xf6\x4f\x05\x02\x5f\x6b\x6b\x9a\xcc\x8a\x96\xb3\x3c\x15\x77\x4f\x0f\x9c\x59\x27\xee\xf6\x8e\x5f\x38\x3f\x34\x3a\x13\x91\xc1\xf5\xd6\xc5\xe2\xa7\xae\xe5\x01\xf6\x2e\xca\x30\x22\x2b\xb5\x05\x67\x93\xc7\xfe\x19\x8b\xa8\xe9\x8e\x7f\x51\x76\xfd\xa2\x92\xbd\xbb\x17\x60\x0c\xfd\x4a\x13\x93\xbe\x1d\x76\x3e\x6d\x84\x71\xc9\x11\x6c\x2f\x15\x73\x86\x70\x74\xf0\x6e\x23\x74\xa1\x28\x94\x19\xb0\x8d\xd1\xb4\xec\x72\x38\xbb\x70\xbf\x67\x5d\xa7\x8d\x47\x4b\xc4\x51\x74\x85\xaf\x2f\x6b\x0e\x85\xd6\x08\x83\x67\x9b\x60\xee\xf7\xfa\xd8\x8e\x36\x4f\x93\x1f\x15\xe8\x8e\xf7\xf3\x1c\x76\x49\x4b\xb8\x81\x78\x10\x5b\x25\xde\x42\x5a\xe8\x3c\xaa\xa9\x0f\x85\x3e\xc6\xc3\x93\x74\x43\x36\xba\xfb\xb8\x78\x29\xf1\x58\x9e\xcf\x85\x8d\xb0\xc4\x1b\xa5\x4d\x50\xc7\x82\xf0\x16\x7d\x1e\x5d\x77\x7f\x05\x1d\xe2\x04\x9c\x6a\x59\x12\x97\x4a\xa5\x32\x3b\xfd\xbf\x61\xc8\x7c\xd3\x12\x27\xd6\x48\x7d\x04\x91\x7c\x07\xfb\x5c\xe6\x35\x8d\xec\x35\x07\x86\x24\xbb\xe2\xa8\x25\xe6\x01\x1e\x93\xdd\xab\x34\x62\x18\x5f\xa2\xb9\x6a\x61\x18\xb8\x45\x03\x07\xef\xd9\x94\x91\xc1\x37\xfd\x5a\x9e\x48\x1d\xa1\x70\x0d\x3a\xa7\xf7\xf0\xd6\x7f\x46\x9e\xb1\xc0\x59\x33\x07\x99\x01\xaa\xb6\xed\xfb\x95\x12\xd8\x9c\x7a\xa2\xe5\xd5\xf6\x6e\x4d\x8f\x0d\x9b\x9c\xb3\x1b\x33\xc3\xd9\x38\x09\x5d\x4f\x30\x30\xb4\xaa\xdf\xf1\x16\x76\xdd\x62\x74\x46\x93\x1c\x14\x58\xf8\xa2\xd4\x8d\xcf\x09\x13\x3f\x7e\xb8\x1f\xba\xcf\xd9\x59\x9e\xe0\xab\x31\xd3\x89\xb8\xb6\x7c\x7a\x78\xa3\xaa\x5d\xf6\x0c\x67\x19\x85\x6a\x7c\x99\x2f\x6a\x5e\xa8\xd7\xcc\xd3\x55\xcd\xd1\x0a\x55\x7f\x1b\x59\x61\xdb\xc3\xbf\x52\x1c\x8a\xff\x27\xf5\xdf\x00\x00\x00\xff\xff\x20\xf7\x92\xb8\x34\x05\x00\x00")
+var _golangFooterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x95\x4f\x8f\x9b\x3c\x10\xc6\xcf\xf6\xa7\x98\xf7\x06\xab\xbc\x9c\xaa\x1e\x5a\xed\xa1\xbb\xe9\xa1\x87\x55\xab\x24\x3d\x55\x55\x64\x60\x48\xac\x10\x9b\x35\xc3\xd6\x15\xf2\x77\xaf\xcc\xff\x04\x92\xaa\x87\x88\x64\x98\x99\xe7\x37\x9e\x07\x52\xd7\x20\x33\x88\xb6\x55\x51\x68\x43\x1b\x0b\xce\x71\xfa\x5d\x20\x6c\x2c\x94\x64\xaa\x84\xa0\xe6\x2c\x8d\xe1\x61\xfd\xc4\x19\x59\x78\xd8\x59\xee\x38\xcf\x2a\x95\x40\x60\x2c\x3c\x6c\x6c\x08\xdf\x55\x29\x32\xdc\xd9\x20\x21\x0b\x89\x56\x84\x96\xa2\xe7\xf6\x1a\x42\x50\x35\xb7\xf7\xbe\xba\x7c\xcd\xa3\x9d\x5d\x01\x1a\xe3\x3f\xda\x84\xbe\x3f\x75\x91\x0f\x8f\x60\x6c\x74\x40\x6a\x5b\x85\x9c\xc9\xac\xb9\xf1\xdf\x23\x28\x99\xfb\x54\x66\x90\x2a\xa3\xfc\xcf\xa6\x86\x33\xc7\xfb\x18\xd9\xa6\xb7\x92\xf9\x1c\x71\x68\xba\xc0\xe7\xc1\xe6\x50\x32\xf3\x30\x64\xe1\x71\x14\xef\x63\x6d\x6e\x43\x9b\xc6\xd1\xd7\x02\x55\xc3\xfb\xf1\x1a\x76\x4e\xcb\xdc\x94\xb8\x6b\xb6\x48\xbc\xc1\x58\xaa\x34\x28\xfd\x1e\xa4\x3a\x84\xdd\x15\xea\x69\x75\x1a\x47\x7d\x5e\x38\x6f\xf1\xac\xcf\x67\x49\x41\x08\xc1\x8d\xd1\x26\xa8\xc3\x40\x64\xa3\xbe\xce\x1f\x77\x93\xd7\xa4\x4d\xc1\xfd\xc9\xcf\x89\x75\x9e\xc7\x22\x39\xfd\xbb\xe0\x58\xf9\x57\xc9\xba\x06\x23\xd4\x01\x21\x7a\x41\x3a\xea\xb4\xf4\x96\xad\x6b\x88\xd6\x3a\x01\xe7\x2e\x89\x7c\x7c\x2b\x0f\x4a\x50\x65\x10\x9c\xf3\x2c\x6f\xc2\x40\x67\x64\x6f\xaf\x8b\x65\x8e\xd6\x9b\xad\xb2\xa3\x98\x12\x91\x8d\xbc\xc0\x17\xf5\xa6\x4f\xbe\x3b\x6f\x40\x50\xa5\xe0\xa6\xdf\xda\x07\xaa\xa7\x95\x8a\xd0\x64\x22\x41\xa8\x79\x5d\xff\xbf\x30\x0c\x1b\xa7\xb9\xe6\x9f\x74\xed\xfb\xee\xec\x52\x67\xd6\x05\x39\x67\x37\x7c\xc4\xd9\xe0\x8e\x66\x4f\x9c\x4d\xd6\xd7\x46\x7a\x09\x5a\x96\x18\x94\x39\x67\x29\xe6\x48\xf8\x29\xcf\x6f\x3c\x62\x52\xd1\xfb\x77\xab\xce\x11\x9c\x9d\xc5\x09\x3f\x1b\x33\x75\xc9\xa5\xe4\xfa\x69\x51\x72\x14\xdc\x26\x47\x3c\x8b\x60\x32\x8d\x41\x41\xd8\x85\x17\x20\x56\x20\xb3\xbd\xd2\xb4\x47\x2b\x4b\x2a\x21\xd6\x3a\xef\x55\xd9\xda\xe8\xe2\x76\xe9\x90\xf6\x7c\xc4\xe4\x74\x2f\x2f\xf8\xf1\xb3\xbd\xbd\x96\x59\x86\x06\x55\x82\xe3\xd0\x2f\xf2\x60\x04\xe1\x5d\x85\x7e\x59\xaf\xf9\xf5\xba\xfa\x93\x49\xe3\xc5\x93\x19\x0e\x8c\x73\xf6\xcb\x88\x62\xf9\x65\xb7\x82\xf1\x25\x1c\x8e\x7b\xbd\xb3\x10\x6f\xd1\xc9\x3f\xc4\x37\x83\x85\x30\xd8\x38\x90\x25\xb9\x2e\x71\x4b\x67\x2a\x07\xcb\xf8\x74\x54\x29\x38\xc7\x1d\xff\x33\x00\xbf\x64\xa0\xa9\x5d\x06\x00\x00")
 
 func golangFooterTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -265,12 +269,12 @@ func golangFooterTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.footer.tmpl", size: 1332, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.footer.tmpl", size: 1629, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\xc1\x6e\xdb\x3a\x10\x3c\x4b\x5f\xb1\x31\x7c\x90\x00\x85\x1f\x90\x07\x1f\x82\x87\x1e\x7a\x31\xd0\xf8\x58\x14\x02\x2d\x2e\x5d\x36\x34\xe9\x90\x94\xed\x40\xf0\xbf\x77\x97\x92\x05\x1b\x48\x1b\xf4\x60\xc0\xdc\x1d\xcd\xcc\x0e\x97\xc3\xf0\x08\x0a\xb5\x71\x08\x8b\x68\x76\x4e\xa6\x3e\xe0\x02\x1e\x2f\x97\xf2\xd9\xda\x76\x18\x40\x6c\x7a\xad\xcd\x19\x2e\x97\x8a\x4e\x5d\x3a\x1f\x64\x90\x7b\x10\xd4\x7e\x0e\xbb\x48\xf5\x1a\xaa\xb2\x08\xfe\x14\x81\x00\xd1\x9a\x0e\xbd\x06\xf1\xe2\x4f\xd4\x6b\x00\x43\xe0\x9f\x0f\x75\xc9\x62\xe8\x54\x66\x2f\x6f\x95\x8d\x3b\xfa\xd7\x4f\x64\x65\xd8\xdd\x89\xfe\x99\x6d\xeb\xd5\xfb\x82\x20\x65\x41\x5f\xe2\x7e\x8b\xea\x60\x65\x87\x3f\xbd\x55\x18\x22\x88\xaf\x4e\xfb\xbb\x76\x7c\xb3\x53\x75\xd1\xb6\xb9\xd2\xc6\xb4\x4f\x99\xa3\x2c\x8e\x32\x40\xdb\x1e\xa5\xed\x31\xc2\xf7\x1f\xc6\x25\x0c\x9a\xf8\x06\x62\x98\xeb\x2b\x90\x87\x03\xb9\xa9\xae\x95\x86\xc3\xd0\x06\xad\xca\x67\x9a\x27\xc9\x64\xba\xd9\x7d\x56\x0f\xd2\xed\x10\x96\xa6\x81\x25\x8f\xf7\xb4\x02\xb1\xee\xad\x95\x5b\x8b\x13\xb0\x2c\x8c\x86\x07\x82\x32\x40\xac\xe5\x1e\xa9\x28\x4c\x74\x04\xab\x6a\x18\xca\x82\x3c\x74\xde\x29\xce\x6c\x69\xb8\xc9\x0c\xe4\x47\x4b\x1b\x31\xb7\xff\x6a\xf1\x8e\x37\xd7\xab\xba\x2e\x8b\x29\x1d\x8a\xf7\x26\x03\x0e\x85\x68\xe8\xcf\x9b\xdd\xf6\x4e\x59\x6c\x5f\x08\x82\xa1\xf2\xdb\x5f\x42\x19\x69\xb1\x4b\x0d\xdc\x66\x48\x54\xdc\xb3\x7e\xb7\xa1\x53\x35\x72\x34\x73\x9e\x42\x08\x8e\xa2\x6d\x79\x7d\xc6\x5d\xa1\x10\x32\x5b\x30\x47\x0c\xe2\x5b\x8f\xe1\xfd\xe3\xcf\x38\x19\xfe\xe0\x61\x05\xce\xd8\x1c\x45\x40\x5a\x5f\xc7\xc7\x26\x93\xec\xe5\x2b\x7e\x09\xa1\x22\xd8\x38\x13\xad\x08\xf2\x24\x2c\x27\xfe\xb7\x3e\xd2\xb4\xa4\xaf\xfd\x5c\x5c\xe3\x39\x4d\xc1\x52\x00\xc6\x99\xe4\xf0\x74\xdd\x66\x2a\xb2\xe2\xea\x0a\xde\x74\xd2\xf1\x7a\x4a\xa5\x02\xed\x7c\xa5\xad\x4c\x09\x5d\x86\xd7\xf9\x9a\x8b\x0f\x5c\x7e\x62\x93\x7d\x8e\xcf\x69\xbe\xb1\x31\x1d\x16\xe2\x47\x30\x7a\x19\x07\x9a\xd8\x9f\x66\x4b\xcc\x53\xff\xf7\xcf\xc1\x4c\x80\x51\x88\x60\xe5\x74\xfb\xfc\xb8\x7e\x07\x00\x00\xff\xff\x8d\x17\xe9\xb7\x24\x04\x00\x00")
+var _golangGetAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6a\x23\x39\x10\x3d\x4b\x5f\x51\x69\xb2\xd0\x82\x8e\x4e\xcb\x1e\xbc\xf8\x10\xc2\x1e\x96\x61\x02\x13\xcf\x6d\x18\x1a\xb9\x55\x72\x34\x91\x25\x47\xad\x76\x1c\x84\xfe\x7d\x28\xb5\xd3\x24\x4c\x86\x30\x07\x83\x55\x55\x7a\xef\xd5\x53\x55\xe7\x7c\x05\x1a\x8d\xf5\x08\xcd\x68\x77\x5e\xa5\x29\x62\x03\x57\xa5\xf0\x6b\xe7\xfa\x9c\x41\x6e\x26\x63\xec\x09\x4a\x69\x73\x86\x21\x9d\x0e\x2a\xaa\x3d\xc8\x6b\xe7\xae\xe3\x6e\x84\x52\x04\xb4\x9c\xc5\xf0\x34\x42\xce\x30\x3a\x3b\x60\x30\x20\xef\xc2\x13\x94\xd2\x01\xc6\x48\xbf\x10\x05\x27\x32\xf4\xba\xa2\xf3\xd7\xcc\xd6\x1f\xc3\xc3\x07\xb4\x2a\xee\xde\x90\xfe\x1e\x6d\x1b\xf4\x73\x03\xa5\x70\x96\x33\xe0\x7e\x8b\xfa\xe0\xd4\x80\xf7\xc1\x69\x8c\x23\xc8\xff\xbd\x09\x6f\xd2\xe3\xa3\x3b\x47\x9b\xbe\xaf\x91\x7e\x4c\xfb\x54\x31\x38\x3b\xaa\x08\x7d\x7f\x54\x6e\xc2\x11\xbe\x7d\xb7\x3e\x61\x34\x6a\xc0\x5c\x38\x5b\xe2\x6b\x50\x87\x03\x7a\xdd\xbe\x44\x3a\x32\xc3\x58\x74\xba\x9e\x41\x6e\x92\x4a\x76\x58\xd4\x57\x71\x51\xf9\x1d\xc2\xa5\xed\xe0\x92\xda\x5b\xad\x41\xde\x4e\xce\xa9\xad\xc3\x73\x21\x67\xd6\xc0\x45\xce\xb5\x40\xde\xaa\x3d\x42\x29\xd2\x8e\x7e\x72\xae\x15\x90\x39\x63\x7d\x3f\x04\xaf\xc9\xb3\x4b\x4b\x49\x42\x80\x35\x18\xe5\x46\xe4\xec\x23\x89\x6f\x70\xab\xd4\x56\x08\xce\xce\xee\x78\xfd\xda\x03\x32\x05\xd6\xd0\xf7\xe3\xa3\xdb\x4e\x5e\x3b\xec\xef\xd0\x6b\x8c\x6d\xd8\xfe\x90\xda\x2a\x87\x43\xea\xe0\xb5\x87\x82\x33\xca\xb9\xb0\xdb\xa4\x7d\x6a\x67\x8c\x6e\xf1\x53\x4a\x29\xc8\x45\x1d\x3c\xc2\x6a\x0d\x54\xab\xb7\xf2\x3e\x84\x87\x2f\x13\xc6\xe7\x76\x48\xa7\x0e\xea\x5f\x7a\x1f\x6a\xf7\x33\xa6\xfb\xa0\x57\x00\x00\xcd\x2f\xb3\xd2\x74\x9c\xb1\x4f\xd6\xcf\x79\x98\x6f\xd2\xb9\xbf\x43\xa5\x29\xf9\x95\xcc\x9d\xb3\x39\xc3\x21\x5a\x9f\x0c\x34\x7f\x3d\x36\x20\x6b\x8a\x66\x96\x33\x46\xaf\x85\x7b\xf4\x69\x75\xee\xbb\xe3\xac\x08\xce\x34\x1a\x8c\x60\x26\x3f\x90\xfb\x30\x2b\x6f\xad\x4f\xff\xfc\xdd\x3a\xf4\x2d\xad\x81\x10\x75\xea\x05\x94\x56\x70\xea\x8e\x82\x35\xb4\xb4\x18\xed\x11\xa3\xac\xea\x6e\x82\x4f\x78\x4a\x73\xa7\xef\xfb\x63\x4d\xbd\x7c\xb1\x06\x6f\x5d\x7d\xf3\x88\x69\x8a\x9e\x8e\x5d\xf5\x6c\xaf\x1e\xf0\xbf\x18\x5b\xa2\xad\x8f\x37\xeb\x9c\xa9\xe5\x8d\x0b\x23\x56\x2d\x26\x2c\xc1\x5b\x22\x9d\x27\x28\x67\xb0\xde\x26\x8f\x4f\x2f\x6b\xcb\x19\x23\xc6\xf5\x4b\xf1\x66\x50\x9e\xf6\x50\x69\x1d\x83\x81\xd6\x38\x95\x12\xfa\x5a\x2e\xea\x3c\xb3\x77\x54\x7e\x20\x93\x74\xce\xdf\x8d\x65\x34\xe9\x54\x37\x87\xd6\xe1\xac\x65\x9e\xc6\x33\xfa\x6a\x91\x44\x38\xe2\xdf\x3f\x36\xe6\x5c\x40\x10\x1d\x95\xf1\x9c\x01\xbd\x86\xab\x52\xf8\xcf\x01\x00\x51\x72\x07\x2f\x0d\x05\x00\x00")
 
 func golangGetAllTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -285,12 +289,12 @@ func golangGetAllTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-all.tmpl", size: 1060, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-all.tmpl", size: 1293, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetCountTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x92\xcf\x8a\xdc\x30\x0c\xc6\xcf\xf1\x53\x68\x87\xa1\x38\x90\x35\x3d\x94\x1e\x0a\x73\x58\x4a\x0f\xbd\x2c\x74\xe7\x58\x4a\x70\x62\x25\x75\xd7\xb1\x67\x65\x67\xba\x4b\x98\x77\xaf\xec\x66\x87\x19\xe8\x9f\x43\x20\x96\x3e\xfd\x24\x7d\x68\x59\x6e\xc1\xe0\x60\x3d\xc2\x26\xda\xd1\xeb\x34\x13\x6e\xe0\xf6\x74\x12\x1f\xc3\xec\x53\xbb\x2c\xa0\xf6\xf3\x30\xd8\x67\x38\x9d\x24\xbf\xfa\xf4\x7c\xd0\xa4\x27\x50\x77\xce\xdd\xd1\x18\x39\x5e\x83\x14\x55\x9f\xf5\x60\x7d\x7a\xff\xae\x01\x24\xca\x5f\xa0\x5a\xe4\x16\xe8\x4d\x61\x8a\xcb\x7e\xd6\x1f\xc3\xe3\x7f\x9b\x69\x1a\xaf\x5a\xfd\x9d\xd7\x05\xf3\xb2\x61\x89\xa8\xb8\x12\xa7\x0e\xcd\xc1\xe9\x1e\xbf\x07\x67\x90\x22\xa8\xcf\x7e\x08\x57\xe9\xf8\xe4\xd6\xe8\xa6\x6d\x4b\xa4\x8d\x69\x4a\x85\x21\xaa\xa3\x26\x68\xdb\xa3\x76\x33\x46\xf8\xfa\x8d\x17\x43\x1a\x98\xb7\x30\xe1\x1c\xdf\x81\x3e\x1c\x78\x1a\xf9\x1a\x69\x80\xe9\x83\x45\x67\xca\x9b\xf7\x49\x3a\xd9\xfe\x3c\x7d\xe9\x4e\xda\x8f\x08\x5b\xdb\xc0\x36\xaf\xf7\x61\x07\xea\x7e\x76\x4e\x77\x0e\x57\xa1\xa8\xec\x00\x37\x2c\xcd\x02\x75\xaf\x27\xe4\xa0\xb2\xd1\xb3\x4c\xd6\xb0\x88\x8a\x67\xe8\x83\x37\xd9\xb3\xad\xcd\xc9\x4c\xe0\x79\x06\xed\x22\x96\xf4\x3f\x47\xbc\xe2\x96\xb8\xac\x6b\x51\xad\xee\xb0\xbd\x17\x1e\x64\x53\x18\xc3\x3f\x4f\xae\x9b\xbd\x71\xd8\x3e\xb0\x04\x49\x86\xee\x87\x32\x56\x3b\xec\x53\x03\x97\x1e\x32\x2a\xe7\x5c\x18\xf7\xfc\x92\xbf\x19\xcd\xd9\x4f\xa5\x54\xb6\x22\x5f\xc9\x0e\x0a\x84\xec\x11\x49\x7d\x99\x91\x5e\x1e\xc2\xcf\x3f\x16\xa8\x7d\xaf\xbd\x7c\x53\xee\xac\x2e\x06\xe5\xfa\x9b\x1d\x78\xeb\x8a\x23\x84\x7c\xbd\x1e\xde\x36\x05\x39\xe9\x47\xfc\x44\x24\x59\x54\x16\x13\xaf\xf9\x02\x68\x72\x95\x58\x77\xcd\xa7\xf4\x2b\x00\x00\xff\xff\x6b\x43\x21\x7d\x0a\x03\x00\x00")
+var _golangGetCountTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x41\x6b\xdc\x3c\x10\x3d\x4b\xbf\xe2\xc5\xe4\xfb\xb0\xc1\x11\xdf\x07\xa5\x87\x85\x3d\x84\xd0\x43\x29\x0d\x34\xdb\x5b\x29\x46\x6b\x8d\x37\x6a\x64\x69\x23\xcb\xdb\x0d\x42\xff\xbd\x48\xeb\x84\x0d\xa4\xe4\x60\xb0\x66\x9e\xde\xcc\x7b\x33\x8a\xf1\x0a\x8a\x06\x6d\x09\xd5\xa4\x77\x56\x86\xd9\x53\x85\xab\x94\xf8\x8d\x9b\x6d\xe8\x62\x84\xd8\xcc\xc3\xa0\x8f\x48\xa9\x8e\x11\x7d\x38\xee\xa5\x97\x23\xc4\xb5\x31\xd7\x7e\x37\x21\xa5\x06\x35\x67\x7d\xc6\x43\xdb\xf0\xf1\x43\x0b\xf2\x3e\x7f\xce\x37\x3c\x97\x20\xab\x0a\x27\x3f\xaf\xa7\xed\xc1\x3d\xbc\x5b\x4c\xfa\xdd\xab\x52\x7f\xe7\xdb\x3a\xf5\x54\x21\x25\xce\x62\x04\x8d\x5b\x52\x7b\x23\x7b\xba\x77\x46\x91\x9f\x20\x3e\xdb\xc1\xbd\x4a\x4f\x8f\x66\x89\x56\x5d\x57\x22\xdd\x14\xc6\x50\x38\x38\x3b\x48\x8f\xae\x3b\x48\x33\xd3\x84\x1f\x3f\xb5\x0d\xe4\x07\xd9\x53\x4c\x9c\xbd\xc4\xd7\x90\xfb\x3d\x59\x55\x3f\x47\x5a\xc4\x88\x41\x93\x51\xe5\x0c\xb1\x09\x32\xe8\xfe\xa5\xfb\xd2\x9c\x97\x76\x47\xb8\xd4\x2d\x2e\xb3\xbc\xd5\x1a\xe2\x76\x36\x46\x6e\x0d\x2d\x40\xce\xf4\x80\x8b\x18\x0b\x40\xdc\xca\x91\x90\x92\xd0\x93\x9d\x8d\xa9\x1b\x44\xce\x58\xd7\xf5\xce\xaa\xec\xd9\xa5\xce\xc9\xcc\x80\x35\x06\x69\x26\xe2\xec\xbd\x16\x5f\xf1\x96\x56\xeb\xa6\xe1\x6c\x71\xc7\xaa\x73\x0f\xb2\x29\x58\xa3\xeb\xa6\x47\xb3\x9d\xad\x32\xd4\xdd\x91\x55\xe4\x6b\xb7\xfd\x25\x94\x96\x86\xfa\xd0\xe2\xdc\xc3\x86\xb3\x9c\x33\x6e\xb7\x09\x63\xa8\x4f\x1c\xed\x8b\x9f\x42\x88\x26\xbb\xa8\x9c\x25\xac\xd6\xc8\x58\xb5\x15\xf7\xce\x3d\x7c\x9b\xc9\x3f\xd5\x7d\x38\xb6\x28\xbf\x79\x3e\x59\xee\x57\x0a\xf7\x4e\xad\x00\xa0\x7a\x63\x5b\xaa\x96\x33\xf6\x45\xdb\x13\x02\xa7\xbb\xf9\xdc\xdd\x91\x54\x39\xf9\x3d\xdb\x7b\xca\xc6\x88\xbd\xd7\x36\x0c\xa8\xfe\x79\xac\x20\x4a\x0a\x29\x65\x58\x9e\x17\x8d\x64\xc3\x6a\x51\xde\x72\x96\x9a\x67\x2b\xce\x96\x9c\x33\x45\x03\x79\x0c\xb3\xed\xf3\x48\x70\x92\x53\x2f\xa0\xf2\x06\x1a\xa4\xba\xe1\x9c\xe5\xe7\xb0\xa8\xf4\xfa\x40\x5e\x94\xf6\xee\xdc\xef\x1b\x67\x03\x1d\xc3\x49\xef\x5b\x2e\x89\x4d\x2f\x6d\xfd\x6f\xa1\x6c\xca\x56\x64\xae\x8b\x35\xac\x36\x65\x0d\x3c\x85\xd9\x5b\xfc\xd7\x16\xfa\x51\x3e\xd0\x27\xef\xeb\x5c\x3b\x4f\x33\x9b\x5c\xee\x62\x8d\xff\xf9\x33\x78\x69\xd0\x6a\xc3\x63\x04\x59\x85\xab\x94\xf8\x9f\x01\x00\x80\x6e\xe7\x37\x0c\x04\x00\x00")
 
 func golangGetCountTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -305,12 +309,32 @@ func golangGetCountTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-count.tmpl", size: 778, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-count.tmpl", size: 1036, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetFirstTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x53\xc1\x8e\xda\x30\x10\x3d\x27\x5f\x31\x8b\x38\x24\x52\xd6\x1f\xb0\x15\x87\x55\xd5\x4a\xbd\x20\x75\x39\x56\x55\x64\xe2\x09\x75\xd7\xd8\xec\xd8\x61\x59\x45\xfc\x7b\x67\x4c\x40\x50\xb5\x5d\xa9\x07\x10\x7e\xf3\xfc\xde\xcc\x63\x3c\x8e\xf7\x60\xb0\xb7\x1e\x61\x16\xed\xc6\xeb\x34\x10\xce\xe0\xfe\x78\x2c\x3f\x5b\x8a\xa9\x1d\x47\x50\xab\xa1\xef\xed\x01\x8e\xc7\x8a\x4f\x5d\x3a\xec\x34\xe9\x2d\xa8\x47\xe7\x1e\x69\x13\x19\xaf\xa1\x2a\x0b\xae\x4d\x85\xa7\xf0\xca\x60\x03\x48\x24\x9f\x40\x75\x29\x3e\xe8\x4d\x16\x2e\xaf\x4d\xad\xdf\x87\xe7\x77\x1d\x35\x6d\x6e\xfc\xfe\xae\xb7\x0e\xe6\x6d\xc6\x94\xdc\x0f\x6e\xd7\x68\x76\x4e\x77\xf8\x23\x38\x83\x14\x41\x7d\xf1\x7d\xb8\x29\xc7\x17\x37\xa1\xb3\xb6\xcd\x48\x1b\xd3\x36\x65\x8d\xb2\xd8\x6b\x82\xb6\xdd\x6b\x37\x60\x84\x6f\xdf\xad\x4f\x48\x3d\xeb\x8d\xac\x70\xc1\x17\xa0\x77\x3b\xee\xa6\x3a\x23\x0d\xb0\x7a\x6f\xd1\x99\x7c\xe6\x79\x92\x4e\xb6\xbb\x74\x9f\xdd\x49\xfb\x0d\xc2\xdc\x36\x30\x97\xf1\x1e\x16\xa0\x96\x83\x73\x7a\xed\x70\x22\x96\x85\xed\xe1\x8e\xa9\x42\x50\x4b\xbd\x45\x06\x95\x8d\x9e\x69\x55\x0d\x63\x59\x70\x0f\x5d\xf0\x46\x32\x9b\x5b\x29\x8a\x02\xf7\xd3\x6b\x17\x31\x97\xff\xd9\xe2\x8d\x6e\xc6\xab\xba\x2e\x8b\x29\x1d\x8e\xf7\x2a\x03\x09\x85\x65\xf8\xc7\x8b\x5b\x0f\xde\x38\x6c\x9f\x98\x82\x54\x85\xf5\x4f\x65\xac\x76\xd8\xa5\x06\xae\x33\x64\x29\xa9\xb9\xb0\x59\xf1\xa9\x3a\x69\x34\x97\x3c\x95\x52\x12\x45\xdb\x52\x78\x8d\xa7\x6d\xe1\x10\xb2\x1a\xd9\x3d\x92\xfa\x3a\x20\xbd\xfd\xf9\x9a\x24\x23\x17\xee\x16\xe0\xad\xcb\x51\x10\xf2\xee\x7a\x39\x36\x59\x64\xab\x9f\xf1\x13\x51\xc5\xb4\xd3\x4c\xbc\x22\x28\x93\x88\x9d\xfa\xe8\x42\xe4\x69\xcb\x53\xc6\x13\xb8\xc4\x43\x9a\x82\x9d\xf4\x1f\x16\xe7\x0b\x22\x55\x7f\xf8\xdd\xf4\x1d\x57\xb1\xbd\xa1\xf0\x97\xf4\x92\x03\xb6\xde\xa6\xf3\x63\x29\x0b\x11\xbe\x98\xad\x3a\xed\x65\xf5\xb5\x31\x14\x7a\xa8\x7a\xa7\x53\x42\x9f\xd9\x75\x5e\xa1\xff\x08\xa0\x3c\x33\x44\x58\x1e\xd4\xf9\x9d\x4a\x53\xd3\x1f\x2e\xef\xe9\x57\x00\x00\x00\xff\xff\x82\xd1\x12\x31\x14\x04\x00\x00")
+var _golangGetDynamicTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x6d\x6f\xdb\x36\x10\xfe\x2c\xfd\x8a\xab\x90\x01\xd2\xa6\xa8\x1d\x30\xec\x83\x07\xb7\x68\x9b\x14\xcb\x96\x26\x6b\x1c\x6c\x18\x8a\x42\xa0\xc5\x53\xcc\x85\x22\x5d\x92\xb2\xe3\x09\xfa\xef\xc3\x91\x92\x5f\xda\xa0\x69\xd0\x7d\x08\x22\x1f\xef\xe5\xb9\xbb\x87\x77\xec\xba\x63\xe0\x58\x0b\x85\x90\xb8\xcd\x12\x6d\x02\x7d\x1f\xd3\x17\x74\x1d\x14\x17\xac\x41\xe8\x7b\xb0\xce\xb4\x95\x83\x2e\x8e\xc8\xc0\x30\x75\x83\x50\xbc\x11\x28\xb9\x25\xfd\x68\x5f\xb7\xeb\x40\xd4\x50\xfc\x21\x99\x50\xd0\xf7\xdf\x77\x1d\xa0\xe2\xd0\xf7\xa4\x74\x4d\x9e\x83\xc5\xf1\x20\x8e\xe3\xe8\xe9\x53\x98\x69\xe3\x40\x1b\x8e\xc6\x82\x5b\x20\x18\xbd\xb6\x30\xdf\x00\xb2\x6a\x01\x96\x0e\x85\x02\xd7\x1a\x55\xc0\xf5\x02\x81\x63\x25\x99\x41\x1e\x4c\x40\x58\x68\x2d\x72\xef\x69\xbd\x40\x05\xc2\x91\x0c\x9b\xa5\xdb\x14\x71\xe4\x9d\xbf\xff\x40\x00\xfc\xe7\x18\xf4\x5c\x34\x41\x91\x22\x36\xec\x4e\x34\x6d\x03\xaa\x6d\xe6\x68\x40\xd7\x01\x83\x41\x8a\x8a\x1c\x58\xed\xd0\x80\xbd\x15\xcb\xa5\x50\x37\x70\x59\xd7\x16\x9d\x8f\x48\x7a\x05\xbc\x84\x7f\xd1\xe8\xc1\x67\xb0\xb2\x80\x2b\x34\x1b\x30\xd8\x30\xa1\xc8\xca\xe8\xb5\x4f\x60\x03\x0b\xb6\x42\x9f\x29\x15\xdb\x7a\x3f\xba\xf6\x02\xe9\x3d\x30\xc5\x41\xfb\x18\xc0\xcc\x4d\xdb\xa0\x72\x76\xd4\xf0\x31\x90\x97\x60\x90\x71\x5b\xc4\x91\x17\x00\x08\xe5\xe2\x28\x00\xa3\xef\x9f\x7f\x8a\xfb\x78\xd7\xcc\x21\x75\x3a\x89\xe3\x4a\x2b\xeb\x20\xdd\x6f\xe8\x91\xc8\xe1\x48\x51\xc7\x27\xd3\xa0\xbd\x6d\x6e\x10\xfb\x16\x8a\x1a\x94\x76\x70\x24\x86\x5e\x1f\x6d\xfd\x4e\x41\x68\xc7\xe0\x07\xf8\x71\xdb\xf2\x83\x3e\x67\xf1\x20\x3f\xa6\xfa\xef\x33\xcf\x8a\x1b\xc5\x5c\x6b\x30\xf1\x67\x27\x1b\xc5\x1a\x51\x95\x1e\x74\x5b\xd7\xe2\x0e\xfa\x3e\xad\xdc\x1d\x54\x5a\x39\xbc\x73\xc5\xeb\xf0\x3f\x8f\x23\xbd\x74\xd6\x53\xf5\x72\xe9\x84\x56\x76\xa4\x61\x46\xb9\x51\x63\xe8\xd0\x4a\x51\xa1\xae\xa1\xb8\xd2\x6b\xe8\xfb\x1c\xd0\x18\xfa\xd3\x86\x30\x1d\xdf\x0f\x4a\xa8\x95\xbe\x7d\x08\x51\x0e\x04\xe0\x0b\x5e\xe6\x9a\x6f\x92\xb1\x8c\xd8\xcc\x91\x2f\x25\xab\x70\xa1\xa5\xa7\x7a\x71\xa6\x6a\x7d\x70\x6c\x3f\xca\x41\x9a\x94\xa5\x97\x94\xd6\x35\xce\xfb\x88\xa3\x15\x33\x50\x96\x2b\x26\x5b\xb4\xf0\xfe\x83\x50\x0e\x4d\xcd\x2a\xec\x88\xd1\x65\x59\x0b\xe9\xc8\xed\x64\x0a\x65\x69\x3f\xca\x79\xab\xb8\xc4\xf2\x5c\x38\x34\x4c\xda\xee\x37\x2d\xd4\x04\x12\x78\x79\x71\x02\x49\x08\xba\xbd\xcc\xc1\x72\xec\x19\x5d\xe1\xd7\x0b\xac\x6e\x29\x6e\x24\x6a\x2a\xe3\x56\x40\x83\x60\xa7\xa5\x15\x17\x54\x7b\xaf\xf9\xa5\x34\x8f\xbd\x82\xa8\xe1\x09\x39\x3b\xb3\x17\xad\x94\xa3\x37\xb2\x3b\x70\x55\xf8\xd3\x29\xd4\x4c\x5a\x8c\xa3\x28\xda\xa6\x3d\x05\xb6\x5c\xa2\xe2\xe9\x28\xc9\x3d\xb8\x3f\x43\x51\xfa\x3e\x8b\xa3\xa8\x1f\x00\xa2\xb4\xc4\x86\xf8\x91\xe6\x63\x3b\x07\xcb\xa1\xac\xc5\xec\xdd\xf9\x81\xfd\xbe\x3c\x78\xa1\xc6\x15\xa7\x77\x4b\x83\xd6\x86\x92\x64\x71\xd4\xc7\x87\x58\xbe\xd9\xe1\x3e\xbc\xbd\xbb\x26\x6a\x90\xa8\x3e\x71\x93\xc1\x73\x78\xe6\x1b\x56\x96\xeb\x05\x1a\x24\x29\x3c\xc0\x8f\x24\x07\x82\x30\x81\xf7\x1f\xf6\xf5\x66\xef\xce\xc9\x51\x74\x8f\x6d\x9a\xfc\xf5\xeb\xe9\xd5\x69\x92\xe5\xb0\x8d\x4f\xf0\xfa\x90\xf6\x57\xc5\x4f\x93\xc4\x57\x8b\x98\x1c\xe6\xfa\x03\x3c\xce\x3d\x89\x6b\x6d\xa0\xa4\xb0\x7e\x4d\x4c\xa6\x03\xa5\xe9\x62\x86\xd9\x44\xc1\xed\x5a\xb8\x6a\x31\x2a\x8d\xfc\x1d\xc8\xbf\x9b\x75\x51\xc5\xec\xc1\xea\x9b\x84\x7c\x3d\x9c\xcf\xda\xb5\x93\xe6\xf7\xe6\xd3\x75\xb0\x34\x42\xb9\x1a\x92\xef\x3e\x26\x40\xf6\x44\xb0\xcf\x18\xc6\xb1\x66\xad\x74\x3e\x56\xd8\x1c\xa0\x84\xcc\x41\xa8\x15\x93\x82\x13\xbc\x34\x39\x98\x3d\xc9\x40\xf2\xfd\xae\xef\xd0\x64\x30\x9d\x6e\x9b\xfe\xff\x40\x3f\x09\x10\x2f\xc9\xd9\xab\xcd\x90\x45\xbf\xed\x54\x39\xdf\x7c\x3b\xaf\xee\x31\x4d\x93\xcb\xab\x93\xd3\x2b\x78\xf5\x77\x60\x96\x87\xdd\xd3\xb0\x1b\x1a\xda\xc5\xa1\x65\xbe\xdb\x61\x0d\x3e\x87\x67\x54\xc9\xb2\xf4\x8b\xf4\x4b\x74\x3b\x3f\x7b\x7b\x76\x0d\x2f\xe0\xf2\xcd\x9b\xd9\xe9\x35\xbc\x48\xb2\x07\x06\xc5\x2e\xca\xf0\x1d\x96\x6d\xb6\x0f\x62\xd8\xbf\x8f\x45\x41\xed\xbd\xd0\x3e\x83\x6b\x7d\x8b\x74\xd3\x1f\x89\x6b\x8b\x65\x8f\x4e\x5f\x11\x7e\xbc\x73\x61\xb5\xd0\xae\xf9\x44\xf3\x0a\x15\x47\x93\xea\xf9\x3f\x05\x17\x4c\x62\xe5\x72\xd8\x5f\x4d\x59\x1c\xd1\x99\xd4\x37\x33\xd7\xb8\x34\xf8\xc8\xb7\x6b\xaa\x28\x8a\x8c\x78\xc2\xb5\xf2\x2f\x0b\xd2\xe5\xf3\x62\xa1\xf5\xed\xbb\x16\xcd\x26\xac\x51\xff\x49\xc3\x8e\x88\xf0\x16\xdd\x42\xf3\x09\x00\x40\x72\xef\xea\x4d\xf2\x38\x8a\x7e\x17\x2a\xe8\x40\xb0\xa6\xdf\xe5\x15\x32\x4e\x87\xd7\x6c\x2e\x31\x9c\x7e\x4a\x65\x7f\x44\xcf\x80\x38\x8a\x66\x8e\x39\xa4\xb7\xd5\x64\xc8\x3d\x8f\x23\xda\x00\x1c\x6b\x34\x50\xb7\xaa\x4a\x33\xe8\x20\xa0\x4f\xfd\x9b\x2a\xa5\xdb\x46\x2f\x8b\x2c\xf3\x0f\x89\x0c\xfa\x34\xf3\xdb\x97\x84\x5e\xb4\x4d\xd3\x88\x15\x9a\xc2\xa3\x1b\x9e\x2c\x21\xdb\xfb\x6b\x24\x6a\x6f\xfc\x64\x4a\xb7\xdf\xdf\xde\xfd\x61\x40\x0e\x1b\x76\x8b\xa7\xc6\xa4\x14\x96\xba\x36\xe0\x0c\xa1\x8b\xd7\x52\x5b\xf4\x58\xfc\x48\x0c\xc2\x0b\x0a\x9a\x0d\x13\x0f\x84\x12\x4e\xe1\x7a\x7c\x09\xc5\x51\x44\x11\xa7\xa3\xf2\xac\x62\x2a\xed\x3a\x60\x9c\x1b\x5d\x43\x5a\x4b\xe6\x1c\x2a\xaf\x9e\x0d\xbb\xf1\x73\x94\x0f\xc0\x24\x9c\x11\xb9\xdf\x31\x97\x7e\xf9\xfd\xc6\xcc\xcd\x88\x25\x1b\xa7\xd9\x50\xc0\x01\x12\xf9\xc9\x7e\x79\x74\x61\x06\x05\x72\x91\x93\xda\xfe\xdb\xf3\xbf\x01\x00\x45\x0c\x31\xbb\xf5\x0c\x00\x00")
+
+func golangGetDynamicTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_golangGetDynamicTmpl,
+		"golang.get-dynamic.tmpl",
+	)
+}
+
+func golangGetDynamicTmpl() (*asset, error) {
+	bytes, err := golangGetDynamicTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "golang.get-dynamic.tmpl", size: 3317, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _golangGetFirstTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xd1\x6a\xdc\x3a\x10\x7d\x96\xbe\x62\x62\x72\xc1\x06\x47\x70\xe1\x72\x1f\xb6\xf8\x21\x84\x16\x4a\x69\xa0\xd9\xbe\x95\x62\xb4\xd6\x78\xa3\x46\x96\x36\xb2\xbc\xd9\x20\xf4\xef\x65\x64\xef\x36\x5b\x52\x02\x7d\x58\x58\xcd\x8c\xce\x39\x73\x66\xe4\x18\xaf\x40\x61\xaf\x2d\x42\x31\xea\xad\x95\x61\xf2\x58\xc0\x55\x4a\xfc\x83\xf6\x63\x68\x63\x04\xb1\x9e\xfa\x5e\x1f\x20\xa5\x32\x46\xe8\xc2\x61\x27\xbd\x1c\x40\x5c\x1b\x73\xed\xb7\x23\xa4\x54\x41\xc9\x59\x8c\xb0\x24\xee\xdc\x13\xa4\x54\x03\x7a\x4f\x3f\xe7\x2b\x4e\x3c\x68\x55\x06\xe6\x2f\x49\xb5\xdd\xbb\x87\x37\x19\xa5\xdf\x9e\xf1\xfd\x19\x6f\xe3\xd4\x73\x01\x29\x65\x3d\x38\x6c\x50\xed\x8c\xec\xf0\xde\x19\x85\x7e\x04\xf1\xd1\xf6\xee\x2c\x3d\x3e\x9a\x25\x5a\xb4\x6d\x8e\xb4\x63\x18\x42\xc6\xe0\x6c\x2f\x3d\xb4\xed\x5e\x9a\x09\x47\xf8\xf6\x5d\xdb\x80\xbe\x97\x1d\xc6\xc4\xd9\x29\xde\x80\xdc\xed\xd0\xaa\xf2\x18\xa9\x21\x46\xe8\x35\x1a\x95\xcf\x20\xd6\x41\x06\xdd\x9d\xd4\x67\x71\x5e\xda\x2d\xc2\xa5\xae\xe1\x92\xda\x5b\x35\x20\x6e\x27\x63\xe4\xc6\xe0\x52\xc8\x99\xee\xe1\x22\xc6\x5c\x20\x6e\xe5\x80\x90\x92\xd0\xa3\x9d\x8c\x29\x2b\x88\x9c\xb1\xb6\xed\x9c\x55\xe4\xd9\xa5\xa6\x24\x21\x40\x03\xbd\x34\x23\x72\xf6\x96\xc4\x33\xdc\x2c\xb5\xac\x2a\xce\x16\x77\xac\x7a\xe9\x01\x99\x02\x0d\xb4\xed\xf8\x68\x36\x93\x55\x06\xdb\x3b\xb4\x0a\x7d\xe9\x36\x3f\x84\xd2\xd2\x60\x17\x6a\x78\xe9\x61\xc5\x19\xe5\x8c\xdb\xae\xc3\x10\xca\x19\xa3\x3e\xf9\x29\x84\xa8\xc8\x45\xe5\x2c\xc2\xaa\x01\xaa\x55\x1b\x71\xef\xdc\xc3\x97\x09\xfd\x73\xd9\x85\x43\x0d\xf9\x2f\xcd\x87\xda\xfd\x8c\xe1\xde\xa9\x15\x00\x40\xf1\xca\xb6\x14\x35\x67\xec\x93\xb6\x73\x05\xcc\x77\xe9\xdc\xde\xa1\x54\x94\xfc\x4a\xf6\xce\x59\xda\x57\xaf\x6d\xe8\xa1\xf8\xe7\xb1\x00\x91\x53\xb4\xb7\x9c\x31\x9a\x17\x0e\x68\xc3\x6a\xe9\xbc\xe6\x2c\x55\x47\x2b\x3a\x37\xd9\x00\xda\x86\xff\xff\xe3\x4c\x61\x8f\x1e\xfa\xc9\x76\x34\x12\x98\xdb\x29\x97\xa2\xfc\x06\x2a\x48\x65\xc5\xa9\x53\xef\x9e\xc6\x1c\x3a\xb5\xeb\xf5\x1e\xbd\xc8\x3a\x6f\x9c\x0d\x78\x08\x73\xd7\xaf\x7b\xa5\xfb\x7c\xf9\xa2\x01\xab\x4d\x9e\xbf\xc7\x30\x79\x4b\xc7\x3a\xfb\x37\xc8\x07\x7c\xef\x7d\x49\xb4\x79\x90\xb3\xbc\x99\x5a\xdc\x18\x37\x62\xd6\x42\x8b\xb5\x04\x6f\x89\x74\xde\xa6\x05\x7f\xd5\x1c\x2f\x10\x54\xf5\xee\x77\xd2\x37\x58\x89\xf6\xac\xc4\x6a\x43\x5a\xf2\x56\x69\xab\xc3\xf1\x0b\xc1\x19\x01\x9f\xc8\xd6\x9d\xb4\xf4\xde\xa5\x52\xde\xf5\x50\xf6\x46\x86\x80\x36\x57\x57\xf9\xdd\xfc\x85\x01\x64\x7b\x1e\x05\x34\xf0\x2f\x3f\x96\x13\x8b\xdf\xfe\xfa\x52\x91\xc2\x18\x01\xad\x82\xab\x94\xf8\xcf\x01\x00\x89\xff\x83\xb2\x16\x05\x00\x00")
 
 func golangGetFirstTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -325,12 +349,12 @@ func golangGetFirstTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-first.tmpl", size: 1044, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-first.tmpl", size: 1302, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetHasTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x92\xcf\x8a\xdb\x40\x0c\xc6\xcf\x9e\xa7\xd0\x86\x50\x6c\xf0\xce\x03\x14\x72\xd8\x43\xa1\xbd\x2c\x74\x73\x2c\xc5\xc8\x1e\xd9\x3b\xdd\xf1\x4c\x56\x63\xa7\xbb\x98\xbc\x7b\x35\x13\x37\x24\xd0\x3f\x07\x83\x47\xfa\xf4\x93\xf4\xa1\x65\xb9\x07\x43\xbd\xf5\x04\x9b\x68\x07\x8f\xd3\xcc\xb4\x81\xfb\xd3\x49\x7d\xc6\xd8\x2c\x0b\xe8\xfd\xdc\xf7\xf6\x0d\x4e\xa7\x52\x5e\xdd\xf4\x76\x40\xc6\x11\xf4\x83\x73\x0f\x3c\x44\x89\x57\x50\xaa\xe2\x19\x23\xb4\x21\xb8\x1a\x88\x39\x7d\x81\x2b\x95\xe8\xe4\x4d\xc6\xa9\xeb\x56\xd6\x1f\xc3\xcb\x7f\xfa\x20\x0f\x37\x5d\xfe\x4e\x6b\x83\x79\xdf\x88\x44\x15\x52\x49\x63\x4b\xe6\xe0\xb0\xa3\xe7\xe0\x0c\x71\x04\xfd\xc5\xf7\xe1\x26\x1d\x5f\xdd\x1a\xdd\x34\x4d\x8e\x34\x71\x1a\xa7\xcc\x50\xc5\x11\x19\x9a\xe6\x88\x6e\xa6\x08\xdf\xbe\x5b\x3f\x11\xf7\xc2\x5b\x84\x70\x89\xef\x00\x0f\x07\x99\xa6\xfc\x1d\xa9\x41\xe8\xbd\x25\x67\xf2\x5b\xf6\x99\x70\xb2\xdd\x65\xfa\xdc\x9d\xd1\x0f\x04\x5b\x5b\xc3\x36\xad\xf7\x71\x07\xfa\x71\x76\x0e\x5b\x47\xab\x50\x15\xb6\x87\x3b\x91\x26\x81\x7e\xc4\x91\x24\xa8\x6d\xf4\x22\x2b\x2b\x58\x54\x21\x33\x74\xc1\x9b\xe4\xd9\xd6\xa6\x64\x22\xc8\x3c\x3d\xba\x48\x39\xfd\xcf\x11\x6f\xb8\x39\x5e\x56\x95\x2a\x56\x77\xc4\xde\x2b\x0f\x92\x29\x82\x91\x9f\x57\xd7\xce\xde\x38\x6a\x9e\x44\x42\x5c\x86\xf6\x87\x36\x16\x1d\x75\x53\x0d\xd7\x1e\x0a\x2a\xe5\x5c\x18\xf6\xf2\x2a\xcf\x8c\xfa\xe2\xa7\xd6\x3a\x59\x91\x6e\x64\x07\x19\xc2\xf6\x48\xac\xbf\xce\xc4\xef\x4f\xe1\xe7\x1f\x0b\xf4\xbe\x43\x5f\x7e\x90\x13\xab\xb2\x3d\xa9\xfa\x6e\x07\xde\xba\xec\x07\x93\x1c\xad\x3f\xef\x5f\x67\xe8\x88\x2f\xf4\x89\xb9\x14\xe1\x79\xb5\x55\x22\x84\x3a\x95\xa9\x75\xd5\x74\x49\xbf\x02\x00\x00\xff\xff\x00\x39\xc5\xaf\x02\x03\x00\x00")
+var _golangGetHasTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xd1\x6a\x1b\x3b\x10\x7d\x96\xbe\x62\xb2\xe4\x5e\x76\x61\x23\xb8\x70\xb9\x0f\x86\x7d\x08\x97\x42\x4b\x69\xa0\x71\xdf\x4a\x59\xb4\xab\x59\x5b\x8d\x76\xe4\x48\x5a\xd7\x41\xe8\xdf\x8b\xb4\x76\x48\x68\x4b\x1e\x0c\xd6\x9c\xf1\x99\x73\xce\x8c\x63\xbc\x01\x85\x93\x26\x84\xca\xeb\x1d\xc9\xb0\x38\xac\xe0\x26\x25\xfe\x5e\xfa\x3e\x46\x10\xdb\x65\x9a\xf4\x09\x52\xaa\x63\x84\x31\x9c\x0e\xd2\xc9\x19\xc4\xad\x31\xb7\x6e\xe7\x21\xa5\x06\x6a\xce\xf6\xd2\xc3\x60\xad\x69\x01\x9d\xcb\x1f\xeb\x1a\x9e\xd9\x91\x54\xa1\xe3\x2f\x47\x69\x3a\xda\x87\x37\xe6\x48\xb7\x7b\x35\xe5\xcf\x6c\x83\x55\x4f\x15\xa4\xc4\x59\x8c\x80\xf3\x80\xea\x60\xe4\x88\x7b\x6b\x14\x3a\x0f\xe2\x03\x4d\xf6\x15\xec\x1f\xcd\xb9\x5a\xf5\x7d\xa9\xf4\x3e\xcc\xa1\x70\x70\x76\x94\x0e\xfa\xfe\x28\xcd\x82\x1e\xbe\x7e\xd3\x14\xd0\x4d\x72\xc4\x98\x38\x7b\xae\x77\x20\x0f\x07\x24\x55\x5f\x2a\x2d\xc4\x08\x93\x46\xa3\xca\x1b\xc4\x36\xc8\xa0\xc7\x67\xf5\x45\x9c\x93\xb4\x43\xb8\xd6\x2d\x5c\x67\x7b\x9b\x0e\xc4\xdd\x62\x8c\x1c\x0c\x9e\x1b\x39\xd3\x13\x5c\xc5\x58\x1a\xc4\x9d\x9c\x11\x52\x12\xda\xd3\x62\x4c\xdd\x40\xe4\x8c\xf5\xfd\x68\x49\xe5\xcc\xae\x75\x06\x33\x03\x74\x30\x49\xe3\x91\xb3\xb7\x24\xbe\xe2\x2d\x52\xeb\xa6\xe1\xec\x9c\x0e\xa9\x97\x19\xe4\x50\xa0\x83\xbe\xf7\x8f\x66\x58\x48\x19\xec\xef\x91\x14\xba\xda\x0e\xdf\x85\xd2\xd2\xe0\x18\x5a\x78\x99\x61\xc3\x59\xc6\x8c\xdd\x6d\xc3\x1c\xea\x95\xa3\x7d\xce\x53\x08\xd1\xe4\x14\x95\x25\x84\x4d\x07\xb9\x57\x0d\x62\x6f\xed\xc3\xe7\x05\xdd\x53\x3d\x86\x53\x0b\xe5\x6b\xde\x4f\xb6\xfb\x09\xc3\xde\xaa\x0d\x00\x40\xf5\xcb\xad\x54\x2d\x67\xec\xa3\xa6\x15\x87\xf5\x97\xf9\xdd\xdf\xa3\x54\x19\xfc\x92\xc3\x5d\xd1\x18\xe1\xe0\x34\x85\x09\xaa\xbf\x1e\x2b\x10\x05\x82\x94\x72\x5b\xde\x16\xce\x48\x61\x73\xf6\xdd\x72\x96\x9a\x4b\x10\xa3\x5d\x28\x80\xa6\xf0\xdf\xbf\x9c\x29\x9c\xd0\xc1\xb4\xd0\x98\x17\x02\xab\x99\xfa\xdc\x54\xee\xbf\x81\x54\x37\x9c\xb3\xfc\x57\x38\x7b\x74\xfa\x88\x4e\x14\x79\xf7\xf6\xc7\xff\x96\x02\x9e\xc2\xea\xf6\x77\x19\x89\xed\x28\xa9\xfe\x7b\x2f\x7d\x53\x2e\x22\x33\x5d\x75\x40\xda\x94\x13\x70\x18\x16\x47\xeb\xca\xdb\x32\x60\x96\x0f\xf8\xce\xb9\x3a\x4f\x2f\xdb\xbc\x88\xee\xe0\x1f\x7e\xe9\xdf\x4b\xdf\x66\x0e\x1e\x23\x20\x29\xb8\x49\x89\xff\x1c\x00\x94\x38\x7c\x3e\x02\x04\x00\x00")
 
 func golangGetHasTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -345,12 +369,32 @@ func golangGetHasTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-has.tmpl", size: 770, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-has.tmpl", size: 1026, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _golangGetIterateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xc1\x6e\xe3\x36\x10\x3d\x93\x5f\x31\x11\x52\x40\x42\x14\x9e\x8a\x1e\x5c\xe8\x10\x04\x3d\x04\x45\x03\x34\xee\xad\x28\x04\x5a\x1c\x3a\x6c\xe8\xa1\x43\x51\x8e\x03\x82\xff\xbe\x20\x25\x7b\x13\x6c\xb2\xbb\x37\x69\xde\xe3\xcc\x9b\x37\x33\x31\x5e\x83\x42\x6d\x08\xa1\x1a\xcd\x96\x64\x98\x3c\x56\x70\x9d\x12\xbf\x0b\xe8\x65\xc0\x3e\x46\x10\xeb\x49\x6b\x73\x84\x94\xea\x18\x61\x08\xc7\xbd\xf4\x72\x07\xe2\xc6\xda\x1b\xbf\x1d\x21\xa5\x96\x33\x4d\xa0\x27\x1a\x32\x63\x81\x1f\xdc\x0b\xa4\xd4\x00\x7a\xef\x7c\x03\x35\x67\xe8\xfd\xf2\xc7\x73\x65\x24\x55\x4a\xf1\xb7\x32\x0c\x1d\xdc\xd3\x4f\x68\x90\x7e\xfb\x4e\x01\x68\xfa\x4e\xd6\x8d\x53\xaf\x15\xa4\xc4\x59\x8c\x80\xbb\x0d\xaa\xbd\x95\x03\x3e\x3a\xab\xd0\x8f\x20\xee\x48\xbb\x77\xf0\xf8\x6c\x97\x68\xd5\xf7\x25\xd2\x8f\x61\x17\x4a\x0e\xce\x0e\xd2\x43\xdf\x1f\xa4\x9d\x70\x84\x7f\xff\x33\x14\xd0\x6b\x39\x60\x4c\x9c\x9d\xe3\x1d\xc8\xfd\x1e\x49\xd5\xa7\x48\x0b\x31\x82\x36\x68\x55\xf9\x07\xb1\x0e\x32\x98\x61\xe9\xa0\xe1\x45\x9c\x97\xb4\x45\xb8\x34\x2d\x5c\xe6\x16\x57\x1d\x88\xfb\xc9\x5a\xb9\xb1\xb8\x10\x39\x33\x1a\x2e\x62\x2c\x04\x71\x2f\x77\x08\x29\x09\x33\xd2\x64\x6d\xdd\x40\xe4\x8c\xf5\xfd\xe0\x48\x65\xdf\x2e\x4d\x06\x73\x06\xe8\x40\x4b\x3b\x22\x67\x3f\x92\xf8\x2e\x6f\x91\x5a\x37\x0d\x67\x8b\x3b\xa4\xde\x7a\x90\x4d\x81\x0e\xfa\x7e\x7c\xb6\x9b\x89\x94\xc5\xfe\x01\x49\xa1\xaf\xdd\xe6\x7f\xa1\x8c\xb4\x38\x84\x16\xde\x7a\xd8\x70\x96\x31\xeb\xb6\xeb\xb0\x0b\xf5\x9c\xa3\x3d\xfb\x29\x84\x68\xb2\x8b\xca\x11\xc2\xaa\x83\xcc\x55\x1b\xf1\xe8\xdc\xd3\xdf\x13\xfa\xd7\x7a\x08\xc7\x16\xca\x67\x9e\x4f\x6e\xf7\x2f\x0c\x8f\x4e\xad\x00\x00\xaa\x0f\x77\xa6\x6a\x39\x63\x7f\x1a\x9a\x39\x30\xbf\xce\xff\xfd\x03\x4a\x95\xc1\x7f\xb2\xc1\x33\x9a\x37\xd8\x1b\x0a\x1a\xaa\x5f\x9e\x2b\x10\x05\x9a\x97\x9c\xe5\x89\xe1\x0e\x29\xac\x96\xde\x5b\xce\x52\x73\x32\x63\x70\x13\x05\x30\x14\x7e\xfb\x95\x33\x85\x1a\xfd\x7c\x13\x0d\x44\x98\x1b\xaa\x17\x52\x9b\xef\xa0\x81\x54\xe7\xa9\xf7\xbd\x77\x2f\x63\x09\x9d\x1b\xf6\xe6\x80\x5e\x14\x9d\xb7\x8e\x02\x1e\xc3\xdc\xf7\xc7\x6e\x19\x5d\x1e\x5f\x74\x40\xc6\x96\x0d\xf0\x18\x26\x4f\xc5\xbc\x9d\x7c\xc2\x3f\xbc\xaf\x73\xc5\x32\xc5\x59\xd9\x5c\x55\xdc\x5a\x37\x62\x91\xa1\xdd\x39\x78\x9f\xeb\xcd\xab\x14\x23\x18\x32\x81\xf0\xe5\x74\xd1\x9c\x95\x43\xee\x4e\xe4\xf5\x20\x29\x1f\xa5\x54\xca\x3b\x0d\xb5\xb6\x32\x04\xa4\x42\x6f\xca\x62\xb3\x0f\x04\x7e\xae\x30\x4b\x64\x8b\x4f\x57\x57\x5f\x1f\x77\xa0\xe7\x3a\xf9\xf2\x67\x29\xcd\xef\x9f\xa5\x45\xef\xe7\x4c\xe9\xec\xce\xea\xac\x38\xbb\xf1\xed\xd3\x4f\x05\x25\x7e\xc2\xc8\x58\x1e\x23\x20\x29\xb8\x4e\x89\x7f\x19\x00\x5c\x9f\xdc\xdb\x3f\x05\x00\x00")
+
+func golangGetIterateTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_golangGetIterateTmpl,
+		"golang.get-iterate.tmpl",
+	)
+}
+
+func golangGetIterateTmpl() (*asset, error) {
+	bytes, err := golangGetIterateTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "golang.get-iterate.tmpl", size: 1343, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetLastTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x90\x31\x6f\xb3\x30\x10\x86\x67\xfb\x57\xdc\xc7\x64\x24\xe2\xe9\x53\xb7\x4c\x55\x87\x4a\x5d\x9a\x74\x47\x06\x1f\xd4\x8d\xb1\xc9\xe1\xa4\x49\x11\xff\xbd\x36\x24\x2a\x19\x10\x70\xbe\xe7\x7d\x7c\x37\x8e\x1b\xd0\xd8\x18\x87\x90\x0d\xa6\x75\x2a\x9c\x08\x33\xd8\x4c\x13\x6f\x31\xbc\xa9\x21\x8c\x23\xc8\x1d\xc6\xb2\x93\x1f\xd7\x1e\x61\x9a\x44\x1d\x2e\x50\x7b\x17\xf0\x12\xe4\xf3\xf2\x2e\x38\xeb\x0f\x60\x5c\x78\xfa\x9f\x83\xe0\x2c\x52\xbd\x22\xd5\xdd\xd9\x88\x15\x80\x44\xe9\xf1\x94\xf3\xe4\x45\xa7\x67\x11\x5f\x5f\xa2\xf2\xfa\xba\xf8\x53\x06\x76\x15\xea\xde\xaa\x1a\x3f\xbd\xd5\x48\x03\xc8\x57\xd7\x78\x58\x1f\x0f\x47\x7b\xab\x66\x65\x39\x57\xca\x21\x74\x21\x4b\x4d\x9c\x9d\x15\x41\x39\x17\x60\x9b\x3e\x8e\xb6\x3a\x39\x6d\xb1\xdc\x45\x3d\x92\xf0\xd5\x97\xd4\x46\x59\xac\x43\x01\x6b\x3e\xe7\x2c\x9d\x59\xdf\xee\xe3\x9f\x58\x32\x0a\xe8\x0f\x39\x9f\xdd\xc6\x99\xb0\x9a\x8e\xb3\x34\xdd\x16\xe6\x3c\x32\x67\x24\xf9\x7e\x42\xba\xee\xfc\xf7\x9a\x95\xfb\x5a\x39\x11\x71\xa5\x35\xf9\x06\x44\x63\x55\x08\xe8\xee\x49\x79\x8c\x8a\x66\xd3\xcc\xcb\xfa\xb7\x05\x67\x2c\x8c\x9c\x31\x5a\x44\x91\xfc\x41\xf2\x0f\x6b\x4d\xca\x4e\x1d\xf0\x85\x48\x44\x2a\xe2\xf1\x36\x7f\xfd\x8a\xda\x87\xf6\x98\xc8\xd3\xee\x6e\xdb\xff\x0d\x00\x00\xff\xff\x3a\xb2\xd4\xf6\x02\x02\x00\x00")
+var _golangGetLastTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x3f\xab\xdb\x30\x14\xc5\x67\xe9\x53\x9c\x1a\x0a\x36\x38\x86\x42\xe9\x10\xc8\x54\x3a\x94\xb6\x43\x93\xb7\x1b\xd9\xba\x4e\xd4\xd8\x52\xa2\x5c\xbf\xbe\x54\xe8\xbb\x17\xc9\x09\xb8\x43\x07\x63\x59\xf7\xdf\xb9\xbf\xe3\x10\x36\xd0\x34\x18\x4b\x28\x6e\xe6\x68\x15\xcf\x9e\x0a\x6c\x62\x94\x47\xe2\xef\xea\xc6\x21\xa0\xd9\x13\xcf\xde\x36\x2f\xf7\x0b\x21\xc6\xb2\xe7\x37\xf4\xce\x32\xbd\x71\xf3\x79\x79\xd7\x52\x5c\xce\x30\x96\x3f\x7d\xac\x50\x4a\x11\x02\x2e\xca\xab\xe9\x59\x8b\x18\x6b\x90\xf7\xe9\x71\xbe\x92\x69\x2e\x59\x9d\x07\xc9\xb5\x88\xce\xe9\xfb\x32\x3f\xf5\xa0\xa9\x23\x7d\x19\x55\x4f\x27\x37\x6a\xf2\x37\x34\x5f\xed\xe0\xb0\x0e\xdf\xae\xe3\xe3\xb6\x68\xdb\x5c\xd0\xde\x78\xe2\x22\x25\x49\xf1\xaa\x3c\xda\x7c\x81\x5d\x3a\x5c\xc7\x6e\xb6\x7a\xa4\x76\x4f\x56\x93\x2f\x5d\xf7\xab\xd1\x46\x8d\xd4\x73\x8d\x75\x7d\x25\x45\x8a\x8d\xee\x78\xe0\x89\xcb\xa5\x47\x8d\xcb\xb9\x92\xa2\x6d\xb5\xb3\x84\xed\x0e\x29\x45\x77\xcd\xc9\xb9\xf3\xcf\x99\xfc\x3d\xb1\xa9\x91\x8f\x49\x52\x90\x42\xfc\x20\x3e\x39\xbd\x05\x80\xe2\xbf\x4c\x8b\x5a\x0a\xf1\xcd\xd8\x25\x0f\x4b\x87\xf4\xdd\xee\x49\xe9\x14\x7c\x51\xdd\x48\x4b\x34\xc1\xf5\xc6\xf2\x80\xe2\xfd\xb5\x40\x93\x43\x89\xb0\x14\xe2\xc0\x8a\x69\x22\xcb\xdb\xc7\xda\xb5\x14\xb1\x7a\x72\xe8\xdd\x6c\x79\xb1\x49\x0a\x4d\x03\x79\x0c\xb3\xed\xcb\x0a\x01\xcb\x52\xe5\x23\x29\xbb\x55\x21\x96\x95\xcc\xa8\x8d\x35\xbc\x32\x53\x8a\x64\xe6\x63\x7f\x6f\x5e\xc9\x37\x59\xf2\xde\xfd\x7e\xfc\x11\x0b\x89\x15\xb6\xe6\xd0\x2b\x5b\x86\x00\xa5\xb5\x77\x03\xca\x61\x54\xcc\x64\x9f\x5d\x2b\xc4\x24\xd4\x0c\x69\x32\xde\xed\x60\xcd\x88\x04\xd0\x67\x52\x08\x01\x7f\xc8\xbb\x95\x88\x3a\x8f\x9f\xd4\x99\xbe\x78\x5f\x26\xbd\x52\xc4\xe4\x4e\xde\x00\x3b\x7c\x90\xab\x62\xe5\x8f\xff\xd4\x5a\x33\xca\x10\x40\x56\x63\x13\xa3\xfc\x3b\x00\x28\x81\x7d\x4e\x0a\x03\x00\x00")
 
 func golangGetLastTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -365,12 +409,12 @@ func golangGetLastTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-last.tmpl", size: 514, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-last.tmpl", size: 778, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetLimitoffsetTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\xc1\x6e\xdb\x30\x0c\x3d\x5b\x5f\xc1\x06\x3d\xd8\x80\xab\xd3\xb0\x43\x87\x1c\x8a\x61\x87\x01\x43\x81\xb5\xc7\x61\x30\x14\x9b\xca\xb4\xca\x52\x2a\xc9\x49\x0a\x23\xff\x3e\x52\x76\x82\xa4\x28\xba\xed\x60\xc0\xa2\x1e\x1f\x1f\x9f\xc8\x71\xbc\x81\x0e\xb5\x71\x08\x8b\x68\xd6\x4e\xa5\x21\xe0\x02\x6e\x0e\x07\xf1\xcd\xf4\x26\x61\xd7\x8c\x23\xc8\xc7\x41\x6b\xb3\x87\xc3\xa1\xa4\x53\x9b\xf6\x1b\x15\x54\x0f\xf2\xce\xda\xbb\xb0\x8e\x14\xaf\x45\x61\x19\x0f\xc6\xa5\x1a\xbc\xd6\x11\xf3\xff\xc7\x0f\x15\x94\xa2\x08\x7e\x17\x81\x52\xa3\x35\x2d\x7a\x0d\xf2\xc1\xef\x38\x0b\x30\x04\xfe\x7c\xa8\x04\x4b\x41\xd7\xe5\xda\x42\x9c\x0b\x33\x6e\xeb\x9f\xfe\x41\x95\x0a\xeb\x0b\x4d\x90\x25\x1d\xe5\xbc\xaa\x70\x5e\x60\xe5\xbb\x97\x05\x65\x88\x82\x88\xb0\x5f\x61\xb7\xb1\xaa\xc5\x5f\xde\x76\x18\x22\xc8\xaf\x4e\xfb\x8b\xeb\xf8\x6c\xe7\xe8\xa2\x69\x72\xa4\x89\xa9\x4f\x99\x43\x14\x5b\x15\xa0\x69\xb6\xca\x0e\x18\xe1\xc7\x4f\xb2\x01\x83\x26\xbe\x91\x18\x4e\xf1\x25\xa8\xcd\x86\xd4\x94\xc7\x48\xcd\x06\x69\x83\xb6\xcb\x67\x6a\x2f\xa9\x64\xda\xb9\x99\x4a\xe4\xea\x41\xb9\x35\xc2\xb5\xa9\xe1\x9a\xbb\xbd\x5d\x82\xbc\x1f\xac\x55\x2b\x8b\x33\x50\x14\x46\xc3\x15\x41\x19\x20\xef\x55\x8f\x14\x94\x26\x3a\x82\x95\x15\x8c\xa2\x20\x0d\xad\x77\xd9\xc2\x6b\xc3\x97\xcc\x40\x7a\xb4\xb2\x11\xf3\xf5\xbb\x12\x2f\x78\x73\xbc\xac\x2a\x51\xcc\xee\x90\xbd\xd9\x83\xf7\x48\x5e\x3d\xcb\xd1\x30\x76\x90\xe0\xf4\xf3\x6c\x57\x83\xeb\x2c\x36\x0f\x94\x88\xa1\xf4\xab\xdf\xb2\x33\xca\x62\x4b\x69\xe7\x86\x53\x5d\xbe\xb3\x7e\xfd\x48\xa7\x72\xe2\xa8\x4f\xe6\x4b\x29\xab\xac\x85\xe7\x6f\x1a\x36\x72\x2c\xb3\x05\xb3\xc5\x20\xbf\x0f\x18\x5e\xde\x4e\x63\x1b\x39\xe1\x6a\x09\xce\xd8\xec\x5b\x40\xda\x0e\xc7\xc7\x3a\x93\xf4\xea\x09\xbf\x84\x50\x12\x6c\x32\x80\xe6\x09\xb9\x13\x2e\x27\x3f\x5b\x1f\xc9\x1a\xaa\xaf\xfd\x29\x78\x8f\xfb\x34\xbf\x02\xb9\x65\x9c\x49\x0e\x77\xc7\x75\xa0\x20\x57\x5c\x1e\xc1\x8f\xad\x72\x3c\xda\xaa\xeb\x02\x2d\x4d\xa9\xad\x4a\x09\x5d\x86\x57\x79\x26\x8a\x37\x54\xfe\x45\x26\xeb\x9c\xf6\xf1\xf4\x32\x93\x3b\x5c\x88\x17\x68\xd2\x32\x35\x34\xb3\xdf\x9e\x24\x31\x4f\xf5\xe9\xbf\x8d\x99\x01\x53\x21\x82\x89\x79\x54\x78\x13\xff\x04\x00\x00\xff\xff\xed\x93\x86\x3b\x83\x04\x00\x00")
+var _golangGetLimitoffsetTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x51\x6b\xe3\x38\x10\xc7\x9f\xa5\x4f\x31\x35\x3d\xb0\xc1\xd5\xd3\x71\x0f\x39\xf2\x50\xca\x3d\x1c\x77\x5b\xd8\x66\xdf\x96\xc5\x28\xd6\x28\xd5\x56\x96\x52\x59\x4e\x53\x84\xbe\xfb\x32\xb2\x13\xda\x52\xb6\xbb\x0f\x01\x6b\x34\x9a\xf9\xcd\x7f\x66\x92\xd2\x15\x28\xd4\xc6\x21\x54\xa3\xd9\x39\x19\xa7\x80\x15\x5c\xe5\xcc\xff\x37\x83\x89\xa8\xba\x94\x40\x6c\x26\xad\xcd\x11\x72\xae\x53\x82\x3e\x1e\xf7\x32\xc8\x01\xc4\xb5\xb5\xd7\x61\x37\x42\xce\x2d\x67\x96\xfc\xc1\xb8\xd8\x82\xd7\x7a\xc4\xf2\xfd\xd7\x9f\x0d\xd4\x9c\x05\xff\x34\x42\x4a\x30\x5a\xd3\xa3\xd7\x20\xee\xfc\x13\xbd\x02\x0c\x81\x7e\x3e\x34\x9c\x50\xd0\xa9\x92\x9b\xf3\x97\x60\xc6\x1d\xfc\xc3\x2f\x50\xc9\xb0\x7b\xc5\x04\x05\xe9\x84\xf3\x26\xc3\xcb\x04\x5b\xaf\x9e\x2b\xc8\x99\xb3\x94\x00\x87\x2d\xaa\xbd\x95\x3d\xde\x7b\xab\x30\x8c\x20\xfe\x75\xda\xbf\xba\x1e\x1f\xed\x62\xad\xba\xae\x58\xba\x31\x0e\xb1\xc4\xe0\xec\x20\x03\x74\xdd\x41\xda\x09\x47\xf8\xfa\xcd\xb8\x88\x41\xcb\x1e\x53\xe6\xec\x6c\x5f\x83\xdc\xef\xd1\xa9\xfa\x64\x69\x49\x20\x6d\xd0\xaa\x72\x06\xb1\x89\x32\x9a\x7e\x29\xa6\xe1\x05\x2e\x48\xb7\x43\xb8\x34\x2d\x5c\x52\xb5\xab\x35\x88\xdb\xc9\x5a\xb9\xb5\xb8\x38\x72\x66\x34\x5c\xa4\x54\x1c\xc4\xad\x1c\x10\x72\x16\x66\x74\x93\xb5\x75\x03\x89\x33\xd6\x75\xbd\x77\xa5\xb1\x97\x86\x2e\x29\x02\xac\x41\x4b\x3b\x22\x67\x1f\x21\xbe\x8a\x5b\x50\xeb\xa6\xe1\x6c\x51\xc7\x29\x62\xf8\x79\x9d\x6f\xda\x72\x12\x8c\x14\x84\x35\x74\xdd\xf8\x68\xb7\x93\x53\x16\xbb\x3b\x74\x0a\x43\xed\xb7\xdf\x85\x32\xd2\x62\x1f\x5b\x78\x29\x78\xc3\x19\xdd\x59\xbf\xdb\xc4\x21\xd6\x73\x8c\xf6\x2c\xbe\x10\xa2\x21\x14\xe5\x1d\xc2\x6a\x0d\xe4\xab\xb6\xe2\xde\xfb\x87\xcf\x13\x86\xe7\xba\x8f\xc7\x16\xca\x27\x35\x93\xb4\xf9\x84\xf1\xde\xab\x15\x00\x40\xf5\xee\xac\x55\x2d\x67\xec\x3f\xe3\x66\x1f\x98\x5f\xd3\xb9\xbb\x43\xa9\xe8\xf2\x0b\x75\x63\xbe\x4d\x09\xf6\xc1\xb8\xa8\xa1\xfa\xe3\xb1\x02\x51\xae\x68\xf0\x39\x63\xd4\x5e\x1c\xd0\xc5\xd5\x52\x7b\xcb\x59\x6e\x38\x53\xa8\x31\x80\x9e\x5c\x4f\xed\x82\x99\xbe\x2e\xbb\x54\x5b\x74\x35\xed\x52\xd3\xb4\xb4\x36\x0d\xe4\x9a\x06\xa3\xeb\xc8\x58\x4c\xe7\x32\x83\x39\x60\x10\x85\xee\xc6\xbb\x88\xc7\x38\x57\xfb\xbe\x46\x46\x97\xc7\x17\x6b\x70\xc6\x96\x21\x09\x18\xa7\xe0\xe8\xd8\x16\xdd\x06\xf9\x80\xff\x84\x50\x53\xda\xd2\xed\x99\x73\x4e\x2d\x6e\xac\x1f\xb1\xb0\x68\x7f\x36\xde\x52\xd2\x79\xe4\x52\x02\xe3\x4c\x74\xf8\x74\xda\x7d\xce\x18\x65\x5c\x9f\x9c\x37\xbd\x74\xb4\xc7\x52\xa9\xe0\x35\xd4\xda\xca\x18\xd1\x15\xf7\xa6\x2c\x00\x7b\x87\xf2\x03\x4c\xe2\x9c\xff\x7c\xce\x63\x48\xa7\xb2\x6a\xb4\x3f\x0b\xcb\x3c\xbe\x4b\xf4\xd5\x19\x89\xe2\x34\x7f\xff\xb6\x30\x8b\x03\x85\x68\xc9\x8d\xa7\x04\xe8\x14\x5c\xe5\xcc\x7f\x0c\x00\xa2\x6e\x6c\x48\x70\x05\x00\x00")
 
 func golangGetLimitoffsetTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -385,12 +429,12 @@ func golangGetLimitoffsetTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-limitoffset.tmpl", size: 1155, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-limitoffset.tmpl", size: 1392, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetOneAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\x4d\x8f\xda\x30\x10\x3d\x27\xbf\x62\x36\xa2\x52\x22\x65\xfd\x03\xb6\xe2\xb0\xaa\xaa\xaa\x87\x22\x75\x39\x56\x55\x64\x92\x31\x75\xd7\xd8\x60\x3b\x2c\x28\xe2\xbf\x77\xc6\x04\x0a\xfd\xa2\xda\x03\x12\x9e\x79\xf3\xde\xcc\xd3\x4c\x86\xe1\x1e\x3a\x54\xda\x22\x14\x41\x2f\xad\x8c\xbd\xc7\x02\xee\x0f\x87\xfc\x03\xc6\x66\x18\x40\xcc\x7b\xa5\xf4\x0e\x0e\x87\x92\x5e\x6d\xdc\xad\xa5\x97\x2b\x10\x8f\xc6\x3c\xfa\x65\xa0\x78\x05\x65\x9e\x51\x6e\x4c\x3c\xb9\x17\x0a\xd6\x80\xde\xf3\xcf\xf9\x2a\x67\x15\xb4\x5d\xa2\xcd\x2f\x25\xb5\xdd\xba\xe7\x1b\x7a\xd2\x2f\xaf\xd4\xfe\xce\xb6\x70\xdd\xbe\x20\x48\xea\x06\x57\x0b\xec\xd6\x46\xb6\xf8\xcd\x99\x0e\x7d\x00\xf1\xd1\x2a\x77\x95\x0e\x1b\x33\x46\x8b\xa6\x49\x91\x26\xc4\x55\x4c\x1c\x79\xb6\x95\x1e\x9a\x66\x2b\x4d\x8f\x01\xbe\x7c\xd5\x36\xa2\x57\xc4\x37\x10\xc3\x39\x3e\x05\xb9\x5e\x53\x37\xe5\x29\x52\x03\xb1\x2b\x8d\xa6\x4b\x6f\x9a\x27\xca\xa8\xdb\x73\xf7\x49\xdd\x4b\xbb\x44\x98\xe8\x1a\x26\x3c\xde\xc3\x14\xc4\xac\x37\x46\x2e\x0c\x8e\xc0\x3c\xd3\x0a\xee\x08\xca\x00\x31\x93\x2b\xa4\xa0\xd0\xc1\x12\xac\xac\x60\xc8\x33\xea\xa1\x75\xb6\x63\xcf\x26\x9a\x93\xcc\x40\xfd\x28\x69\x02\xa6\xf4\x3f\x5b\xbc\xe2\x4d\xf1\xb2\xaa\xf2\x6c\x74\x87\xec\xbd\xf0\x80\x4d\x21\x1a\xfa\xb3\x31\x8b\xde\x76\x06\x9b\x27\x82\xa0\x2f\xdd\xe2\xbb\xe8\xb4\x34\xd8\xc6\x1a\x2e\x3d\x24\x2a\xce\x19\xb7\x9c\xd3\xab\x3c\x72\xd4\x67\x3f\x85\x10\x6c\x45\xd3\x78\xf7\x12\x8e\xbb\x42\x26\x24\x36\xaf\xb7\xe8\xc5\xe7\x1e\xfd\xfe\xcf\x65\xec\x0c\x17\xdc\x4d\xc1\x6a\x93\xac\xf0\x48\x7b\x6b\xf9\x59\x27\x92\x95\x7c\xc6\xf7\xde\x97\x04\x3b\xce\x44\x2b\x82\x3c\x09\xcb\x89\x77\xc6\x05\x9a\x36\x3f\x7a\x3c\x06\x67\xb8\x8b\xa3\xb1\x23\xff\xc3\xf4\x54\xc0\x54\xd5\xdb\x5f\x45\x6f\xa8\xb2\xec\x15\xe4\x94\x26\x13\x99\x71\xe6\xe8\x52\x42\x6a\x2f\x79\xae\xad\x8e\xa7\xeb\xc9\x33\xd6\x3a\xeb\xcf\x5b\x69\xf9\x1a\x64\xd7\x79\xa7\xa0\x54\x46\xc6\x88\x36\xa1\xab\xb4\x55\xaf\xf0\x24\xd5\xfc\x3e\xfc\x65\x59\x74\xee\x93\xb4\x7b\xee\x93\xe5\xd7\x9e\x6e\x40\x41\xf1\x66\x53\x5c\x9c\xe9\x4f\xb2\xff\x31\xed\x76\x57\x23\x82\xc7\xe5\xcb\x3f\x7d\x4e\xa8\x22\x1f\x37\x93\x0f\xff\x47\x00\x00\x00\xff\xff\x2f\xa6\x55\x23\xb9\x04\x00\x00")
+var _golangGetOneAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x51\x6b\xdb\x3a\x14\x7e\x96\x7e\xc5\xa9\xe9\x05\x1b\x5c\xc1\x85\xcb\x7d\xc8\xf0\x43\x29\x65\x8c\xd1\xc0\x92\xbd\x8d\x61\x14\xeb\x38\xd5\xaa\x48\x89\x2c\xa7\x29\x42\xff\x7d\x48\xb6\xd3\xa4\xdd\xc8\xd8\x43\x20\x3a\xe7\xe8\x7c\xdf\xf9\xce\x67\x79\x7f\x03\x02\x5b\xa9\x11\xb2\x4e\xae\x35\x77\xbd\xc5\x0c\x6e\x42\xa0\x1f\xd1\xd5\xde\x03\x5b\xf6\x6d\x2b\x0f\x10\x42\xee\x3d\x34\xee\xb0\xe5\x96\x6f\x80\xdd\x2a\x75\x6b\xd7\x1d\x84\x50\x40\x4e\x89\xf7\x30\x26\x16\xe6\x19\x42\x28\x01\xad\x8d\x3f\x63\x0b\x1a\x51\x50\x8b\xd4\x96\x9e\x42\x4a\xbd\x37\x4f\x17\xf0\xb8\x5d\x9f\xa1\xfd\xbe\xdb\xca\x88\x97\x0c\x42\x48\x6c\x70\xb3\x42\xb1\x55\xbc\xc1\x47\xa3\x04\xda\x0e\xd8\x27\xdd\x9a\xb3\x74\xb7\x53\x63\x34\xab\xeb\x14\xa9\x3b\xb7\x71\xa9\x07\x25\x7b\x6e\xa1\xae\xf7\x5c\xf5\xd8\xc1\xb7\xef\x52\x3b\xb4\x2d\x6f\xd0\x07\x4a\x8e\xf1\x0a\xf8\x76\x8b\x5a\xe4\x53\xa4\x04\xef\xa1\x95\xa8\x44\x3a\x03\x5b\x3a\xee\x64\x73\x64\x9f\xc8\x59\xae\xd7\x08\xd7\xb2\x84\xeb\x38\xde\xac\x02\x36\xef\x95\xe2\x2b\x85\x63\x21\x25\xb2\x85\x2b\xef\x53\x01\x9b\xf3\x0d\x42\x08\x4c\x76\xba\x57\x2a\x2f\xc0\x53\x42\xea\xba\x31\x5a\x44\xcd\xae\x65\x4c\xc6\x0e\x50\x41\xcb\x55\x87\x94\x5c\xa2\x78\xd6\x37\x51\xcd\x8b\x82\x92\x51\x1d\x2d\x4e\x35\x88\xa2\x40\x05\x75\xdd\xed\xd4\xaa\xd7\x42\x61\xbd\x40\x2d\xd0\xe6\x66\xf5\x83\x09\xc9\x15\x36\xae\x84\x53\x0d\x0b\x4a\x62\x4e\x99\xf5\xd2\x6d\x5c\x3e\xf4\x28\x8f\x7a\x32\xc6\x8a\xa8\xa2\x30\x1a\x61\x56\x41\xac\x15\x2b\xf6\x68\xcc\xd3\x97\x1e\xed\x4b\xde\xb8\x43\x09\xe9\x6f\xdc\x4f\x1c\xf7\x01\xdd\xa3\x11\x33\x00\x80\xec\x9d\x57\xb2\x92\x12\xf2\x59\xea\x21\x0f\xc3\xcd\x78\xae\x17\xc8\x45\x4c\x7e\x8d\xe2\x0e\xd9\xe8\x55\x2b\xb5\x6b\x21\xfb\x67\x97\x01\x4b\xa9\xe8\x59\x4a\x48\xdc\x16\x6e\x50\xbb\xd9\x38\x77\x49\x49\x28\x26\x21\x1a\xd3\x6b\x07\x52\xbb\xff\xff\xa3\x44\x60\x8b\x16\xda\x5e\x37\x71\x21\x30\x0c\x93\x8f\x45\xc9\xff\x05\x84\xbc\xa0\x71\x4e\x6b\x9e\xbb\x14\x3a\x0e\x6b\xe5\x1e\x2d\x4b\x3c\xef\x8c\x76\x78\x70\xc3\xcc\xbf\x56\x4a\xb6\xe9\xf2\x55\x05\x5a\xaa\xb4\x7d\x8b\xae\xb7\x3a\x1e\xcb\xa4\xde\x86\x3f\xe1\xbd\xb5\x79\x84\x4d\x6b\x1c\xe8\x0d\xd0\xec\x4e\x99\x0e\x13\x97\x68\xab\x31\x38\x8f\xa0\x83\x97\xc6\xfe\xb3\x6a\xba\x10\x5b\x15\x1f\xde\x82\x5e\x40\x8d\xb0\x67\x25\x53\xba\xdb\x29\x76\x6f\xed\xdc\x2c\xcc\x73\x97\x5c\x96\x6c\x26\xb5\x74\xd3\x83\x41\x49\xc4\x3a\xe2\x2f\x1b\xae\xe3\x03\xc0\x85\xb0\xa6\x85\xbc\x55\xdc\x39\xd4\xa9\xba\x48\x1f\xd2\x5f\x68\x92\x74\x7c\x3f\xfc\xe9\x35\x67\xcc\x03\xd7\x2f\x91\x67\xfe\xd6\x27\x47\xb7\xbd\x36\xfb\x13\xd1\x2e\xb3\x9a\x8c\x55\xc1\xbf\x74\x2a\x8f\xb3\xdb\xf5\xeb\x73\xaa\xa5\xa2\xde\x03\x6a\x01\x37\x21\xd0\x9f\x03\x00\x41\x53\x50\xb6\xb9\x05\x00\x00")
 
 func golangGetOneAllTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -405,12 +449,12 @@ func golangGetOneAllTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-one-all.tmpl", size: 1209, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-one-all.tmpl", size: 1465, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetOneTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x92\xcf\x6e\xdb\x30\x0c\xc6\xcf\xd6\x53\xb0\x41\x0f\x32\xe0\xea\x01\x06\xe4\xd0\xc3\x30\xec\x52\x60\xcd\x71\x18\x0c\xd9\xa2\x3c\xad\xb2\x94\xd2\x72\xd6\xce\xc8\xbb\x8f\x52\x9d\x34\x01\xf6\xe7\x10\x20\xfc\xf8\xf9\x47\xea\x03\x97\xe5\x0e\x0c\x5a\x17\x10\x36\x93\x1b\x82\x4e\x33\xe1\x06\xee\x8e\x47\xf1\x09\x53\xbb\x2c\xa0\x76\xb3\xb5\xee\x05\x8e\x47\xc9\x55\x9f\x5e\xf6\x9a\xf4\x08\xea\xde\xfb\x7b\x1a\x26\xd6\x6b\x90\xa2\xe2\xde\xda\x78\x8c\x3f\x59\x6c\x00\x89\xf2\x2f\x52\x2d\xf2\x14\x0c\xa6\x60\xc5\xe5\x48\x17\x0e\xf1\xe9\x3f\xf3\x34\x0d\x57\xd3\xfe\x4e\xeb\xa2\x79\xdd\xb0\xa5\x6c\x83\x63\x87\x66\xef\x75\x8f\xdf\xa3\x37\x48\x13\xa8\xcf\xc1\xc6\xab\xf6\xf4\xec\x57\x75\xd3\xb6\x45\x69\xa7\x34\xa6\xc2\x10\xd5\x41\x13\xb4\xed\x41\xfb\x19\x27\xf8\xfa\xcd\x85\x84\x64\x99\xb7\x30\xe1\xac\x6f\x41\xef\xf7\xbc\x8d\x3c\x29\x0d\x30\xdd\x3a\xf4\xa6\xd4\xfc\x9e\xa4\x93\xeb\xcf\xdb\x97\xe9\xa4\xc3\x80\x70\xeb\x1a\xb8\xcd\xcf\xfb\xb0\x05\xf5\x30\x7b\xaf\x3b\x8f\xab\x51\x54\xce\xc2\x0d\x5b\xb3\x41\x3d\xe8\x11\x59\x54\x6e\x0a\x6c\x93\x35\x2c\xa2\xe2\x1d\xfa\x18\x4c\xce\xec\xd6\xe5\x66\x26\xf0\x3e\x56\xfb\x09\x4b\xfb\x9f\x2b\x5e\x71\x8b\x2e\xeb\x5a\x54\x6b\x3a\x1c\xef\x45\x06\x39\x14\xc6\xf0\x9f\x67\xdf\xcd\xc1\x78\x6c\x1f\xd9\x82\x24\x63\xf7\x43\x19\xa7\x3d\xf6\xa9\x81\xcb\x0c\x19\x95\x7b\x3e\x0e\x3b\xae\xe4\x1b\xa3\x39\xe7\xa9\x94\x5a\xa3\x70\xc1\xa5\xd3\xd1\x88\x2a\x1f\xcd\x16\x0a\x95\xdc\x01\x49\x7d\x99\x91\x5e\xb9\xfb\x47\x82\xda\xf5\x3a\xe4\x33\xd1\xc6\x50\xb4\x20\xad\xd7\x29\x61\x28\xbc\xba\xc4\x9d\x63\xcc\xd0\x9b\x2d\x04\xe7\x4b\x6e\x84\x7c\xe4\x21\x67\xf0\x0b\x29\xbe\x1f\x6c\x9e\x3a\xea\x27\xfc\x48\x24\xf9\x93\xb7\x30\xde\xcd\xe5\x0e\x4f\x5e\x66\x89\x35\xa7\x7c\x86\xbf\x03\x00\x00\xff\xff\x87\x4f\x2c\x01\x47\x03\x00\x00")
+var _golangGetOneTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x41\x6b\x1b\x3d\x10\x3d\x4b\xbf\x62\xb2\xe4\x83\x5d\xd8\x08\x3e\x28\x3d\x18\x7c\x08\xa5\x94\x52\x1a\xa8\xdd\x5b\x29\x8b\xbc\x1a\x39\x6a\xb4\x23\x47\xab\x75\x9c\x0a\xfd\xf7\x22\x79\xed\x3a\xb4\x25\x07\x83\x77\xde\x68\xe6\xcd\x9b\x37\x31\xde\x80\x42\x6d\x08\xa1\x1a\xcd\x96\x64\x98\x3c\x56\x70\x93\x12\xff\x80\xa1\x8b\x11\xc4\x7a\xd2\xda\x1c\x20\xa5\x3a\x46\xe8\xc3\x61\x27\xbd\x1c\x40\xdc\x5a\x7b\xeb\xb7\x23\xa4\xd4\x40\xcd\x59\x8c\x30\x03\x2b\xf7\x04\x29\xb5\x80\xde\xe7\x9f\xf3\x0d\xcf\x5d\x90\x54\x29\xcb\x2f\x5b\x1a\xda\xbb\x87\x57\xfa\x49\xbf\x7d\xd1\xed\xdf\xd5\x36\x4e\x3d\x57\x90\x52\x61\x83\xc3\x06\xd5\xce\xca\x1e\xef\x9d\x55\xe8\x47\x10\x1f\x49\xbb\x17\xf0\xf8\x68\xe7\x68\xd5\x75\x25\xd2\x8d\x61\x08\xa5\x06\x67\x7b\xe9\xa1\xeb\xf6\xd2\x4e\x38\xc2\xb7\xef\x86\x02\x7a\x2d\x7b\x8c\x89\xb3\x73\x7c\x09\x72\xb7\x43\x52\xf5\x29\xd2\x42\x8c\xa0\x0d\x5a\x55\xbe\x41\xac\x83\x0c\xa6\x3f\xb3\x2f\xe4\xbc\xa4\x2d\xc2\xb5\x69\xe1\x3a\x8f\xb7\x58\x82\xb8\x9b\xac\x95\x1b\x8b\x73\x22\x67\x46\xc3\x55\x8c\x25\x41\xdc\xc9\x01\x21\x25\x61\x46\x9a\xac\xad\x1b\x88\x9c\xb1\xae\xeb\x1d\xa9\xac\xd9\xb5\xc9\x60\xae\x00\x4b\xd0\xd2\x8e\xc8\xd9\x6b\x14\x5f\xd4\x2d\x54\xeb\xa6\xe1\x6c\x56\x87\xd4\xa5\x06\x59\x14\x58\x42\xd7\x8d\x8f\x76\x33\x91\xb2\xd8\xad\x90\x14\xfa\xda\x6d\x7e\x08\x65\xa4\xc5\x3e\xb4\x70\xa9\x61\xc3\x59\xc6\xac\xdb\xae\xc3\x10\xea\x63\x8d\xf6\xac\xa7\x10\xa2\xc9\x2a\x2a\x47\x08\x8b\x25\xe4\x5c\xb5\x11\xf7\xce\x3d\x7c\x99\xd0\x3f\xd7\x7d\x38\xb4\x50\xfe\xe6\xfd\xe4\x71\x3f\x63\xb8\x77\x6a\x01\x00\x50\xfd\xe1\x95\xaa\xe5\x8c\x7d\x32\x74\xc4\xe1\xf8\x32\x7f\x77\x2b\x94\x2a\x83\x5f\xb3\xb8\x47\x34\x7b\xd5\x1b\x0a\x1a\xaa\xff\x1e\x2b\x10\x05\xca\x9e\xe5\x8c\xe5\x6d\xe1\x80\x14\x16\xf3\xdc\x2d\x67\xa9\x39\x09\xd1\xbb\x89\x02\x18\x0a\x6f\xdf\x70\xa6\x50\xa3\x07\x3d\x51\x9f\x17\x02\xc7\x61\xea\x39\xa9\xf8\xbf\x81\x54\xcf\x1b\x37\x64\xc2\xe9\x36\x38\xcb\xb7\x31\x0f\xed\xcd\x1e\xbd\x28\x7c\x57\xee\xe9\x9d\xa3\x80\x87\x70\x1c\xff\x6f\xa2\x89\x75\x2f\x29\x5f\x86\x54\xca\x3b\x0d\xb5\xb6\x32\x04\xa4\x52\xbb\x29\x0e\xcb\xce\xc9\x0d\xae\x96\x40\xc6\x16\xab\x78\x0c\x93\xa7\xbc\xf6\x9f\xe8\xdd\xef\x1b\xcd\x0c\x06\xf9\x80\xef\xbd\xaf\x33\xdf\xb2\xff\xd3\x98\x4b\xf8\x9f\x5f\xbc\xcc\x46\x3d\x3f\x24\x63\x79\x8c\x80\xa4\xe0\x26\x25\xfe\x6b\x00\xa1\x30\xd1\x19\x47\x04\x00\x00")
 
 func golangGetOneTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -425,12 +469,12 @@ func golangGetOneTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-one.tmpl", size: 839, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-one.tmpl", size: 1095, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetPagedTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\x51\x6b\xdc\x30\x0c\x7e\x4e\x7e\x85\x1a\xfa\x90\x40\x6a\xfa\xdc\x71\x83\x32\xf6\x30\x18\xa5\xeb\x3d\x8e\x11\x7c\x89\x72\xf3\xea\xd8\x57\xdb\xb9\xb6\xdc\xee\xbf\x4f\xb2\x93\xf4\x0a\xa5\x1b\x7b\x08\xc4\xf2\x27\xe9\xd3\x27\xc9\x87\xc3\x05\x74\xd8\x2b\x83\x50\x78\xb5\x35\x32\x8c\x0e\x0b\xb8\x38\x1e\xf3\x5b\xb9\xc5\xae\x39\x1c\x40\xac\xc7\xbe\x57\x4f\x70\x3c\x96\x74\x6a\xc3\xd3\x4e\x3a\x39\x80\xb8\xd6\xfa\xda\x6d\x3d\xd9\xeb\x3c\xd3\x6a\x50\x01\x94\x09\x35\x21\xec\x3d\x1a\xf0\xc1\x29\xb3\xad\xa0\xcc\x33\x67\x1f\x3d\x90\xaf\xd7\xaa\x45\xdb\x83\xb8\xb3\x8f\xec\x36\x41\xed\x18\x26\x74\x0d\xe8\x1c\x7f\xd6\x55\x39\x73\x43\xd3\x45\x32\xf9\x29\x51\x65\xf6\xe4\xf6\x37\x96\xd2\x6d\x5f\x71\x84\x48\x71\xce\xf9\x4e\xf8\x8d\xed\x9e\x0b\xf2\xc8\x33\xd5\xcf\xc5\xac\x56\x50\x14\x70\xc8\xb3\x6c\x36\x40\x71\x59\xe4\x19\xb9\x66\x94\x0e\x87\x0d\x76\x3b\x2d\x5b\xfc\x69\x75\x87\xce\x83\xf8\x62\x7a\x1b\x83\xcc\xd7\xfe\x41\x4f\xd6\xa2\x69\xa2\xa5\xf1\x61\x08\x31\x53\x9e\xed\xa5\x83\xa6\xd9\x4b\x3d\xa2\x87\xef\x3f\x48\x48\x74\x3d\xc5\x3b\x50\x84\xc5\xbe\x02\xb9\xdb\x11\xe7\x72\xb6\xd4\xac\x6a\xaf\x50\x77\xf1\x4c\x22\x04\x19\x54\x3b\x95\x5c\x25\x72\x4e\x9a\x2d\xc2\xb9\xaa\xe1\x9c\x35\xb9\x5a\x81\xb8\x19\xb5\x96\x1b\x8d\x13\x30\x56\x7a\x46\x50\x06\x88\x1b\x39\x20\x19\x85\xf2\x86\x60\x65\x15\xcb\x6e\x9a\xd6\x9a\x28\xf4\xb9\xe2\x4b\x8e\x40\x7c\x7a\xa9\x3d\xc6\xeb\x77\x29\xbe\x8a\x1b\xed\x65\x55\xb1\x7a\x51\x1d\x6a\x42\xd4\xe0\xbd\x20\x49\xf6\xa9\x89\xd5\x22\x18\x2b\x48\x70\xfa\x79\xd0\x9b\xd1\x74\x1a\x9b\x3b\x72\x44\x57\xda\xcd\x2f\xd1\x29\xa9\xb1\xa5\x9e\x9f\x0a\x4e\x79\xf9\x4e\xdb\xed\x9a\x4e\x65\x8a\x51\x2f\xe2\x0b\x21\xaa\xc8\x85\x87\x36\xcd\x23\x29\x16\xa3\x39\xb5\x47\x27\xbe\x8d\xe8\x9e\xdf\x76\x63\x19\xd9\xe1\x6c\x05\x46\xe9\xa8\x9b\x43\xda\x28\xc3\xc7\x9a\x26\xa8\x8e\x81\x06\x79\x8f\x9f\x9d\x2b\x09\x9a\x44\xa0\xc9\x43\xae\x86\x53\x8a\x4f\xda\x7a\x92\x27\xf5\x4e\x19\x15\x0c\x3e\x82\xf8\x2a\x7d\xb8\xbd\x8f\xbd\xea\xed\x82\xbd\xc1\xa7\x30\x35\xe8\x14\x9c\xd6\x8b\x8c\x4c\x66\x35\x83\xd7\xad\x34\xbc\x1b\xb2\xeb\x1c\x2d\x61\xd9\x6b\x19\x02\x8d\x32\xc3\x2b\xf8\x0d\xad\x1d\x06\x49\x7e\x2f\x90\x97\xac\xc4\xf3\x8d\xe2\xfe\xa1\x3a\x2e\x2f\xed\xff\xd2\xd4\x24\x2c\x67\xe1\x0d\x4d\x5c\x93\x0e\x53\x86\xab\x85\x32\xc7\xa9\x3e\xfc\x8f\xa6\x31\x58\x7a\x94\x3e\xc2\x65\x74\x63\x03\x9a\x98\xbe\xe2\x85\x4e\xb7\xb1\x8e\x97\x77\x88\x46\x7a\x08\x62\xbd\xa3\xd7\x28\x94\x33\xc7\x57\x32\x10\xcf\x23\x20\x8d\xfd\xc9\x73\x90\x3c\xd3\x7f\xca\x3e\x71\x4c\xb5\x2e\xa0\x9a\x49\xe7\xd3\xcc\xf3\xc3\xf3\x27\x00\x00\xff\xff\x20\x27\x50\x4a\x80\x05\x00\x00")
+var _golangGetPagedTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdf\x6b\xe4\x36\x10\x7e\x96\xfe\x8a\x39\x93\x82\x0d\x8e\xb8\x87\xd2\x87\x2d\x2e\x1c\x47\x1f\x4a\xdb\x70\xcd\xf6\xad\x14\xa3\xb5\xc6\x1b\x75\x65\x69\x23\xcb\x49\x0e\x55\xff\x7b\x19\xc9\xde\x4b\x20\x5c\xca\x3d\x2c\xac\x67\x3e\xcd\x7c\xf3\xcd\x8f\x18\xaf\x41\xe1\xa8\x2d\x42\x35\xeb\xa3\x95\x61\xf1\x58\xc1\x75\x4a\xfc\x93\x3c\xa2\xea\x63\x04\xb1\x5f\xc6\x51\x3f\x41\x4a\x75\x8c\x30\x84\xa7\xb3\xf4\x72\x02\xf1\xc1\x98\x0f\xfe\x38\x43\x4a\x2d\x67\x46\x4f\x3a\x80\xb6\xa1\x85\x21\xb8\x13\x5a\x98\x83\xd7\xf6\xd8\x40\xcd\x99\x77\x8f\x33\xc4\x08\xb3\xd1\x03\xba\x11\xc4\xad\x7b\xa4\x67\x2b\xd4\x2d\x61\x45\xb7\x80\xde\xd3\xcf\xf9\x86\x13\x37\xb4\x2a\x93\xe1\xcf\x89\x6a\xfb\xe0\x4e\x6f\xb2\x94\xfe\xf8\x82\x23\x64\x8a\x5b\xce\xaf\x84\x3f\x38\xf5\xb9\x82\x94\x38\xd3\xe3\x8a\x86\xae\x83\xaa\x82\xc8\x19\xdb\x0c\x50\xbd\xaf\x38\x4b\x9c\xb3\x18\x01\xa7\x03\xaa\xb3\x91\x03\xde\x39\xa3\xd0\xcf\x20\x7e\xb1\xa3\xcb\x41\x36\xf7\x7c\x6f\x56\x6b\xd5\xf7\xf9\x41\x3f\x87\x29\xe4\x4c\x9c\x3d\x48\x0f\x7d\xff\x20\xcd\x82\x33\xfc\xf5\xb7\xb6\x01\xfd\x28\x07\x8c\x89\xb3\x8b\xbd\x03\x79\x3e\xa3\x55\xf5\x66\x69\x49\xd5\x51\xa3\x51\xf9\x1b\xc4\x3e\xc8\xa0\x87\xb5\xe4\xa6\x90\xf3\xd2\x1e\x11\xae\x74\x0b\x57\xa4\xc9\xae\x03\x71\xb3\x18\x23\x0f\x06\x57\x60\xae\xf4\x5d\x8c\x19\x20\x6e\xe4\x84\x90\x92\xd0\xb3\x5d\x8c\xa9\x9b\x5c\x76\xdf\x0f\xce\xe6\x71\xb8\xd2\xe4\xa4\x08\xd0\xc1\x28\xcd\x8c\x9c\xbd\x45\xf1\x45\xdc\x4c\xb5\x6e\x1a\x52\x8f\xf8\x51\x13\xb2\x06\x5f\x0b\x52\x64\x5f\x9b\x48\x85\x15\xc1\x48\x41\xe8\xa0\xef\xe7\x7b\x73\x58\xac\x32\xd8\xdf\xa2\x55\xe8\x6b\x77\xf8\x47\x28\x2d\x0d\x0e\xa1\x85\xe7\x82\x37\x9c\x91\xcf\xb8\xe3\x3e\x4c\xa1\x2e\x31\xda\x8b\xf8\x42\x88\x86\x24\x57\xce\x22\xec\x3a\x20\xac\x3a\x88\x3b\xe7\x4e\x7f\x2c\xe8\x3f\xd7\x43\x78\x6a\x21\xff\xa5\x66\x92\x36\xbf\x63\xb8\x73\x6a\x07\x00\x50\xbd\x32\x8f\x55\xcb\x19\xfb\x55\xdb\x82\x80\xf2\x96\xbe\xfb\x5b\x94\x8a\x9c\x7f\x52\x2f\x8a\x37\x46\x38\x7b\x6d\xc3\x08\xd5\x77\xf7\x15\x88\xec\xa2\x5d\xe1\x8c\x51\x73\x71\x42\x1b\x76\x6b\xe5\x2d\x67\xa9\xe1\x4c\xe1\x88\x1e\xc6\xc5\x0e\xd4\x2c\x28\xdc\x6b\x6d\xc3\x0f\xdf\xd7\x06\x6d\x4d\xeb\xd7\x34\x79\xb7\x1a\x48\x35\xa9\xd7\xf7\x64\xcc\xa6\x4b\x91\x5e\x3f\xa0\x17\x99\xdd\x47\x67\x03\x3e\x85\x52\xeb\xeb\x0a\xe9\x31\x3f\x7e\xd7\x81\xd5\x26\x8f\x88\xc7\xb0\x78\x4b\x9f\x2d\x54\x55\x9b\x95\x9b\xe4\x09\x7f\xf6\xbe\xa6\xd4\xb9\xdf\x85\x6b\x49\x2f\x3e\x1a\x37\x63\xbd\x8e\xa9\xb6\x3a\x58\x7c\x04\xf1\x9b\x9c\xc3\xa7\x53\x1e\xcb\xd1\x5d\xb0\x37\xc4\xa7\xcc\xe2\x73\x70\xb9\x24\x9c\x31\x22\xd3\x6d\xe0\xfd\x20\x2d\x9d\x01\xa9\x94\x77\x23\xd4\xa3\x91\x21\xa0\xcd\xf0\x06\xfe\x85\xc1\x4d\x93\x84\x94\xbe\x40\xbe\x64\x6d\x38\x7b\xa5\xb8\xff\x51\x1d\x95\x57\x4e\xdd\x65\x7e\xe9\x2b\xef\x28\x2d\xde\xca\xb5\xcc\xfd\x9a\x61\x77\xa1\x4c\x71\x9a\x1f\xbf\x45\xd3\x1c\x2c\xef\x05\xfc\x04\xef\x33\x5b\x32\x6c\x8d\xa7\xdb\x55\xbc\xe4\x59\xef\x17\x9d\xdc\x0e\xc6\x29\x88\x7d\x1e\xb7\x7a\xe3\xf8\x42\x86\xc4\x59\x02\x34\x33\x3e\xbb\x7c\xe5\x65\x89\x52\xb2\xaf\x1c\xa9\x8a\x6d\x4f\xdd\x12\x5a\x22\xcd\x63\x04\xb4\x0a\xae\x53\xe2\xff\x0d\x00\x7a\xa0\x67\xcb\x6b\x06\x00\x00")
 
 func golangGetPagedTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -445,12 +489,12 @@ func golangGetPagedTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-paged.tmpl", size: 1408, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-paged.tmpl", size: 1643, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetScalarAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\x4d\x8f\xda\x30\x10\x3d\x27\xbf\x62\x36\xa2\x52\x22\x65\xfd\x03\xb6\xe2\xb0\xaa\x5a\xa9\x87\x22\x75\x39\x56\x55\x64\x92\x31\x75\xd7\xd8\x60\x3b\x2c\x28\xe2\xbf\x77\xc6\x04\x0a\xfd\xa2\xda\x03\x08\xbf\x79\x7e\x6f\xe6\x69\xcc\x30\xdc\x43\x87\x4a\x5b\x84\x22\xe8\xa5\x95\xb1\xf7\x58\xc0\xfd\xe1\x90\x7f\xd0\xb6\x6b\x86\x01\xc4\xbc\x57\x4a\xef\xe0\x70\x28\xe9\xd4\xc6\xdd\x5a\x7a\xb9\x02\xf1\x68\xcc\xa3\x5f\x06\xc2\x2b\x28\xf3\x8c\x6a\x63\xe1\xc9\xbd\x10\x58\x03\x7a\xcf\x1f\xe7\xab\x9c\x6d\xd0\x76\x49\x37\xbf\xf4\xd4\x76\xeb\x9e\x6f\x19\x4a\xbf\xbc\xb2\xfb\xbb\xdc\xc2\x75\xfb\x82\x28\xa9\x1d\x5c\x2d\xb0\x5b\x1b\xd9\xe2\x37\x67\x3a\xf4\x01\xc4\x47\xab\xdc\x55\x39\x6c\xcc\x88\x16\x4d\x93\x90\x26\xc4\x55\x4c\x1a\x79\xb6\x95\x1e\x9a\x66\x2b\x4d\x8f\x01\xbe\x7c\xd5\x36\xa2\x57\xa4\x37\x90\xc2\x19\x9f\x82\x5c\xaf\xa9\x9b\xf2\x84\xd4\x40\xea\x4a\xa3\xe9\xd2\x99\xe6\x89\x32\xea\xf6\xdc\x7d\x72\xf7\xd2\x2e\x11\x26\xba\x86\x09\x8f\xf7\x30\x05\x31\xeb\x8d\x91\x0b\x83\x23\x31\xcf\xb4\x82\x3b\xa2\x32\x41\xcc\xe4\x0a\x09\x14\x3a\x58\xa2\x95\x15\x0c\x79\x46\x3d\xb4\xee\x98\xd9\x44\x73\x91\x15\xa8\x1f\x25\x4d\xc0\x54\xfe\x67\x8b\x57\xba\x09\x2f\xab\x2a\xcf\xc6\x74\x28\xde\x8b\x0c\x38\x14\x92\xa1\x1f\x1b\xb3\xe8\x6d\x67\xb0\x79\x22\x0a\xfa\xd2\x2d\xbe\x8b\x4e\x4b\x83\x6d\xac\xe1\x32\x43\x92\xe2\x9a\x71\xcb\x39\x9d\xca\xa3\x46\x7d\xce\x53\x08\xc1\x51\x34\x8d\x77\x2f\xe1\xb8\x2c\x14\x42\x52\xf3\x7a\x8b\x5e\x7c\xee\xd1\xef\xff\x7c\x8d\x93\xe1\x0b\x77\x53\xb0\xda\xa4\x28\x3c\xd2\xe6\x5a\x3e\xd6\x49\x64\x25\x9f\xf1\xbd\xf7\x25\xd1\x8e\x33\xd1\x8a\x20\x4f\xc2\x76\xe2\x9d\x71\x81\xa6\xcd\x8f\x19\x8f\xe0\x0c\x77\x71\x0c\x76\xd4\x7f\x98\x9e\x2e\xb0\x54\xf5\xf6\x57\xd3\x1b\xae\x6c\x7b\x45\xa1\x2f\xee\x25\x05\xac\xad\x8e\xa7\xb7\x92\x67\x2c\x7c\x36\x9b\xb7\xd2\xf2\xea\xcb\xae\xf3\x4e\x41\xa9\x8c\x8c\x11\x6d\x62\x57\x69\x85\x5e\x11\x40\xba\xf3\xfb\xa4\x97\xd7\xa2\x73\x9f\xa4\xdd\x93\x4b\x60\xfb\xb5\xa7\x85\x57\x50\xbc\xd9\x14\x17\x6f\xf2\xa7\xd8\xff\x24\x74\xbb\xab\x91\xc1\xe3\xf2\x33\x3f\xfd\x79\x70\x54\xe3\x1a\xf2\x2b\xff\x11\x00\x00\xff\xff\x62\x10\xdc\x10\xa8\x04\x00\x00")
+var _golangGetScalarAllTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xd1\x6b\xdb\x3e\x10\x7e\x96\xfe\x8a\xab\xe9\x0f\x6c\x70\x0d\x3f\x18\x7b\xc8\xf0\x43\x29\x1b\x8c\xd1\xc2\x92\xbd\x8d\x61\x14\xeb\x9c\x6a\x55\xa4\x54\x96\xd3\x14\xa1\xff\x7d\x9c\xec\xa4\x49\xbb\x91\xb1\x87\x40\x74\x77\xfa\xbe\xef\xbe\x3b\x2b\x84\x2b\x90\xd8\x29\x83\x90\xf5\x6a\x65\x84\x1f\x1c\x66\x70\x15\x23\xff\xa4\x8c\x6c\x42\x80\x6a\x31\x74\x9d\xda\x41\x8c\x79\x08\xd0\xfa\xdd\x46\x38\xb1\x86\xea\x5a\xeb\x6b\xb7\xea\x21\xc6\x02\x72\xce\x42\x80\x29\x31\xb7\x4f\x10\x63\x09\xe8\x1c\xfd\xac\x2b\x38\xd1\xa0\x91\x09\x97\x1f\x73\x2a\xb3\xb5\x0f\xe7\x08\x85\x5b\x9d\xd0\xfd\x19\x6e\x69\xe5\x73\x06\x31\x26\x39\xb8\x5e\xa2\xdc\x68\xd1\xe2\xbd\xd5\x12\x5d\x0f\xd5\x67\xd3\xd9\x93\x74\xff\xa8\xa7\x68\xd6\x34\x29\xd2\xf4\x7e\xed\x13\x06\x67\x5b\xe1\xa0\x69\xb6\x42\x0f\xd8\xc3\xf7\x1f\xca\x78\x74\x9d\x68\x31\x44\xce\x0e\xf1\x1a\xc4\x66\x83\x46\xe6\xfb\x48\x09\x21\x40\xa7\x50\xcb\x74\x86\x6a\xe1\x85\x57\xed\x41\x7d\x12\xe7\x84\x59\x21\x5c\xaa\x12\x2e\xa9\xbd\x59\x0d\xd5\xdd\xa0\xb5\x58\x6a\x9c\x0a\x39\x53\x1d\x5c\x84\x90\x0a\xaa\x3b\xb1\x46\x88\xb1\x52\xbd\x19\xb4\xce\x0b\x08\x9c\xb1\xa6\x69\xed\x38\xa4\x4b\x45\x49\x42\x80\x1a\x3a\xa1\x7b\xe4\xec\x9c\xc4\x13\xdc\x24\x35\x2f\x0a\xce\x26\x77\x8c\x3c\xf6\x80\x4c\x81\x1a\x9a\xa6\x7f\xd4\xcb\xc1\x48\x8d\xcd\x1c\x8d\x44\x97\xdb\xe5\xcf\x4a\x2a\xa1\xb1\xf5\x25\x1c\x7b\x58\x70\x46\x39\x6d\x57\x0b\xbf\xf6\xf9\x88\x51\x1e\xfc\xac\xaa\xaa\x20\x17\xa5\x35\x08\xb3\x1a\xa8\x56\x2e\xab\x7b\x6b\x1f\xbe\x0e\xe8\x9e\xf3\xd6\xef\x4a\x48\x7f\x69\x3e\xd4\xee\x2d\xfa\x7b\x2b\x67\x00\x00\xd9\xdb\x65\xc9\x4a\xce\xd8\x17\x65\xc6\x02\x18\xaf\xd2\xb9\x99\xa3\x90\x94\xfc\x46\xee\x8e\x59\xda\x56\xa7\x8c\xef\x20\xfb\xef\x31\x83\x2a\xa5\x68\x6b\x39\x63\x34\x2e\x5c\xa3\xf1\xb3\xa9\xf1\x92\xb3\x58\xec\x9d\x68\xed\x60\x3c\x28\xe3\xdf\xbf\xe3\x4c\x62\x87\x0e\xba\xc1\xb4\x34\x11\x18\xbb\xc9\xa7\xa2\xf4\x05\x14\x10\xf3\x82\x53\xa3\xce\x3e\xf5\x29\x74\xe8\xd6\xa9\x2d\xba\x2a\xe9\xbc\xb1\xc6\xe3\xce\x8f\x4d\xff\xde\x2a\xd5\xa5\xcb\x17\x35\x18\xa5\xd3\xf8\x1d\xfa\xc1\x19\x3a\x96\xc9\xbe\xb5\x78\xc0\x8f\xce\xe5\x44\x9b\xe6\x38\xca\x1b\xa9\xab\x1b\x6d\x7b\x4c\x5a\x68\xaf\xa6\xe0\x1d\x91\x8e\xcb\x34\xe1\xcf\xea\xfd\x05\x82\x2a\x3e\xbc\x26\x3d\xc3\x4a\xb4\x27\x25\x46\x69\xd2\x92\x96\x4a\x19\xe5\xf7\xef\x03\x67\x04\x7c\x20\x5b\xb4\xc2\xd0\xfb\x22\xa4\x74\xb6\x83\xbc\xd3\xc2\x7b\x34\xa9\xba\x48\x9f\xcd\x3f\x18\x90\x4c\x7b\xdb\xe9\xf1\x35\x6f\xed\xad\x30\xcf\x73\xfb\xd4\xe7\xaf\x97\xe2\xb0\x5a\x2f\x60\x7f\xe3\xd0\x79\x55\xfb\x2d\xaa\xe1\x7f\xbe\x2f\xa7\xde\xdd\xea\xe5\xf5\x24\xdf\x42\x00\x34\x12\xae\x62\xe4\xbf\x06\x00\xd2\x21\x7f\xa5\xa9\x05\x00\x00")
 
 func golangGetScalarAllTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -465,12 +509,12 @@ func golangGetScalarAllTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-scalar-all.tmpl", size: 1192, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-scalar-all.tmpl", size: 1449, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangGetScalarTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x92\x41\x6e\xdb\x3c\x10\x85\xd7\xd2\x29\x26\x86\x17\x12\xa0\xf0\x00\x3f\xa0\x45\x16\x7f\x81\x6e\x0c\x34\x5e\x16\x85\x40\x89\x43\x95\x0d\x45\x3a\x43\xca\x4d\x2a\xf8\xee\x1d\xd2\x8a\x63\x03\x6d\xba\x10\x20\xbe\x19\x7e\xf3\xf8\x30\xcb\x72\x0f\x0a\xb5\x71\x08\x9b\x60\x46\x27\xe3\x4c\xb8\x81\xfb\xd3\xa9\xfc\x64\x9c\xea\x96\x05\xc4\x7e\xd6\xda\xbc\xc0\xe9\x54\xf1\x69\x88\x2f\x07\x49\x72\x02\xf1\x60\xed\x03\x8d\x81\xf5\x1a\xaa\xb2\xe0\xda\x5a\x78\xf4\x3f\x59\x6c\x00\x89\xd2\xe7\xa9\x2e\xd3\x18\x74\x2a\x73\xcb\xeb\x99\xc6\x1d\xfd\xd3\xbf\x06\x4a\x1a\x6f\xc6\xfd\x1d\xd7\x7b\xf5\xba\xe1\x96\x6c\x07\xa7\x1e\xd5\xc1\xca\x01\xbf\x7b\xab\x90\x02\x88\xcf\x4e\xfb\x9b\x72\x78\xb6\xab\xba\xe9\xba\xac\x74\x21\x4e\x31\x33\xca\xe2\x28\x09\xba\xee\x28\xed\x8c\x01\xbe\x7e\x33\x2e\x22\x69\xe6\x2d\x4c\xb8\xe8\x2d\xc8\xc3\x81\xdd\x54\x6f\x4a\x03\x4c\xd7\x06\xad\xca\x67\x7e\x4f\x94\xd1\x0c\x17\xf7\x79\x3a\x49\x37\x22\x6c\x4d\x03\xdb\xf4\xbc\xff\x5a\x10\xbb\xd9\x5a\xd9\x5b\x5c\x1b\xcb\xc2\x68\xb8\xe3\xd6\xd4\x20\x76\x72\x42\x16\x85\x09\x8e\xdb\xaa\x1a\x96\xb2\x60\x0f\x83\x3f\x67\xb6\x35\xa9\x98\x08\xec\x47\x4b\x1b\x30\x97\x3f\xb4\x78\xc3\xcd\x7a\x55\xd7\x65\xb1\xa6\xc3\xf1\x5e\x65\x90\x42\x61\x0c\xff\x3c\xdb\x7e\x76\xca\x62\xf7\xc8\x2d\x48\x95\xef\x7f\x08\x65\xa4\xc5\x21\x36\x70\x9d\x21\xa3\x52\xcd\xfa\x71\xcf\xa7\xea\xcc\x68\x2e\x79\x0a\x21\xd6\x28\x8c\x33\xf1\x6d\x6b\xca\x22\x6d\x4d\x0b\x99\x4a\xe6\x88\x24\xbe\xcc\x48\xaf\x5c\xfd\x23\x41\xec\x07\xe9\xd2\x9a\x48\xa5\xc8\x6b\xa8\xb4\x95\x31\xa2\xcb\xbc\x3a\xc7\x9d\x62\xcc\xd0\x16\xd8\xbc\xf8\x9f\x68\xe7\xb9\x18\x72\x82\x84\xbc\xf0\x2e\xa5\xf1\x0b\xc9\xbf\xef\xae\x33\x36\x27\xb1\xde\xbd\x6b\x93\xf2\xe1\x8d\xe4\x78\x92\x4f\xc8\xfc\x8a\xaf\x9c\x83\x7c\x6f\xce\x3b\x7c\x4d\x5f\x33\x4e\x2b\xfc\x3b\x00\x00\xff\xff\x74\x62\xde\x03\x85\x03\x00\x00")
+var _golangGetScalarTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x5d\x6b\xdb\x3c\x14\xbe\x96\x7e\xc5\xa9\xe9\x0b\x36\xb8\x82\x17\xc6\x2e\x02\xbe\x28\xa3\x83\x31\x56\x58\xb2\xbb\x31\x8c\x62\x1d\xa7\x5a\x95\xa3\x44\x96\xd3\x74\x42\xff\x7d\x48\x76\xba\x84\x7d\xf4\xc2\x60\x9d\xef\xe7\x39\xcf\x09\xe1\x06\x14\xf6\x9a\x10\x8a\x41\x6f\x48\xfa\xd1\x61\x01\x37\x31\xf2\xf7\x9a\x54\x1b\x02\x88\xd5\xd8\xf7\xfa\x08\x31\x96\x21\x40\xe7\x8f\x3b\xe9\xe4\x16\xc4\xad\x31\xb7\x6e\x33\x40\x8c\x15\x94\x9c\x85\x00\xb3\x63\x69\x9f\x20\xc6\x1a\xd0\xb9\xf4\x59\x57\xf1\xd4\x06\x49\xe5\xba\xfc\xbc\xa7\xa6\x83\x7d\x7c\xad\xa1\x74\x9b\x8b\x76\x7f\x2f\xb7\xb6\xea\xb9\x80\x18\xf3\x38\xb8\x5d\xa3\xda\x19\xd9\xe1\x83\x35\x0a\xdd\x00\xe2\x03\xf5\xf6\xc2\x3d\xec\xcd\x6c\x2d\xda\x36\x5b\xda\xc1\x6f\x7d\xae\xc1\xd9\x41\x3a\x68\xdb\x83\x34\x23\x0e\xf0\xf5\x9b\x26\x8f\xae\x97\x1d\x86\xc8\xd9\x8b\xbd\x01\xb9\xdb\x21\xa9\xf2\x64\xa9\x21\x04\xe8\x35\x1a\x95\xdf\x20\x56\x5e\x7a\xdd\xbd\x4c\x9f\x87\x73\x92\x36\x08\xd7\xba\x86\xeb\x04\x6f\xd1\x80\xb8\x1f\x8d\x91\x6b\x83\x73\x20\x67\xba\x87\xab\x10\x72\x80\xb8\x97\x5b\x84\x18\x85\x1e\x68\x34\xa6\xac\x20\x70\xc6\xda\xb6\xb3\xd3\x92\xae\x75\x72\xa6\x0a\xd0\x40\x2f\xcd\x80\x9c\xbd\x36\xe2\x45\xdd\x3c\x6a\x59\x55\x9c\xcd\xec\x90\x3a\xe7\x20\x91\x02\x0d\xb4\xed\xb0\x37\xeb\x91\x94\xc1\x76\x89\xa4\xd0\x95\x76\xfd\x5d\x28\x2d\x0d\x76\xbe\x86\x73\x0e\x2b\xce\x92\xcf\xd8\xcd\xca\x6f\x7d\x39\xd5\xa8\x5f\xf8\x14\x42\x54\x89\x45\x65\x09\x61\xd1\x40\x8a\x55\x6b\xf1\x60\xed\xe3\xe7\x11\xdd\x73\xd9\xf9\x63\x0d\xf9\x37\xed\x27\xc1\xfd\x84\xfe\xc1\xaa\x05\x00\x40\xf1\xbb\x58\x8a\x9a\x33\xf6\x51\xd3\x14\x00\x53\x6a\x7a\xb7\x4b\x94\x2a\x39\xbf\x24\x76\x27\x6f\x52\xab\xd3\xe4\x7b\x28\xfe\xdb\x17\x20\xb2\x2b\xa9\x96\x33\x96\xd6\x85\x5b\x24\xbf\x98\x81\xd7\x9c\xc5\xea\xc4\x44\x67\x47\xf2\xa0\xc9\xbf\x7d\xc3\x99\xc2\x1e\x1d\xf4\x23\x75\x69\x23\x30\xa1\x29\xe7\xa0\x7c\x01\x15\xc4\x72\x5e\xb9\x26\xed\x4f\xd7\xc1\x59\xba\x8e\x19\xb5\xd3\x07\x74\x22\xcf\xbb\xb4\x4f\xef\x2c\x79\x3c\xfa\x09\xff\x9f\x58\x13\xab\x4e\x52\xba\x45\xa9\x94\xb3\x3d\x94\xbd\x91\xde\x23\xe5\xda\x55\x96\x58\x92\x4e\x6e\xd0\xc0\xb0\x37\xe2\xce\xb9\x7b\xbb\xb4\x4f\x43\x56\x8d\x43\x3f\x3a\x4a\x0a\xf8\x81\xce\xfe\xba\x57\xd2\x26\x6f\x7f\xce\xbd\x6a\x80\xb4\xf9\x67\x46\x9a\x7e\x2b\x1f\xf1\xce\xb9\x32\x61\xcd\xe9\x27\x8a\x1a\xf8\x9f\x9f\x65\x26\x95\x5f\xb4\x0a\x01\x90\x14\xdc\xc4\xc8\x7f\x0e\x00\x64\xc5\x1e\x42\x86\x04\x00\x00")
 
 func golangGetScalarTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -485,12 +529,12 @@ func golangGetScalarTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.get-scalar.tmpl", size: 901, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.get-scalar.tmpl", size: 1158, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _golangHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x59\xef\x6e\xdb\xc8\x11\xff\x2c\x3d\xc5\x44\xcd\x05\xa4\xa1\xd0\x57\x5c\x51\xa0\x3a\xb8\x40\x6c\x39\xad\x51\xc7\x76\x6c\xf9\x0e\x45\x2e\xd0\x51\xe2\xca\x61\x4d\x71\x15\xee\x52\x92\xab\xe8\xdd\x3b\x33\xbb\x4b\x2e\x29\xc9\x97\xdc\xf5\x70\x70\xc8\xdd\xf9\xfb\x9b\xd9\x99\x59\x6a\xb3\x79\x0d\x2f\xe5\x42\xa7\x32\x57\x30\x38\x81\xe8\xda\x3e\xbf\xde\x6e\xbb\xdd\xe3\x63\x78\x73\x3f\xba\xfe\xc7\xf9\xd5\xf9\xed\x9b\xd1\xf9\x10\x4e\xff\x0d\x0f\x72\xf1\xf8\x10\xa5\xf9\xb1\x5a\xc4\x53\x31\x97\xf9\xa3\x78\x7a\x90\xc7\xc9\x64\x1d\x2d\xff\x4c\x1c\xc3\x6b\xb8\xba\x1e\xc1\xf9\xf0\x62\x14\x75\xbb\x48\xf4\x18\x3f\x08\xd8\x6c\x20\xba\xb1\xcf\x24\x3a\x9d\x2f\x64\xa1\x21\xe8\x76\x7a\x93\x27\x2d\x54\x0f\x1f\xa6\x32\xd7\x62\xad\xe9\x31\x89\x75\x3c\x89\x95\x38\x56\x9f\x33\x7a\x17\x45\x21\x0b\x26\x9a\xcd\x99\xa0\x10\xb3\x4c\x4c\xf9\x51\xe9\x02\x39\x97\xf6\x31\xcd\x1f\x98\x4e\xa7\x73\x41\xff\x96\x79\x3a\x95\x09\x3f\xaa\xa7\x7c\xda\xeb\x76\xc9\xe7\x22\xce\xd1\x90\xe8\x7c\xad\x8b\xf8\x82\x4d\x51\x64\x56\x87\xcc\xa4\x07\xa2\x11\x79\x42\x8f\x21\xe3\x70\x53\x88\xa5\xc8\x35\xa0\xa6\x24\x25\x88\xe2\x0c\x52\xcb\x38\x2b\xe4\x1c\xa6\x71\xa9\x50\x37\x4c\xca\x34\x4b\x60\x16\xa7\x59\x59\x08\xd5\x5d\xc6\x05\x8c\xe1\x04\xac\x91\xd1\x85\x96\xb1\xbf\x48\xe6\x46\x97\xb1\xd2\x17\x79\x22\xd6\xd5\x0e\x7a\x19\xdd\x2d\x70\x57\xdb\x25\xb2\x3d\x7a\x57\x22\x3e\x5d\x5e\x41\xe0\x7e\x2e\xe2\xc5\x79\x51\x10\x75\x99\x4f\x03\x84\x08\x8e\xce\x09\xa7\x10\x18\x2e\xd8\x14\x42\x97\x45\x4e\x6f\xe8\xda\xa5\x7c\x78\x10\x85\xa1\x9d\xc9\x62\x1e\x6b\xab\xbf\x0f\x71\xf1\xa0\x20\x8a\x30\xaa\x5a\x14\x33\x0c\xeb\x66\x8b\x5e\x77\x90\x6f\x24\xe5\xbb\x38\x7f\xba\x95\x2b\x85\x7a\x4c\x14\xa2\x2b\xb1\x0a\x7a\x5a\x4a\x98\xe3\x16\x14\xb8\xd7\x0b\x99\xfa\x3e\x57\xe5\x82\x30\x11\xc9\xb0\x48\x97\xa2\x68\xf1\x94\xf5\x3e\x24\x4c\x60\x19\xcf\xe7\x0b\xfd\x74\xbf\xc0\xa8\x8b\x16\x8b\xa0\x1d\x28\x79\x0b\x89\xd1\x2c\x72\x00\x32\xf9\xc0\xae\x7e\x8d\x27\xb0\xe9\x76\xd2\x19\x58\xff\x5f\x9c\x40\x9e\x66\xb4\x66\x11\xb1\x22\x0c\x2f\xb2\xa2\x45\xdb\x2e\x66\xa8\x7e\x5a\x08\x60\x25\x67\x98\x3f\x40\xa1\xe8\x62\x08\x15\x27\x6d\xb5\x3e\xbe\xcf\x1f\x73\xb9\xca\x3d\xca\x13\x48\xa5\x8e\x9b\x34\x2d\x5c\xfc\xcd\x2b\x49\xe8\xfa\x2b\xa3\xf5\x50\xe6\xa2\xb1\x52\x87\xc1\x5f\x3e\x23\x73\x8a\x18\x4d\xfb\x29\x95\x59\x4c\x69\xe9\x6f\x7b\xa8\x12\x70\xb5\x43\x04\x57\x39\xd5\x04\x02\x25\x10\xe3\xdd\xed\xb0\xf1\x15\x77\xb7\x63\x43\x68\xa0\xa5\x6d\xa7\xac\x5a\x7a\x5f\x8a\xe2\xe9\xae\x9c\xcd\xd2\xb5\x5b\xdb\xda\x00\x05\xa2\x4a\x46\x13\xa8\xd0\x52\x90\x52\x97\x97\x11\x6e\x45\x76\xbb\xe2\x5c\x99\xb4\xf6\x04\xd8\x6c\xe6\x28\x56\x49\x5f\x87\xd1\x49\xa3\xb8\xb9\x97\x9f\x9d\x90\x5a\xee\x3c\x7e\x14\xbc\xe4\x3c\x6e\x0a\x16\x7b\x85\xe2\x2b\x8b\x15\x54\x1c\x5f\xb1\x3d\x1b\xfc\x3b\xb0\x67\x4a\xad\x52\x3d\xfd\xc4\xbc\xc8\x34\xc5\x82\x05\x58\xb0\xc8\x25\x13\xd5\x01\x4a\x12\x91\xcd\x8a\xdd\x90\xfb\x0c\x26\xe8\x07\x18\x5c\x46\xd4\x0e\xae\x76\x1d\x2c\xdb\x59\x16\x24\x7e\x04\x3d\x77\x5b\x32\xac\x5f\xa8\xda\xb9\xb6\x93\xb0\x7d\xdc\x24\x4b\x06\xf0\x4c\x56\x13\x91\x79\x1a\xd8\xe3\x8d\x2b\xdb\xda\x40\x51\xe7\x63\xf0\x2d\xd6\x78\x79\xbc\xcf\x8e\xe6\xb6\xa7\x4f\xd7\xa7\x26\xf8\x4c\xa9\x3a\x56\x7e\xae\x7e\x8b\x09\xde\x01\xdc\x67\x42\x6b\xdb\x3b\x17\x03\xf0\x35\x37\xed\x9b\xee\x1e\xdf\x3a\x3b\xfb\xde\xf6\xef\x30\x78\x9f\x95\x7b\xca\x85\x21\x73\xcb\x03\x4f\x67\x65\x2a\x97\x0d\x9b\x49\x55\x59\xe5\xd2\xb1\x16\x53\x83\xeb\xf3\xe5\x37\xa0\x0c\xbf\x15\xaa\xcc\xb0\xc8\x9a\x93\x67\x4b\xc7\x57\x71\x1f\x31\x3b\x21\xdb\x64\xc6\xa5\xaf\xe1\x77\xec\xe4\x8a\x6d\x9c\xb9\xd4\x6f\x6e\x24\x53\xb5\xba\x4d\x22\x94\x4e\x73\x46\x06\x90\x0a\x62\x58\x18\x3a\xea\x53\x99\x54\xea\x09\xc1\x42\x20\x14\x11\x34\x59\x79\x97\xf0\xb3\xdb\xa6\x59\x31\x78\xc3\x53\xaf\xe0\xb2\x3d\xc3\xd3\x6e\x27\x99\xbc\x13\xfa\x93\x4c\x14\x76\xda\x7f\x4a\xf9\xa8\x3c\xa2\xce\x95\x5c\x99\x4e\x1d\x02\x0d\x32\xd1\x08\xff\xd8\xbe\xc4\xa9\x73\xbd\x10\xb9\x3d\xde\x7d\x50\xb2\x2c\x30\x24\x2e\x49\x82\x64\x02\x47\xc3\x53\x86\xcb\x55\x3a\x94\x49\xce\xa3\xee\x31\xed\x3a\x1b\x6c\xfd\xb2\xd1\xdd\xf8\xa3\xd1\x30\x8d\x69\xc0\x32\x63\x11\x17\x2a\x9a\x8d\xae\xe2\xb9\x80\x2f\xc0\x93\xc9\x0c\x7a\xdf\x7d\xee\xe1\x3e\x15\x2d\x23\xd9\xe8\x3c\x01\x89\xe6\x55\xe4\xdb\x6d\x60\x2c\x0c\xfd\xb1\xaa\x93\x88\x59\x8c\x29\x31\x68\x14\xdb\xfe\xc1\x2a\xc6\x6d\xd9\x15\xea\x17\xfb\x0a\x75\xdf\x2f\xf1\x86\x1c\x75\xb8\x91\xa7\xe9\x3a\x23\xb2\x47\x9a\xf5\x23\x3a\xc3\x70\x62\x91\xc2\x15\x94\xb2\xb5\xcc\x34\x13\x59\x16\xec\x06\x96\xf2\x06\x31\x0f\xc2\x1f\xbf\xc5\x2c\x8a\x3d\x82\xf4\x6a\x78\x4a\x94\xc3\xd3\x81\x95\xd5\x37\x36\x4f\x22\x4e\x87\x88\x72\xe0\xc4\x84\x1f\x1f\xbb\xff\xcf\x68\xa1\x8e\x2a\xfd\x50\x47\x2e\x56\x7e\xb4\xc8\xd3\x3f\x1a\xa9\xaa\x44\x51\x52\x50\x17\xad\x06\x03\x39\xf9\x0f\xa5\x67\x08\x16\x63\x08\x9a\x79\x6a\xf9\x90\x2c\x72\xc0\xd1\xf3\xf0\xd4\x05\x25\xdc\x23\x8b\xcf\xc3\x54\xaf\xc1\xde\x21\xb0\x85\xf2\xbf\x54\x3f\x46\xeb\xbe\x27\x5e\x9b\x37\x8a\xa0\x15\x7b\x2a\x1e\xd2\x9c\x62\xfd\x7c\x72\xf9\x06\xd5\x91\xb4\x14\xaf\x46\x6b\xa2\x1f\x61\xbd\xd7\x54\xe5\x51\x8d\x85\x77\xc0\x8c\x54\xa8\x47\xeb\x40\xaf\x43\x0a\xb2\x43\x04\x41\x47\x9d\xee\xfe\x15\xdd\x19\x38\x6f\xd7\x84\x7a\xcb\x41\xac\x31\xb7\x6b\x04\xeb\x08\x77\x6b\x90\x5e\xdd\xae\x37\xc9\x84\x55\x6c\x8d\x3c\x1b\x33\xe6\x1e\x8a\x4c\x68\xf1\x26\xcb\xf6\x21\xd3\x07\x53\x26\x10\x21\xcc\x8e\xbf\xfe\xe5\x00\x46\x98\x29\x0e\xdb\xe7\x10\xfa\x9e\x19\xda\x67\xae\x71\xca\xbc\xe1\xaa\x63\xaa\x04\xca\x76\x80\xea\x35\xda\x35\x9f\xa7\x9a\xc2\xdb\x71\x62\xf9\xf8\x55\x12\xc6\x85\xcc\xb2\x09\xde\x21\xc9\x2e\x64\xb8\xb5\xaf\xf6\xf4\xd5\xdb\xfe\x79\xae\x2e\x0a\x58\xdb\x09\x8d\xd7\x71\x96\x0d\xa0\x22\xa5\x7b\x9a\x48\x06\xf0\xdd\xb2\xd7\xf7\xed\xf1\xe5\x85\x55\x19\x08\x2b\xe0\x51\x7d\xe2\xa3\x5b\x77\xca\xd1\xda\xab\xe3\xf8\xc2\x05\x67\xb4\xee\xd6\x19\x51\x37\x55\x73\x68\x1b\x1c\xba\xe6\xa8\xb2\x9c\xd6\x2a\x5a\x3c\x37\x16\xa8\x03\x07\xc7\x83\xb4\x81\xea\x21\x71\x35\x8c\x5f\x25\xb0\x26\x67\x91\x07\x6a\x10\xaf\xbf\x4c\x26\xec\x27\x7d\x57\x68\x97\x22\x35\x3c\xed\xf1\x47\x06\x26\xd4\xeb\xc3\x84\xa3\xb5\x47\x88\x57\xee\xec\x30\x29\xde\xe4\xb3\x1e\xab\x67\x1a\x3c\x0e\x35\xc3\x76\xeb\xa1\x8c\xa9\xcf\xff\x1d\x71\x23\x36\x76\xc3\x78\x8c\xc0\x4f\xca\x3c\xc9\xc4\xd8\x2b\x87\x48\x60\x0a\xae\x2d\xbc\xad\xea\xd3\x52\x82\x78\x8a\x49\x9a\x27\x81\xaa\xfa\xf1\xce\xbd\x87\x0a\x82\x55\x1a\x39\xea\x76\x51\xdb\x11\x8b\x89\x7c\xa7\xe7\x3a\x50\xf8\xa7\x31\xf1\xe0\xc0\x03\xed\xeb\xae\x67\xfe\xa5\xc7\xe7\xdd\x71\x7f\x43\xdb\x73\x97\x25\x6f\x46\x04\xf9\xe8\x0a\x69\xaa\xea\x39\xd2\x9c\x38\x53\x24\xf1\xec\x22\x91\x57\x2a\x0e\x4c\xbd\xfe\xbc\x1b\xfa\x37\x9d\x46\xcd\xf5\x43\x6b\xb3\xab\x1d\x58\x8e\x69\xdb\xa3\xca\xdf\x9d\x56\x67\x6a\xe0\x51\x53\xa0\x57\x61\x1b\x1b\x1b\xee\x9d\x03\xe0\x5e\xdd\x69\xe9\x18\x18\x62\x6f\x85\x2b\x10\xd1\xf3\x7f\x86\xc9\x66\xd3\x80\xde\xb1\xfb\xd0\xd2\xb6\xef\x8f\x76\x75\x44\x2a\xb5\x21\xdc\x4d\x3f\x89\x79\xbc\xef\x12\xfd\x2b\xb9\x63\xb6\xef\xde\x5f\x22\xf1\xaf\xcf\x4b\xaa\x3a\x91\xab\x33\x38\x61\xba\xca\xd4\xf6\xdb\x1e\x4b\xeb\xb7\x2b\x19\x83\xba\x70\x6d\x34\x37\xbc\xed\xef\x40\x83\x0f\x41\x0b\x11\xd3\x3a\x2b\x38\xaa\x48\x57\x76\xf8\x91\x76\x36\x3c\x13\xec\x03\xc7\xe0\x37\xbf\x16\x1d\x1f\xc3\xe8\x7a\x78\x8d\x7d\x02\xdb\x29\x9e\xfc\x45\x86\x7b\x9f\x64\x86\xcf\xea\xc0\xb7\x24\x59\x6a\x3a\x09\xf5\x77\xbb\x59\xd0\x23\x5d\xd8\x5a\xd4\x2f\x39\x29\xa2\x26\xf3\x4b\x8e\x6d\xc6\x9c\xc4\x05\x02\xad\x9f\x02\xda\xe1\x06\x63\x3f\x46\xa1\x1c\xf7\x01\xca\x1b\xc1\x0c\x14\x86\x05\x3e\x7c\xf4\xec\x75\xa1\x5e\xd8\xdd\x10\xde\xf2\xe7\xac\x60\x66\x6c\xd1\x74\x2b\x86\x29\x14\x65\x2e\xd8\x37\x5a\x7d\xcb\x16\x06\xb3\x3e\xf4\x3e\xe0\x3d\x25\xc7\x71\x60\x19\x67\x38\xdf\xcd\xf0\x84\xa7\x7d\xc0\x17\x72\xc6\xd4\xf5\x85\x6b\xe2\x29\xfc\x1d\xbe\x37\x5d\xb5\x25\x04\xff\xb7\x3d\xb2\x53\x2c\x99\xd3\x7c\x9d\x8d\x7e\x8a\xb3\x52\x5c\xcf\x02\x14\x18\x1a\x21\xc5\x32\xfa\x17\x15\xbc\x90\xe6\x01\x47\x76\xa3\x0b\x23\xd7\x10\x5c\xa8\xab\x34\xb3\xc3\xc3\x8e\xae\xab\xfb\xcb\x4b\xd6\xd6\xa1\x42\x84\xb7\xb5\x52\xd0\xcb\x16\xe8\x2f\x19\x7e\x42\x22\xce\x33\x31\x0f\xc2\xe8\xc2\x01\xe5\x46\x79\x37\x43\xb3\x95\x48\x1d\x05\x84\xac\x51\x65\xbe\xc6\x70\x6a\x0c\x9a\x4e\xce\x58\x33\x8e\xcf\x08\x4d\xe8\x28\xab\x7b\xd9\x7e\x62\x45\xc4\x91\x0d\x06\xd3\xde\xbe\x3d\xfb\xe1\x87\x1f\xfe\x76\x15\xe7\x32\xac\xa4\x7c\xf8\x48\x9f\xbf\x8d\x08\x04\x7f\xdc\x87\x49\x0d\xfd\xd2\x42\x80\xb0\xbc\xb0\xdf\xb1\x11\x9c\x1b\xc6\x82\x02\x1a\x4c\x42\x87\xd2\xae\x01\x7f\x5a\x3b\x73\x3d\xa8\xc0\xc6\x9a\x57\xb7\x5d\xf7\xe7\xb0\xab\xde\xec\xbf\x4b\xb5\x74\x54\x5b\x2e\xd8\xad\x48\x7d\xec\xd9\x09\xc1\x0d\x08\x77\x7c\x78\x95\xfb\xbe\xfe\xd2\x1e\xe6\xaa\x95\x57\x5d\x7e\x5a\x08\x4c\x5b\x6f\xfb\x8c\x17\x0c\x7f\x93\xd4\x7c\x19\xf6\x48\xcd\x87\x20\x8f\xb4\x31\x0e\x58\x42\xbf\x92\x78\x13\xcc\xdb\x54\x64\x49\xfd\x43\x80\x65\xe7\x32\x32\xb2\xa5\xc5\x3b\x94\xf4\xb8\x67\x7c\xbf\x57\xa2\xa0\x9f\x2f\x58\x71\xa7\xb4\x6f\xe3\x79\xe9\x7f\xc3\xaf\xd6\xa9\x14\xfa\xc7\xd9\xca\xf7\x8a\x58\xd0\xb0\x3b\x84\xf1\x28\x9e\x64\xc2\x6b\x05\x60\x4b\x76\x8f\xed\xa4\x4d\xa4\xc3\x31\xa8\xeb\x2c\xb4\x3f\xea\xc4\xd9\x45\x8e\x5a\x75\xed\x65\x8d\x4b\x03\xf1\x03\xe8\x1c\x92\xb2\x83\x55\x13\x7c\x0f\xb1\x7d\x45\x8d\xb4\x37\x82\x78\x40\x3b\xc7\x95\x9c\xfb\x03\x8a\x77\xc2\xcc\x29\x34\xf3\xb2\x67\x57\xc2\xcb\xa9\x96\x7c\x13\xaa\xc7\xcc\x31\x1e\x6e\x88\xde\xe1\x69\xcc\x98\x32\xda\xcd\xb3\xd9\x1e\x67\x3a\x63\x25\x34\x4c\xa4\xc4\xd3\x37\xce\xcb\x2c\x73\xcf\x4b\xaa\x92\x8d\x2c\xf3\x1a\x98\xd1\x8f\xa3\xca\x92\x29\xce\xf0\x8d\xab\x2a\xe7\x42\x4b\xd5\xa6\x8a\x38\x66\x19\x22\xfa\x36\x67\x98\x96\x58\x13\x89\xd7\x5b\x0c\x96\xcd\x2b\xbe\x4d\xa1\xa6\xbc\x0d\x90\xc5\xd8\xe0\x8b\x12\x7b\x88\x31\x73\x00\xe6\xd2\x8a\x89\xf6\x28\xde\x24\x09\x99\xf6\xaa\xba\x77\x2e\x61\x5b\x5d\x6b\xa3\x2b\x74\xd1\x66\xe3\x8e\x37\xe3\xdb\x78\x65\x3d\x1a\xb9\xc9\x64\xc7\x19\xaa\x7b\xcb\x3d\x1f\xe5\x7d\x39\xa4\x24\x68\xcc\x8b\x3e\x66\xbb\xb6\x1e\xd5\xb6\x86\xfb\x60\xb6\x02\xf7\x18\xf3\x35\x10\x51\x54\xcd\x0b\x78\x07\x78\xd6\x62\x0a\x21\x55\xb9\x51\x43\x19\x00\x1b\x27\xfb\xc5\x2c\xe2\x1c\xf9\xf2\x05\xf0\x89\x53\xc4\x3c\x9a\x0c\xb1\x48\xf8\xf7\xfc\x83\x1a\x98\x03\x15\x78\xb5\x05\x18\x8c\x3d\x3a\xaa\x0a\xc2\xd2\x7f\x74\x6f\x95\xde\x46\x25\xf2\x95\x8c\xcf\x64\x56\xce\xf3\x43\xb5\xc8\xec\xfa\xc5\x88\xac\x7e\xdd\x3c\x9a\xee\x53\xfd\xfd\xe8\x2c\xd0\x75\x53\xf5\xbe\x7b\x7a\xe0\xeb\x88\xc8\xfc\x2f\xfc\x43\xfa\x31\xe1\x30\x1f\x4e\x72\x8f\x42\x2c\xa0\x5c\x80\xfe\x24\x60\x8e\x3d\x50\xe3\x48\x83\x65\x9a\xbe\xe0\x2a\x89\xab\x31\x72\x63\x61\x50\xe6\x2a\xa2\x60\x22\xf4\x4a\x88\x9c\xe5\xfc\x57\xe6\x42\xc1\x2a\xcd\x32\x16\x55\x75\x51\x2d\xdd\xec\x82\x65\x41\x2e\x44\x91\x3d\x45\x9e\x91\x23\x6c\xce\x53\x36\x8c\x6c\x79\xc7\x4a\xd9\xe8\xe3\x63\xfa\x2d\x19\x77\x69\x03\xec\xe7\x33\xa0\xc6\xcf\x3f\x1b\x13\x84\x38\x94\x23\x9a\xc8\x3c\x17\xb9\x56\xc4\xd1\xe5\xd2\xf6\xfe\xd2\xf6\x17\xd8\x56\x82\x08\xc2\x6f\x14\xf6\xbf\x00\x00\x00\xff\xff\xf9\xf0\x5d\x52\xf1\x1f\x00\x00")
+var _golangHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\xef\x73\xdb\xb8\x72\x9f\xc9\xbf\x62\x9f\x7a\x97\x21\x33\x3a\xfa\x75\x5e\xa7\x33\xd5\x8d\xfb\x26\xb1\x7d\xad\xa7\x89\x93\xb3\x9d\x7b\xd3\xc9\xcb\xf8\x28\x12\xb4\xf8\x4c\x01\x0a\x00\xfd\x70\x75\xfa\xdf\x3b\x0b\x2c\x40\x90\xa2\x14\x3b\x97\xeb\xa7\x7e\xb1\x45\x60\xb1\xbb\xd8\x5d\x2c\x16\xbb\x20\xb7\xdb\x1f\xe0\x3b\xb1\xd0\xb5\xe0\x0a\x26\xa7\x90\xbd\xa3\xdf\x3f\xec\x76\x71\x7c\x72\x02\xaf\x3e\xdc\xbe\xfb\x8f\x8b\xab\x8b\xeb\x57\xb7\x17\xe7\xf0\xfa\xbf\xe1\x5e\x2c\x1e\xee\xb3\x9a\x9f\xa8\x45\x5e\xb0\xb9\xe0\x0f\xec\xf1\x5e\x9c\x94\xd3\x4d\xb6\xfa\x67\x1c\x71\xfe\x0e\xae\xde\xdd\xc2\xc5\xf9\xe5\x6d\x16\xc7\x8b\xbc\x78\xc8\xef\x19\x6c\xb7\x90\xbd\xa7\xdf\x88\xba\x9e\x2f\x84\xd4\x90\xc4\xd1\x68\xfa\xa8\x99\x1a\xc5\xd1\xa8\x10\x5c\xb3\x8d\xc6\x9f\x65\xae\xf3\x69\xae\xd8\x89\xfa\xdc\xe0\x33\x93\x52\x48\x03\x54\xcd\x0d\x80\x64\x55\xc3\x0a\xf3\x53\x69\x59\x08\xbe\xa2\x9f\x35\xbf\x37\x70\xba\x9e\x33\xfc\xbf\xe4\x75\x21\x4a\xf3\x53\x3d\xf2\x62\x14\xc7\x38\x67\x99\xf3\x7b\x06\xd9\xc5\x46\xcb\xfc\xd2\xb0\xa2\x60\xb7\x8b\x23\x64\x13\x7f\x20\x0c\xe3\x25\xfe\x4c\x8d\x1c\xde\x4b\xb6\x62\x5c\x43\x21\x78\x59\xa3\x88\xf2\x06\x6a\x1a\x58\x49\x31\x87\x22\x5f\xaa\x9a\xdf\xc3\x74\x59\x37\x25\x54\x79\xdd\x2c\x25\x53\xf1\x2a\x97\x70\x07\xa7\x40\x4c\x66\x97\x5a\xe4\x61\x23\xb2\x9b\xbd\xc9\x95\xbe\xe4\x25\xdb\xf8\x9e\x6a\xae\xb3\x9b\x85\xac\xb9\xa6\x26\xe4\x3d\x7b\xbb\xd4\x6c\x13\x9b\x96\x24\x8e\xfe\x26\xf3\xc5\x85\x94\x08\xbd\xe4\x45\xc2\xa4\x84\x97\x17\x28\xa7\x14\x8c\xb8\x60\x2b\x99\x5e\x4a\x8e\x4f\xbb\x38\x7a\x23\xee\xef\x99\xb4\xb0\x95\x90\xf3\x5c\x13\xfd\x31\xe4\xf2\x5e\x41\x96\x65\x35\xd7\x4c\x56\x79\xc1\xb6\xbb\x34\x8e\x23\x26\xe5\xad\x10\x6f\x73\xfe\x78\x2d\xd6\x0a\x4e\x11\x91\x90\x2a\xbb\x62\xeb\x64\xa4\x85\x80\x79\xce\x1f\x41\x8a\xb5\x1a\xa5\x06\xfa\x03\x57\xcb\x05\xca\x84\x95\xe7\xb2\x5e\x31\xd9\x1b\xb3\x6c\xfb\xa1\x34\x00\x34\xf0\x62\xbe\xd0\x8f\x1f\x16\x65\xae\x59\x6f\x08\xc3\x1e\x58\x9a\x2e\x02\xbe\xe4\xab\xbc\xa9\xcb\x1b\xb4\x9f\x2e\x70\x6d\x7b\x40\x09\xa9\x47\x69\x9c\xc6\x31\xce\x16\x1a\x71\x6f\xe4\xf2\x94\x69\xc3\x36\x8e\xea\x0a\x48\x58\x7f\x3a\x05\x5e\x37\xd8\x46\xe2\x23\x14\x76\x6c\x96\x65\x69\x1c\xed\xe2\x5d\x1c\xeb\xc7\x05\x03\x43\xe4\x4c\x94\x0c\x50\x6f\x71\x21\xb8\x32\x16\xee\xdb\xef\x3e\xf0\x07\x2e\xd6\x3c\x80\x3c\x85\x5a\xe8\xbc\x0b\xd3\x13\x62\xd8\x79\x25\x50\x15\x61\xcb\xed\xe6\x5c\x70\xd6\x69\x69\x75\x16\x36\x9f\x21\x3b\x32\xaf\xb9\xfe\xa5\x16\x4d\x8e\x36\x1c\x76\x07\x2a\x08\x9b\x03\x61\x87\xcd\x67\x33\x56\x3c\xb4\x78\xd2\x50\x02\x68\x56\xcb\x42\xa3\xd4\xd0\x3c\x8d\x82\xe2\xc8\xcc\xd6\x23\x88\x23\x32\x10\xab\x8b\x38\x6a\xb9\x23\xf5\xc4\xd1\xcf\x4b\x26\x1f\x6f\x96\x55\x55\x6f\x5c\xdb\x8e\x34\x9a\x30\x6f\xea\xe6\x5f\x92\x12\x04\x12\x75\x56\x9f\x5d\x48\x99\x51\xb7\x1f\xb9\xb6\x8b\x26\x61\xfd\xb5\x62\xd4\xee\x97\x54\xab\x77\x87\x0d\x15\xed\x1e\x08\x2c\x61\x2d\xde\x79\xfe\xc0\x4c\x93\x9b\x71\x17\x31\x1b\x44\xca\xeb\xc6\xa0\x65\xe8\x7a\x5f\x18\x7e\xb6\x17\x52\x4e\x68\xc5\xaa\x75\xad\x8b\x19\x3e\xe0\xa0\x22\x57\x0c\xd4\xe7\x06\xa7\x64\xcd\x60\x12\x47\x11\xcb\xc8\x8c\xf6\x6d\x24\x1c\x60\xad\xe4\xc0\x00\x67\x42\xed\x04\xd7\xfb\x13\x0c\xd6\xae\x55\x5d\x52\x86\x1a\x0c\xa6\xdb\xc3\x41\xf3\x8a\xa3\xc8\x4d\x6d\xcf\xc2\xc7\x71\x64\xec\x63\x02\x47\x96\x01\x02\xd9\x5f\x13\x72\x1e\xe3\x38\xda\xb5\x0c\xb2\xd6\x80\x93\xe7\x70\x13\x18\xfe\x10\x1f\xdd\xee\x80\x1e\x39\x1b\x74\x43\xc9\x67\x34\xd5\x3b\x15\xda\xea\x73\x58\x08\x16\xd9\x10\x0b\xbd\xee\x60\x5d\x4c\x20\xa4\xdc\xe5\x4f\xb7\x6e\xe0\xf7\xf2\x17\xec\x02\x43\xfc\xf5\xba\x9f\xc8\x5f\xb1\xef\x8f\xda\xd5\x33\x0e\xba\xbf\x82\xe1\x21\x2e\x07\xfc\x9f\x05\x73\xcd\x93\x80\x66\x8f\xd5\x8e\xb7\xfb\x63\xb9\xec\x90\x7a\x02\x83\x18\xb1\xdc\x14\x33\x36\xcf\x6f\xec\x7a\x01\x8a\xdc\xe6\xf5\xbd\x34\x48\x6e\x34\x5b\x40\xad\x20\x07\x85\xbf\xf2\xc5\xa2\xa9\x59\x09\xd3\x47\x78\x6b\x40\x58\x66\x9d\x77\x77\x40\xeb\xc4\x57\x4c\xaa\x5a\x70\xdc\xd4\xfe\xf5\x5f\xe2\x88\xe7\x73\x06\x00\x34\xdf\x38\x52\x9f\x1b\x08\x9e\x43\xe2\xec\x76\x03\x45\xde\x34\x0a\x2a\x1c\x0e\x39\x68\x99\x73\x95\x17\xc8\x16\xe4\x95\x66\x12\xe4\x92\x73\x74\xdc\x7a\xc6\x40\x31\xbd\x44\xca\xb9\x66\x73\xc6\xb5\x42\x4c\x7a\x96\x6b\x28\x24\xc3\xd0\x20\xe7\x25\x34\xa2\x78\x30\xc0\xe5\x74\x73\x47\xac\x29\xd0\xf9\xb4\x61\x63\x58\xe4\x4a\x39\x64\x6d\xdf\x2c\xd7\x88\x29\x97\x0c\xf2\x46\xb2\xbc\x7c\x74\x42\xc8\xe0\xc6\x13\x33\xfd\x88\x80\x95\xa0\x05\x06\x0d\x37\x7a\xae\x61\xca\x2a\x21\x19\x62\x7c\x44\x5e\x33\x72\xf7\x6e\x7e\x49\xa1\x37\x40\x81\x6b\x76\x66\xff\x8f\xa1\x9c\xc2\x4b\x74\xd5\xe7\xaf\xc7\x34\xa9\x8f\x9f\x28\xea\x88\x23\x87\x19\x11\x25\x4a\xcf\x8f\x07\x24\xe3\x38\xaa\xb8\x8d\xda\xf4\xc6\x62\xbd\xdd\x8c\xbd\x16\xe7\xf9\xe2\xa3\x51\xcc\xa7\xa9\x10\x0d\x59\x5f\x0a\xad\x8d\xa6\xb0\x8d\xe3\x48\x6f\xc6\xf8\x88\xfb\x4c\x39\xcd\x5e\xb3\xfb\x9a\x5b\xde\xc7\xb8\x27\xa5\x7e\x8f\x0a\x02\x1e\x32\x5f\x26\xa5\xd9\x19\x4a\x56\xb9\xe8\x11\x51\x46\x03\xbb\x1a\x86\x80\x70\x0a\x7a\x93\x9d\x89\xf9\xbc\xd6\x49\x8a\x8d\x16\x4f\x1c\x45\xbb\xd8\x8f\xba\x93\xa2\x69\xa6\x79\xf1\x80\x0c\xe9\x4d\x76\x4d\x8f\x49\xfa\x63\xb7\x3b\xe0\x27\xf2\x61\xdc\x88\xac\x6b\x02\x1e\x0e\xe3\x6d\x56\x4e\xe0\xfb\xd5\x68\x1c\xee\xc5\x1e\x53\x8a\xbc\xec\xe2\x68\x97\xa4\x71\x1c\x55\x42\xc2\xdd\x18\x8c\xf0\x27\xa7\x74\x1a\xb0\x8a\xc2\xa9\x91\x86\x8c\x72\x70\x5c\x5d\xc1\x9d\x97\x9f\xde\x64\x17\x1b\x56\x90\xae\xad\x0c\x0d\xe0\x8f\x7d\x09\xd2\xd4\xb1\x99\x88\xc7\x71\x64\x96\x70\x6b\x9c\xa7\x30\xba\xb9\x78\x73\x71\x76\xeb\x9a\xe0\xa7\xeb\x77\x6f\x3b\xc6\x3d\xf2\x26\x93\xb8\xa6\x34\x8e\x30\xec\x0e\x79\x32\x5e\xb7\xc3\x54\x00\xfc\x45\xed\x3a\x73\x9a\x9c\xf6\x2c\x6a\xbb\xb3\xd2\x42\x72\xd9\x15\xe2\xb6\xda\xc7\x33\x48\xcf\x31\x38\x8b\x40\x81\x22\xf4\x4d\x91\xf3\xe4\x05\x01\x0d\x49\x07\x81\xce\x1a\xa1\x58\x92\x0e\x49\xcb\x31\xf5\x91\x50\x7c\x42\xdb\x92\x4b\x66\x18\xee\xd1\xc2\x7d\x6b\x9f\x44\x8f\x42\x6f\xce\x9d\x5e\xef\xae\x2b\x9e\xe8\x76\x7d\xa5\x3e\xb8\xa7\x68\xc7\x2f\x4d\x94\x42\xcf\x0e\xf6\xfd\x80\xd9\x77\x8f\x2e\x6f\x3c\x1f\xa0\x0b\xcd\xae\x99\x5a\x36\x7a\x4c\x8b\x96\xa2\xdf\x6f\x81\xdc\xb8\x8c\x6b\x67\x2d\x2d\xee\x6b\xb1\x7e\x3a\x7a\xd4\xc6\x10\x01\x29\xd6\xe4\xf1\xa5\x58\xe3\x26\x83\x9e\x79\x91\x4b\x0d\xa2\x02\x47\xd9\x78\x60\xf4\xa0\x70\xcf\x38\x43\xbf\x59\xc2\x9c\xe9\x99\x28\x15\x2c\x15\x1b\x83\x12\x16\x24\x47\x37\x4d\x82\x2e\x72\x0e\x92\x99\x0d\x2d\xe7\xb4\xa9\xae\x6b\x3d\x13\x4b\x0d\xb5\x52\x4b\xe7\xe6\x8d\x88\x69\x07\x33\x3c\x38\xf6\x50\x41\xc6\x04\x4b\xa6\xf4\x1e\xe3\x06\xa1\x57\xae\xfa\xdc\x9c\x09\xce\xff\x5f\xbb\x5d\xed\x3a\xe4\xa4\x62\xf5\xb9\xa1\x53\x9b\x5c\x72\x65\x38\xac\x99\x82\xb2\x96\xac\xd0\xcd\x23\xe0\x9e\xee\xf6\x3d\x10\xd2\x6d\x56\xa4\x9c\x76\x74\x1b\x5a\x90\xdc\x7d\xa0\x95\x94\x2d\x58\x0a\x5f\x33\x91\xc1\x79\xa0\x5d\xb4\x01\x59\x99\x11\xd9\x6c\x80\x00\x21\x0c\x0e\xf8\x98\x0c\x82\xba\xf2\x69\xb2\x8c\xe2\xac\xf7\x92\x2d\x72\xc9\x4c\x8e\x08\x85\xa3\xe7\xfa\x2c\x2f\x66\x0c\x66\xa2\x29\x15\x2c\x5c\x77\x1b\xce\xc0\x03\x7b\xb4\x81\x97\x9e\xb1\x5a\x82\x64\xbc\x64\x08\x72\xf3\xf3\x9b\x0c\x2e\xd1\xae\x71\x01\xa8\xbc\x62\x80\x3e\xb7\x10\xbc\x58\x4a\x89\x69\xa7\xa5\x72\x51\x5a\x4b\xa7\x15\x23\xd2\x02\xbb\x37\x7f\x51\x46\x29\x24\x46\x2f\x18\xdc\xb4\x36\x33\x5f\x9a\x10\x0e\x93\x4c\xd7\x7f\xb3\x69\xa6\x08\x29\x29\xb3\x1d\x58\x33\xf9\xe4\xc7\x79\x7d\x71\xb6\xc6\x67\xc3\x4f\x62\xb8\x78\x32\x13\x71\xb4\xc7\x46\x0a\x2f\xdb\xd9\xb5\xfa\x7a\xe1\x1b\xd1\xa7\x23\x95\x09\x18\xf1\xa2\xdd\x62\x9f\x9a\x0c\x72\xb9\xdd\x8d\x29\x3b\x73\x72\x02\x9c\xad\x6f\x37\x9e\x57\xb0\xce\xde\xc6\xc4\xae\x0d\x45\x8e\x1e\x25\x50\xd8\x52\xb1\x12\x03\x57\xbd\xc9\xe0\x76\x26\x14\x43\xfd\xb8\x18\xb2\xe6\x80\x1a\xe6\xda\x44\x8e\x92\x4d\xc5\x92\x9b\xd0\x11\x91\x74\x02\x5d\x6c\x9e\x31\x90\xe8\x88\x72\x69\x90\x78\xf3\xc0\xc0\x5a\xfb\x35\x34\x06\x55\xf3\x82\x91\xf5\xa0\x8f\x13\xdc\x8c\x75\xcb\xaa\x10\xcb\xa6\x84\x75\x5e\x6b\xea\x41\x64\x85\xe0\x9c\x59\x5a\x7d\xda\xb5\x32\xf6\x58\xf3\x7b\x9c\x41\x67\x72\xf3\xa5\xc2\xf0\x16\x0a\xdc\x02\x4b\xc4\xd3\xc6\xba\x1d\x1c\x8c\x97\x8a\xe2\xde\xae\x18\x3b\x11\x29\xc9\xa2\x55\xe1\x01\x75\x76\x6c\xe6\x19\xe6\xb2\x6f\x2f\x2e\x0c\x45\x8a\x63\x10\x26\x98\xb4\x5c\x64\x8d\x10\x0f\xcb\x85\x3d\xf7\xa6\x3f\x62\x1f\xc2\x46\x27\x27\x80\x08\x88\x08\xcc\xea\x92\xa1\x0d\x14\x39\x2f\x58\xc3\x4a\xc7\x85\x51\x79\x28\xac\x31\xac\x67\x4c\x32\x42\x51\x6b\x58\x1b\x35\x14\x62\xce\xc0\xc4\x9f\xb8\x2b\x89\xca\x8a\x32\x8b\xa3\x30\x16\x2a\xf4\xe6\x40\x78\xe2\x23\x12\x5e\x37\x63\x8a\x7b\x30\xb6\xf1\xed\x7a\x93\x05\xec\x06\xa1\xa6\x89\xda\x29\x4a\x6a\x61\xc9\x23\x75\xc0\xad\x04\x3a\xa7\xd9\xa4\x08\x14\x93\x42\x28\xaa\x56\xde\x81\xb0\x31\x0c\x34\x41\x5f\x91\xcd\x97\xd9\xf5\x1b\x51\x3c\x60\x38\x65\x0f\x04\xb6\xed\x03\x6f\xa8\x35\xd4\x45\x91\xe1\x93\xfa\x68\x78\xf8\xe4\x0d\xc0\x81\x1c\x62\x88\x96\xc6\xd7\x7a\x11\xe4\xb4\x67\x14\xc5\x01\x7b\xe8\x30\x44\xc9\x38\x37\x05\xa7\xbd\x0c\xd9\xe9\xca\xb2\xae\xfa\x9a\xdc\xd3\x23\x9e\x75\x8c\x68\x06\xa4\xd5\x0a\x0b\x11\x6d\x6a\xa5\x71\xeb\x1a\x94\x99\xe7\x14\x79\x1a\x88\x64\xfd\x60\x97\x49\xec\x0e\x37\x25\x86\xb9\xee\x49\x1e\x61\x0f\x88\xde\xd8\x6f\xd2\x3f\x3e\x3e\x61\x26\xe8\x3b\x69\xe3\xec\x1e\xaa\x88\x21\xb7\x54\x0d\x81\x3b\x92\x6d\x38\xa9\x1f\x83\x2e\x12\xec\x8b\x17\x07\x0e\x98\x1e\x92\x56\x40\xc9\x1a\xa6\x59\x42\xa4\x5a\x3d\xb5\xf9\x4c\x84\xdd\xc5\xa1\xdf\x1d\x0a\x64\xf4\x4c\x8a\xe5\xfd\xac\xbf\x09\x98\xc4\x85\x17\x12\x6d\xc3\x3d\x3c\xed\x5e\x5c\xa0\x11\x07\x42\x6d\x45\x5d\xc2\xcb\xee\xa8\x14\x9e\x17\x62\x1e\x88\x6e\x92\xfd\x00\x13\xb6\x3d\x33\x2e\x33\xc3\x57\x46\x0c\x7c\x85\x3d\xbb\x36\x44\xbb\x7f\x00\x0e\xa2\xa5\xc3\xb3\x7d\x66\xd0\x7b\x68\xba\x7b\x21\xef\x1f\x3d\xdb\x3e\xdf\xcf\x99\xee\x37\x0c\x5f\xbf\xc1\x14\x99\x94\xd7\x62\xbd\x65\x94\x80\xdc\x0d\x4f\xb5\x1f\x11\x87\xb3\x3d\x39\x41\xd4\x78\xa8\x33\x19\x45\x64\x6d\x8d\x81\x11\xdc\xb4\x07\x36\x5c\x49\x8c\x8e\x6c\x6d\x20\x53\x6b\xd5\xae\x2a\x5a\x45\x84\xaa\x5d\x3d\xde\xef\xb4\x92\x95\x44\x30\x85\x2f\x9c\xe6\x82\x18\x43\x66\xa8\x47\x13\xb9\x53\x15\xd7\x15\x4d\xb9\xd0\xaf\xde\x0b\x33\xb8\x57\x3c\x44\xc4\x35\x37\x09\x50\xe0\x42\x43\x0e\x0b\x0b\x87\x65\xc7\x46\x28\x85\x26\x40\xa9\x88\xde\x50\xd3\x0b\x85\xef\xb6\xb5\x47\x33\xc3\xf3\xd7\x41\x9c\x4e\x41\x5c\x1c\x95\xd3\xb7\xf6\xfc\x1b\xc7\xd1\x7f\x0a\xf1\xa0\x02\xa0\xe8\x4a\xac\x5d\x9e\x0d\x8b\xd8\xd9\x6d\x3d\x67\x98\x3a\x3b\x39\x81\xd7\x26\x40\x33\x4a\x1a\xe3\x99\x44\x31\x3d\xc6\x53\x37\x26\x59\xf1\x64\x61\xba\x81\xad\xd0\xa6\xfa\x67\x6d\x8b\x01\x8f\xce\x4c\x19\x65\xd0\xc9\x39\x8a\x02\xac\x47\x0e\x11\x35\xaf\x84\xb5\xe9\x4b\x5e\x89\x94\x38\x7a\x85\x49\xdc\x83\x0c\x09\x5e\x1c\x62\x07\x66\xb9\xb2\x28\xaa\x9a\xd7\x6a\xc6\x4a\x73\xbc\x37\xa6\x83\xd3\xc6\xa8\x58\x0b\xf1\x30\x36\x2d\x7c\x39\x9f\x32\x89\x49\x05\x89\xa5\xe8\x5a\x53\x04\x8f\x34\x30\x6c\xc2\xb0\xbc\xaa\x58\xa1\x59\x39\xf6\xf1\xb6\x31\x24\xc4\x53\x2b\x02\xc7\x30\x38\x8e\xa2\x96\xe9\x27\xcf\x17\xcf\x1a\x51\xb9\xb4\x19\x75\xc3\x60\x76\x4e\x4f\x63\xe2\x09\xb3\xb0\x76\x7d\xba\x53\x55\x5b\x1a\x36\x78\xfe\xab\xe6\x25\x2d\xf5\xa0\x3a\xec\xbb\xee\xce\x6c\x96\xbb\x85\x3d\x85\x91\xcd\x7c\x8f\x42\xb0\x6b\x96\x97\x00\x5d\x30\x3c\x91\x74\x80\xa8\x98\xde\x01\xa2\x2a\x7a\x08\x76\xce\x1a\xd6\x27\x69\xb7\xd3\x91\x37\x61\x2f\x83\xc0\x48\xad\xf9\x86\x65\x00\x33\x1a\x20\x64\x2c\x8e\x6e\x31\x2d\x1f\x56\x07\x22\x9f\x6f\x77\x2d\x7e\x9d\x8b\xe9\x3f\xe0\xe5\xf9\xeb\x14\x66\x42\x3c\x18\x9a\x4f\xb2\x43\x5c\xd4\xa5\xe0\xcc\x6a\x72\x58\x13\x2e\x26\x14\xd3\x7f\x64\x66\xb9\x65\xa1\xc9\x07\x6e\x72\x10\x00\xd9\xb0\x64\x53\x97\x7a\x6c\xe1\x02\x53\x0a\x02\x15\x72\x43\x86\xa5\x96\x1b\xb3\x55\x59\x9f\xab\x34\xe6\xc8\x30\x73\x8b\x96\x74\x25\xd6\x49\xea\x9d\xd7\x91\x89\xf4\x98\x6c\x89\xb7\x3c\x8e\x2d\xca\x1b\x3c\x46\x26\x86\x4c\x6a\x0d\xd4\x08\xc4\x19\x25\xd2\x80\x77\x0b\xc6\xa9\x8a\x8b\x39\xb8\xa5\x2c\x18\x69\x25\x85\x04\x4b\x18\xe7\xaf\xfb\xc4\xd1\x8b\xaa\xcf\xcd\x5d\x5b\xe0\xf0\x65\x6a\xca\xdb\x6d\xc3\xfb\x35\xe7\x75\x8e\xb7\x74\xec\xdd\x1a\x53\x8f\xc6\x72\xd5\x15\x56\x90\x7e\x03\x73\xbd\xa5\x82\xd1\xf7\x9f\x47\xb0\xdb\x61\x6d\xda\x62\xb6\x34\x4f\x41\x2c\x18\xf7\xe0\xbb\x5d\x62\x39\x4c\xc3\xbb\x39\x51\xc9\xaa\x7c\xd9\xe8\x49\x2b\x73\x73\x96\x3a\x54\xac\xf6\x0a\x3c\x12\x01\x04\xd5\x83\xb4\x5f\xf9\xe8\x4e\xbd\x53\x07\x09\xb0\xd1\x3c\x82\x78\x1d\x95\x8e\x61\xda\x5d\x39\x4d\x63\xcf\x00\x86\xc0\xa6\x2d\x7b\x5f\xf3\xfb\xa1\xfc\xf5\x11\xb6\x70\x13\x81\x53\x78\x71\xfe\x1a\x99\x38\x7f\x3d\x21\x5c\x26\xe7\x11\x95\x53\x32\x11\xdc\x4c\x5a\x2b\x8b\xbf\xa5\xb6\xca\x69\xe6\xf7\x31\x38\xc5\x0c\x4b\xa8\xad\x72\xfa\xfb\x35\xe5\x97\x44\x39\xed\x1d\x5b\xbc\xb3\x38\x1b\x3c\xb1\x20\xe1\x2f\xa4\xec\xe8\x2c\x81\x2b\xd9\x9c\x27\xf0\x08\xa9\xe8\x50\xd6\x1e\x45\x26\x16\xe2\xfc\x75\x7b\x4e\xe9\x9d\x49\xf6\x8e\x24\x6d\x44\x85\x23\x3b\x6a\x43\xb6\x58\xa3\x18\xec\x86\x81\xba\xa4\x3a\xf2\xdb\x9f\xb9\x59\xbd\x03\x1e\x12\x13\x7c\x58\x21\x6c\x17\x6d\x50\xfc\x23\x0a\xa6\x00\xe8\x4e\xa0\x87\x6d\x6e\x6f\x02\xa1\x4e\x5e\xdc\x6e\x10\xfe\x76\x33\x01\x8d\xa5\xfd\x48\x6f\xc8\x18\x26\x46\x66\x78\x9d\xc0\xd5\x18\xf5\x26\x45\xbb\x74\x4a\x1c\x4e\xa8\x5e\x6f\x50\x2e\xbd\x59\x5e\xb1\xf5\xf5\x26\x49\xe1\xe5\xf5\x26\x88\xef\x5e\x5c\x6f\xb6\xe5\xd4\xd0\xd9\x75\xc2\x3c\x33\xda\x6e\x6a\xaf\x9a\x66\x78\x03\xc1\x05\x8c\x02\xec\x7b\xe6\x7e\x95\xd4\x09\xf8\x98\x98\xfe\x3c\xfe\x8a\x02\x69\x39\xf5\x52\x0d\x6a\xa5\x7f\x54\xb1\xd4\x6e\xe7\x3f\xe4\x4d\x73\xa8\x5e\x1a\xf0\x73\xa8\x64\xea\xe6\xab\x37\x59\x19\x4a\xb7\xad\x8f\xdd\x6e\x82\xf0\xe0\xd6\xe7\x05\xe3\xd6\x2c\x3c\x68\x69\xfd\x4c\x67\x44\x9b\x49\x7c\xd2\xe2\xc5\xb3\x90\x0a\x4f\xd9\x43\x4b\x05\x71\x7a\x5a\x29\x38\x41\x7f\xb5\xaf\xd0\x1b\x9b\x61\xc8\x28\x4d\x62\xec\xa2\x30\x58\x9d\xb3\xd0\x9b\x2c\x50\xe9\x21\x67\xe1\x87\x84\xde\xe2\x8b\x9e\xc2\x01\x74\x69\xa4\x4f\x99\x7a\x6b\x32\xdf\x76\xf2\xce\x54\xba\xd3\xef\x19\xe8\xbe\x00\xc2\x61\xbf\x43\x04\x2d\x9d\xbe\x10\x0e\x6c\x6a\x06\xea\xbb\x72\x6a\xac\x70\x72\xba\xbf\xb7\xa9\xf3\xd7\x23\xf8\x81\xee\xff\x7e\xa7\x37\x87\x01\x6f\x37\x01\x60\x3d\x5f\x34\x87\x41\x2f\xe7\x8b\x06\xf7\x4c\xb2\xfe\xed\x36\x18\xb0\xdb\x05\x6b\xa0\x9c\x62\xa4\x0c\xe8\x9d\xe2\x88\xb4\x07\x77\x77\xea\x73\x33\x5d\xf2\xb2\x61\x77\xc1\xfe\x1a\x47\xb4\x83\xd3\x4e\xfe\x24\x4d\x1a\x05\x02\x7c\x69\xdd\x18\xe7\xdb\x63\x33\x85\x6b\x36\xad\x79\x99\x28\x1f\x22\xee\xdd\xb8\x44\xaf\x4f\x6c\x67\x0e\x3a\xfd\x12\xda\xf0\xae\x05\xa1\xf4\x19\x07\xe8\xdf\xcc\x0d\x04\xf0\x26\x18\xd7\x4d\x51\x7c\xa1\x5c\xf7\xc3\x6e\x77\x94\xa1\x30\x1a\xd8\x4b\x2c\xe0\x14\xbb\xcb\xc0\x5e\xc1\x62\xfc\xcb\x88\x03\xeb\x76\x0b\xd0\xa3\x0f\x2e\x76\x41\x81\x57\xc0\x5c\x3e\x18\x09\xd6\xaa\xbd\x03\x66\x6f\xc0\x98\x05\x82\x0b\x90\xd2\xc3\xe8\x87\x70\x54\xe7\xda\xc9\xfe\xad\xb5\xf0\xbe\x1a\x39\x77\x0f\xec\x3b\x8e\x8d\x38\xb0\x54\x43\xd3\x2e\xa7\x83\x86\x6d\x6c\xba\x2f\x13\x6f\x1b\x7b\xb1\x23\xae\x81\x14\x5e\x76\x11\x3e\xcd\x5d\x19\xf5\xa0\xe8\x3a\x85\xa6\x72\x9a\x9d\xbf\xee\x55\x48\xda\x6d\xed\x45\x87\x10\x0a\x11\xa3\x8b\x72\x8a\x61\x4d\x8f\xe7\x09\xbc\xe8\xb5\x20\xb8\x81\xc7\xb5\x4b\x83\x68\x75\x4e\x00\x5e\x74\xd3\x82\x5b\x93\x87\x9d\x98\x6c\x9b\xc2\x02\xa5\x2f\x61\xe2\x51\x1a\xd3\xd7\xa8\x17\x0c\x96\x06\x1d\xe0\x1f\xc8\xa8\x2f\xbc\x6f\x8d\xac\x76\x3d\x46\x0e\xf9\x08\xcf\x0c\xe6\xe7\xf0\x1a\xe2\xd0\x5d\xec\x5f\x83\x5b\x8a\x3f\xbf\x81\xdd\xee\xd7\x98\x52\x72\x81\xda\x6d\xfa\x03\x17\x35\xbe\x6b\xe0\x2e\xcc\x75\x4e\x28\x21\x08\x4a\xe5\x57\x7a\x5f\xe3\xd7\x71\x8f\xc9\x41\xd4\x97\xd5\x95\xd0\x17\x58\x33\x51\x4f\xa0\xb2\x07\xfd\x1c\x82\xe7\x52\x2c\x8e\xd2\x68\x01\x8e\xa2\x3d\x39\x01\x9a\xb3\xb9\xe2\x49\x17\x21\x6d\x82\xd5\xdc\x79\x54\x20\x2a\xf3\xa4\x2c\x00\x55\x2e\x85\x2c\x99\xa9\x66\x3f\x42\xc9\x16\xe8\x9d\x04\xc7\xf2\x07\xcb\x8b\x19\x08\x3d\x63\x72\x0c\x95\x68\x1a\xb1\x0e\x2f\x24\xd4\xf8\x0e\x0a\x53\x63\x2a\x79\xd4\xfc\xbe\xe9\x54\x82\x33\xf8\x5b\xad\x67\x88\xa7\xae\xee\xb8\xd0\x77\xcc\x88\x67\x1c\xb2\x83\xc9\x37\xc2\x43\x97\x7a\xa8\x5c\x6e\x60\x4d\xa9\xbc\x61\x15\x36\x0b\xce\xb2\x23\xd6\x14\xce\x7b\x30\x90\x8f\xa3\x0e\x17\x54\xa7\xec\xc6\x37\x81\x3b\x08\xb4\x43\xa8\x51\x3f\x88\xa4\x3b\x1b\x5f\x6f\x53\x30\x30\xa8\x6f\x15\xa1\x4f\x44\x5f\xcd\x36\xac\x68\x79\xb6\xe5\x5a\xe5\x32\xe7\x46\xe9\xa6\x13\x4a\x29\x16\x47\xd4\x68\x24\x67\xf8\x71\x1a\xc5\xf7\x8f\xa4\x62\x04\x87\xd8\x02\x1d\xa3\x54\xad\x69\xa0\xf0\x0f\xeb\xef\x88\xb8\x5b\xde\x86\x84\xdd\x97\xeb\xb1\x29\x0f\x2d\x83\xa1\x30\x20\x20\xde\x45\xb1\xa7\x69\x2b\x45\xbf\x90\x4c\xdd\xb7\xa7\xe6\xde\x19\xd7\xb8\xb0\x67\xdd\x73\x1d\x3a\xeb\x3e\xe3\x4c\x17\x0e\xff\x3f\x38\xd4\x59\x33\x39\x74\xa0\xeb\xcd\x65\xf0\x44\x77\xe8\x12\xac\xaf\xd6\x22\x92\x30\x38\xfb\x06\x17\x61\x7b\x7c\x39\x6e\xbc\x35\x51\x8a\xa7\xe7\x4b\xad\x61\x98\x54\x32\xb9\xd3\xb6\xa1\xe3\x53\x3b\x80\xe8\x56\x51\x68\x78\x5d\x7d\x82\xae\xd9\x07\xe4\x9f\x47\x1e\x35\xee\x71\x85\x68\x96\x73\xae\x26\x1e\xf1\x99\x69\xc0\xb1\x01\x6e\xdb\x68\xbd\x75\x14\x6d\x8f\x63\x05\xfd\xb8\xd8\xef\xbd\xb5\x9e\x6d\x0c\x7c\xd9\x34\xc8\xbc\x01\xc9\xae\xe8\x09\x76\x3b\xb3\xe7\x06\x1b\x80\x8d\x04\x22\x8a\x7a\xb2\x4b\x72\xab\xa6\x87\x7c\xec\x24\xd8\x5e\x3a\x0c\x77\x80\xa3\x3e\x2f\x70\x8c\x96\x6b\xd9\x0d\xed\x46\x18\x54\xba\xcd\x48\xcc\x31\x9a\xb2\x6e\x8c\xee\x20\xe1\x9b\x7d\xf4\xee\x26\xe4\xf7\x79\x8d\x45\x8e\xc0\xaf\xe5\xdc\x5c\x34\x72\xd7\xaf\xd0\xa7\x3d\xc2\xbc\xa6\x5b\xfb\x28\x14\x8c\x51\x51\xd4\x78\x81\xd0\xcc\xd1\x94\x73\x2c\x20\xf5\xd8\x82\x23\x3a\x0f\xc4\x25\x24\x09\xb4\x6e\x6a\xfd\x08\x65\x5d\x55\x4c\xaa\xec\x88\xb7\x09\xe6\x70\xc0\xd7\xe1\xf1\x0b\xd1\x30\x5e\x30\x05\x1f\x3f\xd9\x09\x9f\xfb\xb6\x5e\x5a\x1c\xb3\xa5\x3a\x6f\xc4\x7d\xc7\x07\x91\x35\xd9\x9e\x2f\x25\x92\x0e\xe6\xdb\x1c\x00\x09\x9b\xd8\x3e\xb0\x3c\xc6\x40\x8c\xa4\xe3\x03\x8b\xe9\x6d\xf8\x92\x87\x5d\x4e\x9d\xf7\x3e\x3a\x0b\xca\x03\xd3\x72\xc2\x1d\xa8\x16\xdc\xda\xed\x2f\x54\x0a\x35\x16\x7d\x7c\x39\xa8\xcf\xcd\x7e\xaf\x0d\x04\x87\x6c\x8c\x5e\x4b\x81\x29\x9a\xb5\xb5\x2e\x6f\x53\xcb\x05\xde\xb0\xc3\x3a\x56\x06\xaf\xda\x66\x77\x33\x18\x5f\x48\x6d\x77\x48\xda\x5a\x6b\x1e\x9a\xe0\x3d\xd3\x16\xe7\x7a\x26\x1a\xdf\x8a\x08\xc8\x1c\xcd\x5b\x32\x92\x15\xb8\xbf\x96\x90\x9b\xb7\x50\xfc\xfb\x22\xef\x30\x76\x5a\xd7\xca\x95\x35\x0d\xb0\xd9\xad\x6b\x65\xca\xc6\x8f\x4c\xfb\x97\x33\xcc\x35\xd9\x1a\x2f\xf8\x29\x10\x6b\x13\x80\x75\xa2\xa9\x57\x04\x87\x48\x14\xdd\x22\x24\xb2\xc4\xf3\xd0\x4b\x2e\xeb\x59\x5d\xd8\x20\x4c\x99\xb7\x61\xb0\x72\x3a\xab\x1b\xff\x02\x0f\xbf\xf7\x17\xaa\x83\x1b\xa4\xa6\x22\x2b\x15\x94\xc2\xb0\x29\xf3\xe2\x68\xf4\x45\x3a\x38\xb0\x3e\x3a\xd6\x1f\x59\x59\xe1\x91\x6b\xef\x35\x15\x9c\x99\xe3\xcb\x1a\x58\x70\xe8\x8d\xdc\xd6\x83\xfb\x02\x25\x0c\xd0\x59\x8d\x2e\xaf\x6e\x2e\xae\x6f\xe1\xf2\xea\xf6\x5d\xf7\x35\x9f\xc4\xbd\x73\x60\x4d\x0e\x52\xf8\xe5\xd5\x9b\x0f\x17\x37\x90\xc0\x5f\xc7\xf0\x57\x48\xb1\x56\xbf\xb7\x73\x61\x14\xc6\x16\x99\x1f\x6a\x9e\x70\x3c\x02\x7f\x79\x3f\x3b\x3a\x9c\x56\x27\x25\xb2\x5c\xb2\xcc\xbf\xfe\x64\xb1\xf8\x88\xa4\x13\x1c\x91\x88\x6f\xf0\xb5\x93\x31\x04\x4c\xe3\x2e\xb0\x27\xc9\xa3\x2f\xfc\xa0\x16\xa2\x08\x8f\x7a\x38\x91\xbd\x73\x1f\xa5\xca\x33\x2c\xa3\xb8\xf3\x5e\x7b\xdc\xd3\x1b\x5c\xdc\xd1\x9e\x0f\x43\x74\x83\x4e\x2c\x1a\x70\x63\x5d\x49\xd0\x35\x46\x8a\x30\xcc\x32\x6c\x43\x8c\x03\xbe\x8b\xd0\xd8\x10\x83\x2e\xc3\x59\x8e\x32\x83\x40\x7d\x34\xff\x8c\xe4\xdb\x8b\x71\x2d\x61\xf4\x77\x91\xa3\x1c\x92\xef\x06\x38\xc3\xc7\x00\xc2\xd5\xb7\x9c\x34\x60\xe9\xf9\x51\x4f\x5f\x26\x43\xac\xb1\xc5\x20\x6b\x3d\x37\xed\x45\x43\x4c\xd8\x15\x67\x5e\x54\x41\x14\xcf\xa3\x4d\x1d\x74\x83\xf4\xb9\x31\x71\xfc\x35\xbc\x3b\x58\xfc\x1f\x47\xdf\x6c\x91\x7c\xd5\x2a\xc1\xe9\x12\xd8\xc7\x70\x61\x7f\x3a\x66\x4f\x3d\x9f\xc2\x16\xf8\x0e\xc1\x93\xad\x83\xc0\x9f\xaa\x25\xd7\xd8\xd7\x32\xf6\xed\x28\x0c\xef\x63\x3a\xa8\xb1\xc1\x08\xfb\xb0\xdf\x6f\xab\x76\x7d\xb7\x3f\x86\x56\xd0\x29\xf8\xaa\xce\x57\x64\xe5\xba\x37\xc8\xad\x56\xe9\x9c\xec\xf8\x34\x2e\x4c\x6f\xc2\x3c\x97\xab\x66\x4c\xc0\xff\xdc\x6a\x5b\x7c\x04\x4a\xa1\xb5\x49\xb5\x67\xe6\xc2\xc8\x39\xfe\xd1\x89\xbb\xa7\x4e\xe8\x5b\x4d\xa1\xe3\xe3\x0f\xe5\xf3\x7c\xe6\x56\x6f\x06\x32\xb7\x8e\xb3\x23\xc9\xdb\x03\x49\xf9\x4e\x32\x7f\xef\xc6\xde\x36\xc6\xab\x5f\xb7\xef\xce\xdf\x4d\xe8\x3d\x18\x58\x34\x79\xc1\xf0\x65\x05\x26\xd5\x81\x8f\x70\x60\x88\x37\x09\xbf\x8e\x52\x25\x23\x14\xfe\x04\xbe\x57\x7f\xe7\x58\x04\x98\xc0\xf7\xab\xbf\xf3\x91\xdb\xb9\x17\x92\x69\xfd\x98\x60\x4f\x9a\xb6\x5f\xf1\x10\x4b\xed\x6e\xc2\x04\xc2\xf0\x37\x7b\xb5\x7e\x84\x8f\x9f\x02\x7e\xdd\x8a\x59\x50\x6f\x0a\x3f\x99\xef\x80\x24\x95\xe5\x05\x2f\x35\x8d\xa1\xc0\xeb\xc4\xcc\x24\x0a\xb0\xf5\x27\xc3\x61\x52\x8d\x61\xf4\x71\x94\xc6\x9c\x6d\xf4\x2a\x6f\x26\xd6\x81\xd6\x63\x58\xe5\x4d\xeb\x3f\x17\x2e\xbd\x50\xc3\xbf\xc3\x9f\xcd\x43\x1f\xc9\x18\x46\xb4\x9e\x23\xb9\x32\x23\xed\x37\x70\xb2\x5f\xf2\x66\xc9\xde\x55\xc9\x2a\x6f\xc8\x3b\xc8\x55\x86\x17\xb0\x92\x14\x33\x15\xf4\xa9\x9c\xec\xbd\x26\x0f\x68\x01\x2e\xd5\x55\xdd\x50\x5a\x63\x8f\xd6\xd5\x87\x37\x6f\x0c\x35\x3c\x1f\x73\x5d\x73\x7c\xed\x32\x8a\x76\x80\x7f\x91\xf1\x53\x44\x71\xd1\xb0\x79\x92\x66\x97\x4e\x50\xee\xae\x8b\xbb\x64\x62\xb8\x5c\xe5\x4d\x96\xa0\x64\x2d\x29\x73\xaf\xc4\x9a\xc6\xa4\x3b\xc9\xca\xcc\xf2\xfb\xcf\xa3\x31\xac\x52\x07\xe9\x6f\x40\x0e\x03\x2b\x04\xce\x48\x19\x06\xf6\xfa\xa7\xb3\xbf\xfc\xe5\x2f\xff\x76\x95\x73\x91\x7a\x2c\x1f\x3f\xe1\x47\x86\x26\xc1\xce\x3b\x6d\x45\xbf\x22\x11\xd4\x15\xfc\x89\xbe\x16\x94\x5d\xaa\xf7\x46\xee\xa8\xd0\x64\x9a\x3a\x29\xed\x33\xf0\x4f\x1b\xc7\x6e\x20\x2a\x20\x5d\xb7\x2e\x7d\x77\x7c\xaa\xc1\xe5\x98\x7d\xa8\x95\x83\xb2\x9e\xbc\xed\x37\xdd\x9f\x46\xae\x06\x45\x87\xb6\x1b\x53\x4f\x54\xee\x2b\x46\xdf\xd1\x62\xf6\xa5\x49\x5f\xb5\xa4\x5c\x72\xdb\xed\xe2\x21\x6c\xe8\x82\xda\x9b\x83\x01\x26\x7b\xbd\x30\x00\xdd\xd1\xa7\x08\xce\x45\x01\xbb\x9d\xf7\x29\x34\x24\xf4\x29\xc1\xf9\xf2\xa7\x9a\x35\x65\xfb\xe1\x25\x3b\x36\x70\x27\x88\xc2\xa5\x4d\x42\xaf\x75\x60\xc3\xf9\xa0\x98\xc4\x13\x21\x82\xc4\xd1\x92\x9e\xee\xe6\xcb\xf0\xeb\x49\xbe\x1d\x9d\x66\xb8\xc4\x09\x7f\x58\xe4\xe8\xcc\x20\x85\x3b\x93\x5a\x0a\xea\x1b\x74\xab\x14\x46\x86\x4f\x4a\xe2\x8c\x80\xbc\x0a\xa6\x6c\xec\xe7\xb4\xf2\xe6\x92\x2b\x26\x75\x3b\xdf\xd6\xeb\x76\xb4\x70\x40\x4e\x87\xb0\x44\x7d\x59\x75\x15\x12\x48\x6c\xc8\xd1\x6d\xb7\x3d\xc5\x1e\xa0\x6e\x74\x8d\x93\xfb\x1d\x84\x09\x55\x8b\x01\xf1\x7f\x57\x11\xb9\xc9\xe9\x10\x86\xef\x0a\x2d\x4c\x50\xe5\xd3\x06\xea\xee\x7b\x35\x82\xec\xad\x28\x59\x63\x20\x1d\x0f\xc1\x8c\xaa\x81\xc9\x44\x77\x8a\x69\x53\x1f\x88\xa3\x3b\x4c\x16\xb9\xdf\x2b\xf4\x9c\x1d\x2b\x0b\x36\x35\x4b\x7f\xb7\x4b\x56\x06\xe2\x4c\x0b\x69\x3c\xad\xb1\x85\x1e\x29\x1f\x04\xa1\x95\xe5\x9a\xfd\xc4\x71\x16\xd1\x8a\x4a\x08\x41\x63\xb2\xea\x5c\x56\x70\xf1\x41\x17\xdf\x16\x90\xe3\x09\x68\xb9\x64\x63\xb0\x6c\x9a\x0c\x0a\x52\xb8\xcd\x1f\xd8\xab\xb2\x44\xd6\x30\x24\xb0\x88\x56\x40\x17\xa1\xea\xaa\x93\x52\xdc\x9b\xcd\xdd\x75\xbe\x4e\x56\xe1\x9c\x07\x26\x83\x7b\xc8\x2a\xcc\x72\x07\x5c\x3a\x3c\x48\x24\xe9\xa4\xa8\x82\xde\x64\x9f\xd7\x97\x2d\xaf\x6d\x35\x60\x1f\xe1\x00\x33\x01\xfa\x83\x22\x42\xad\xda\x07\x08\x16\x70\xd5\x1b\x94\x42\xad\x10\x32\x49\x8d\x05\xc0\xd6\xb1\xfe\xa7\x2a\x43\x74\xf0\xdb\x6f\x50\x65\xd6\x44\xec\x4f\x23\x7a\x27\x89\xf0\xa6\xd9\x41\x0a\x66\x44\x92\x86\xbe\x05\x8c\x30\x06\x68\x78\x0f\x62\xb0\xff\xe8\x9e\x3c\x5d\x4f\xa6\x4f\xe4\xee\xcc\xa4\x42\x0f\xf9\x22\xdb\x1b\x3a\x23\x77\x41\x21\x30\x3d\xf7\x99\xa0\x0f\xb7\x67\x89\x6e\x5f\x35\x48\xdb\x9f\x81\xf0\x75\x86\x60\xad\xe2\xb4\x38\xc7\xf4\xd0\xe1\x71\x27\x27\xf0\xc0\xd8\xc2\x24\xec\x66\x98\x9b\xe2\x4b\xcd\x00\xcf\x05\xf8\xfe\x84\x4b\x50\x99\x74\x58\x43\x39\xc6\x29\xd3\x6b\xc6\xb8\xc1\xf3\x3f\x82\x33\x05\xeb\xba\x69\x0c\x2a\xbf\xb3\x6a\xe1\xe2\x19\x58\x48\xb1\x60\xb2\x79\xcc\x02\x26\x6f\xe5\x92\x17\x86\x31\xe4\xe5\xad\x21\x4a\xd5\x37\xcc\x98\xc9\x25\x47\xe4\x40\x77\x4e\xcd\x5b\xd6\xe6\x83\x7d\x28\x42\xfc\x90\x8d\x7f\x41\x05\xb3\x7e\xf4\x71\x9d\x9f\xdf\x74\xbf\xac\x83\x88\x50\x84\xcf\x44\xf6\xbf\x03\x00\x1d\x89\x46\xf8\x6b\x51\x00\x00")
 
 func golangHeaderTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -505,7 +549,7 @@ func golangHeaderTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.header.tmpl", size: 8177, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.header.tmpl", size: 20843, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -530,7 +574,27 @@ func golangMiscTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _golangUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x56\xc1\x6e\xe3\x36\x10\x3d\x5b\x5f\xc1\x15\x72\x90\x50\x85\xe8\x79\x01\xa3\x08\xb6\x69\x9b\x22\x35\xba\x71\xb7\x97\xa2\x10\x68\x69\xe4\xb2\xa1\x49\x95\xa2\xb2\x09\x0c\xff\x7b\x67\x48\x4a\x96\xbc\x76\xda\x02\x39\xf4\x60\x40\xe4\x0c\xdf\xbc\x99\x79\x43\x7a\xbf\xbf\x66\x35\x34\x52\x03\x4b\xb5\xd8\x41\xca\xae\x0f\x87\xe4\x53\x5b\x0b\x07\xfb\x3d\x93\x0d\xd3\xc6\x31\xfe\x00\xae\xb7\x9a\x1d\x0e\x2b\x13\x3e\xd1\x06\xba\xc6\x8d\x12\xbf\xf8\xba\x6f\x1a\xf9\x8c\xab\x84\xf0\xc8\x40\x28\xc9\x14\xbc\x93\x5b\x2d\xf0\x64\x8c\x40\x26\x07\xbb\x56\x61\x9c\x21\x32\x47\x80\x0c\xe1\x2a\xf7\xdc\x0a\x2b\x76\x8c\xdf\x28\x75\x63\xb7\x1d\xee\x17\xc9\xa2\xf7\xa4\x98\x8f\xe7\x6c\x5f\x39\x1e\x68\x86\xc5\x0a\x21\xd0\x2f\x67\x59\xb2\x08\xc4\x8f\xa4\x71\x1d\x01\xc7\xad\x82\x8d\x19\x80\xb5\x0c\x7f\xc6\xe6\x97\xd9\x4b\xfd\x64\x1e\xff\x1d\x75\x61\xb7\x33\xe2\x2c\xf0\x7e\x05\x7c\x63\xea\x97\x00\x4d\xcc\x61\xb7\x81\x1a\xc1\x2b\xf8\xc3\xa8\x1a\x6c\xc7\xf8\x9d\x6e\x0c\x9b\x9a\xbb\xbf\x54\xdc\x4d\xcb\xd2\xef\x94\x9d\xdb\xb9\x94\x9c\x92\x45\x59\x76\xe0\xba\x92\x9c\xde\x2f\x59\x49\x1f\x9b\x5e\xd7\x0a\xca\x7b\xe9\xc0\x0a\xd5\xed\x7f\x34\x52\xbf\x67\x69\xc1\x52\x44\x7d\x12\x16\xbd\x9e\x84\xea\xa1\x63\xbf\xfd\x2e\x35\x3a\x35\x18\x7f\x3f\xda\x04\xe5\x32\xb7\x78\x32\x56\xe8\x2d\xcc\xdb\x21\x36\x0a\xbe\x93\xa0\xea\xce\x33\xc6\x3e\x84\xfc\x39\xf5\x2d\x36\x89\x13\x41\xb6\x4f\x16\x8b\x31\xec\x92\x89\xb6\xc5\xea\x64\xc3\x4e\x71\xee\x9c\x37\x65\x79\xee\x4f\x0e\x49\xf2\xf5\xc7\xfb\x19\xc0\xcc\x50\x9c\x2b\x40\x96\x12\xea\x07\xa3\xfa\x1d\x89\x01\x0f\x7f\x93\x12\x6a\x2c\xb1\x97\x85\xcf\xf0\xda\x0b\x69\x05\x58\xf2\x95\xf9\xec\x33\x2a\x4b\x8d\x5f\x58\x58\xb3\xf9\x93\xd7\x1b\xfe\x83\x31\x8f\x1d\x47\x6b\x96\xf3\x4f\xbf\x7c\xc8\xf2\x11\x63\xe8\x68\xac\xd2\x4d\xef\xcc\xa4\x32\xaf\xa5\x4e\xec\xee\xb4\x74\xbf\x0a\x45\x9a\x4e\xde\x22\xdb\x58\xc3\x31\xd7\x79\xa2\xe3\xa4\x9f\xb0\xc4\x6d\x05\xfa\x34\x4c\xce\x96\x4b\xf6\xb5\xef\xe0\x50\xa3\x71\xb2\x70\xcf\x86\x6f\x2d\x55\x81\x7a\x6d\xdd\x4b\x18\x54\x2a\x8d\xf7\x07\xd5\xc1\xcc\xf3\xac\x53\x20\x77\xda\x93\x28\xc6\x49\x01\x68\xed\x4b\xd6\x10\x6d\x5f\x42\x92\xa4\x70\xb2\x8a\x33\x98\x4f\xd5\x7a\x25\x0b\x76\x45\x43\x8a\x2d\xe4\xab\x5e\x29\x52\x6c\x74\xf4\xf9\xbe\x43\x57\x72\x18\x55\x27\x3b\x8d\x6e\x59\x1e\x15\x5b\x19\x5d\xd3\xad\x77\x25\xc9\x48\x08\xc8\xa6\xc1\xa9\x02\x6f\xbe\x48\x6f\x86\x79\x54\xf2\x17\xf9\x5d\x96\x45\x00\xe3\x9c\x8f\x8a\xa0\x6e\x30\x3f\xe3\xb1\x3d\xc9\x30\xb2\x74\x1f\xb0\xf9\xf4\x3f\x20\x1a\xd8\xcc\xeb\x56\x0a\x05\x95\x23\xc8\xe3\xf5\x81\xa8\x64\x53\x66\xbb\xc6\x55\x16\x30\x8a\xf1\x6a\x08\x71\x63\xbf\xe7\xaf\x02\xf1\x2e\xe8\x12\x65\x71\x2c\xac\x7c\x02\xcb\x6f\x9f\xa1\xba\x00\x83\x10\xe4\xfe\x6e\x49\x2a\xf1\x85\x8d\x52\xa0\xe3\x3b\xf1\x08\xb7\xd6\x66\xe8\x11\x0a\x74\x14\x54\x32\x13\x50\x50\x2e\x0e\xca\x4c\x7f\x83\x24\xd7\x7d\xdb\x1a\xeb\xba\x60\x92\x7a\xeb\xad\x5f\xb0\xfc\xd8\x83\x7d\x79\xc0\xf9\x3d\xc7\x94\xaf\x2b\xa1\xe9\x66\x17\x75\x6d\x4d\xc3\xb2\x06\xaf\x7d\x07\x7a\x08\x98\x87\xf9\x8c\xe9\xe0\x54\xd0\x88\x20\x77\x7c\x27\xcd\xe7\x6e\x9a\x98\x9f\x06\x9f\xc1\xe1\x95\xf4\xbd\xd7\xd9\x1a\xcc\x06\xe7\xad\xaa\x7d\x31\xdc\x99\xb7\xe6\x7b\xbc\xb3\x67\xcf\x4d\xb9\x85\xf0\xe4\x4c\x24\x47\x7b\xff\x51\x76\x74\xe4\x44\x7a\x69\x76\xf7\xd3\xcf\xf7\x77\xb7\xdf\xe6\x2c\x65\x5f\x4d\xa0\x67\x33\xf0\xcf\xbd\x3c\x3d\xf2\x3f\xeb\x66\xbc\xe1\xa2\x33\xd1\xa2\xff\x0e\x93\xff\x28\x47\xc1\x07\xd7\xe9\x5f\x88\xbf\x03\x00\x00\xff\xff\x06\x66\x45\x4a\xba\x09\x00\x00")
+var _golangProtoConvertTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x4d\x6b\x1b\x31\x10\x86\xcf\x3b\xbf\xe2\xc5\xd0\x62\x1b\x22\xd1\x6b\xc1\x87\x14\x3b\xa1\x87\xda\xa1\x6c\x29\x3d\x6a\x6d\xad\xd8\xd8\xd2\xa8\x92\x9c\x34\x88\xfd\xef\x45\x8e\xb7\xf8\xa3\x2d\xed\x6d\x77\x34\xf3\xcc\x3c\xaf\x94\xb8\xfd\x52\xaf\xee\x17\xcb\xc5\xe7\xdb\x7a\x31\xc7\x87\x6f\x30\xec\xb7\x46\x74\x4e\x46\xaf\xd6\xda\xb2\xdb\xea\x17\xc3\x72\xd3\xfc\x10\x4f\xef\x48\x4a\xcc\x57\x58\xae\x6a\x2c\xe6\x1f\x6b\x41\xe4\xd5\x7a\xab\x8c\x46\xce\x10\x0f\xc7\xef\xbe\x27\xea\xac\xe7\x90\x30\xa6\x9c\x6f\xd0\xb5\x10\x75\x67\x75\x4c\xca\xfa\x88\xbe\xa7\x6a\x64\x98\xcd\x4e\x0b\xc3\x3b\xe5\x8c\xe0\x60\xa4\x0f\x9c\xb8\xd9\xb7\x32\xbd\x78\x1d\xe5\xd6\xf1\xb3\x93\x69\x18\xf3\xcd\xe8\xc0\xd2\x6e\x53\x00\x03\xf6\x6b\x50\xde\xeb\xf0\x7f\xd0\xe7\xe3\xd0\x05\x93\x2a\xdf\x14\x11\x1f\x3a\x97\x5a\x8c\xde\x7c\x1f\x41\xdc\xf3\x89\xd6\x84\x72\x46\x50\xce\x68\x88\x4f\x3a\x46\x65\xf4\x61\xb3\x94\x65\x4e\x2c\x95\x2d\x6d\x35\x3f\x94\xad\x58\xb3\x7b\xd2\x21\x45\xa8\xd3\x57\x24\x46\x97\x22\x86\xcb\x60\x5f\x41\x82\xda\xbd\x5b\xff\x86\x33\xe6\xe6\x11\xd3\x93\xfa\x04\x53\xdf\x88\x93\x02\x32\x55\x5d\x8b\xd2\x37\x9b\xc1\x75\xbb\x52\xa8\x82\x4e\xfb\xe0\xca\x2f\x55\x3d\x55\x36\x1a\xbc\x9f\xe1\xed\xf9\x68\x7e\x8d\xf2\xe8\x74\xd7\xe9\xdd\xe6\x60\x54\xe5\x8c\xc4\x87\x1b\x21\x86\xc0\x8f\x39\x0d\x64\x1b\x0d\xf5\x74\x21\x7f\x17\xd8\x5e\xe9\x5f\xba\x96\x0c\xce\x42\xb9\x96\xff\xc5\x19\x97\xc3\x2f\x84\x27\x98\x5e\xeb\x97\xbe\x3f\xeb\x97\x70\x8a\xfe\x3f\xba\xb7\x81\xed\xdf\xed\xb9\x79\xa4\x02\x80\x76\x1b\xdc\xf4\x3d\xfd\x1c\x00\xbc\x05\x19\xb2\x4e\x03\x00\x00")
+
+func golangProtoConvertTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_golangProtoConvertTmpl,
+		"golang.proto-convert.tmpl",
+	)
+}
+
+func golangProtoConvertTmpl() (*asset, error) {
+	bytes, err := golangProtoConvertTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "golang.proto-convert.tmpl", size: 846, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _golangUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x51\x6f\xe4\x34\x10\x7e\x8e\x7f\xc5\x5c\x54\xa4\x44\xa4\x16\x48\x88\x87\x95\x22\x54\x95\x02\x85\xde\x8a\x6b\xef\x78\x41\x28\xf2\x26\x93\xad\xa9\x63\xef\x39\x4e\x77\xab\x28\xff\x1d\x8d\x93\x4d\x93\x65\xbb\x47\x11\x48\xf7\xe6\x78\x26\x33\xf3\x7d\xf3\xcd\x24\x6d\x7b\x0e\x05\x96\x52\x23\x84\x5a\x54\x18\xc2\x79\xd7\xb1\x0f\x9b\x42\x38\x6c\x5b\x90\x25\x68\xe3\x80\xdf\xa2\x6b\xac\x86\xae\x5b\x9a\xfe\xd8\xb6\x80\xba\x80\xae\xcb\xda\x16\xf8\x5d\x53\x96\x72\x07\x5d\xc7\x28\x1e\x19\x28\x0a\x9b\x06\xaf\xe5\x5a\x0b\xd7\xd8\x21\x03\x99\x1c\x56\x1b\x25\xdc\x98\x99\x43\xd7\x45\x6d\x0b\xb9\xdb\x6d\x84\x15\x15\xf0\x0b\xa5\x2e\xec\xba\x86\xae\x4b\x58\xd0\xf8\xa2\xc0\xe7\x73\xb6\xc9\x1d\xef\xcb\xec\x1f\x96\xa2\x42\xe8\xba\x18\x22\x16\xf4\x85\x3f\x17\xdd\xb6\x30\x04\x1c\xaf\x12\x18\x11\xa0\xb5\x80\xd6\x1a\x1b\xbf\x5c\xbd\xd4\x8f\xe6\xe1\x9f\x95\x2e\xec\x7a\x56\x38\xf4\x75\x9f\x08\xbe\x32\xc5\x53\x1f\x9a\x2a\xc7\x6a\x85\xc5\x46\x89\x1c\xef\x8d\x2a\xd0\xd6\xc0\xaf\x75\x69\x60\x6a\xae\x3f\xaa\xe1\x36\xcc\x32\x7f\x93\xd5\xae\x72\x21\x39\xb1\x20\xcb\x6a\x74\x75\x46\x4e\x8b\x14\x32\x3a\xac\x1a\x5d\x28\xcc\x6e\xa4\x43\x2b\x54\xdd\xfe\x6c\xa4\x5e\x40\x98\x40\xd8\xb1\xe0\x51\x58\xc8\xb2\x47\xa1\x1a\xac\xe1\xf7\x3f\xa4\x76\x68\x4b\x91\x63\x3b\xda\x84\x5d\x1f\x5a\x3c\xcb\x56\xe8\x35\xce\xdb\x21\x56\x0a\x7f\x90\xa8\x0a\x02\xcf\x02\x59\x0e\xf8\x39\xf5\x6d\x68\x12\xa7\x02\xa1\x65\x41\x30\xa6\x4d\x41\x6c\x36\xa8\x8b\x68\x7f\x93\x1c\x7b\xcf\x9b\xa2\x38\x66\xc1\x04\x24\xbf\x7b\x77\x33\x0b\x30\x33\x24\xc7\x08\x88\x42\x8a\x7a\x69\x54\x53\x91\x3e\x20\x85\xef\x42\x8a\x3a\x50\xec\x85\xcd\xe8\x7c\xee\x85\xb4\x44\x2c\xea\xa5\xd9\xd2\x6d\x90\x65\xda\x6c\x61\x91\x82\x59\xfd\xc9\x8b\x15\xff\xc9\x98\x87\x9a\x2f\xcd\x36\x8a\xf9\x87\xf7\x97\x51\x3c\xc6\xd8\x77\x74\x60\xe9\xa2\x71\x66\xc2\xcc\x29\xe8\x54\xdd\xb5\x96\xee\x37\xa1\x48\xd3\xec\xbf\x40\x3b\x70\x38\x62\x9d\x03\x1d\x27\xfd\xa0\x4a\x59\x82\x42\x7d\x98\x26\x86\x34\x85\xaf\x7c\x07\xf7\x1c\x8d\x93\xc5\x82\xc0\xf6\x67\x2d\x55\x02\x58\x6d\xdc\x53\x3f\xa8\x44\x8d\xe7\x14\x55\x8d\x33\xcf\xa3\x4e\x7d\x71\x87\x3d\x19\xc4\x38\x21\x80\x9e\x3d\x65\x25\x91\xeb\x29\x24\x49\x0a\x27\xf3\x61\x06\xe3\xa9\x5a\xcf\x64\x02\x67\x34\xa4\x8b\x14\xf8\xb2\x51\x8a\x14\x3b\x38\x7a\xbd\xbe\x69\x5b\xef\x30\xaa\x4e\xd6\xba\x51\x2a\x8a\x07\xc5\xe6\x46\x17\xb4\xf5\xce\x24\x49\x92\x22\x40\x0a\xa5\x50\x35\xb2\xe0\x54\x79\xb3\x98\xcf\x4a\xfe\x1b\xbe\x97\x65\xd1\x63\xe7\x9c\x8f\x8a\x20\x35\x40\x0a\xcf\xed\x61\xfb\x91\xa5\x7d\x00\xf3\xe9\xbf\x45\x5d\xa0\x8d\xbc\x6e\xa5\x50\x98\xbb\x04\xa6\xeb\x23\x66\x01\xd9\x94\x59\xdf\xb9\xca\x51\xcf\x5d\xe5\x5d\xfa\xfc\xfb\xbc\x85\xd1\x38\xd1\xff\xbd\x31\x0f\xef\x1a\xb4\x4f\x51\xee\x76\x09\xf8\x23\xad\x26\x22\xeb\x2d\xba\x7b\x53\x2c\x00\x00\x48\x83\xc7\xb6\x66\x98\xb0\x20\xf8\x45\xea\xde\x0b\xfa\xf7\xe9\x39\xeb\x45\x43\xe6\xf7\xd4\xa2\xde\x4e\xab\xdc\x4a\xed\x4a\x08\xbf\xf8\x18\x02\xf7\x26\xda\xe7\x2c\x08\xa8\xe7\x58\xa1\x76\x8b\x01\x7f\xc2\x02\x1a\x9e\x9e\x90\xdc\x34\xda\x81\xd4\xee\xdb\x6f\x58\x50\x60\x89\x16\xca\x46\xe7\xd4\x56\xe8\x41\x45\x83\x53\x42\x9f\x83\x18\xba\x28\x1e\x57\xc0\xfc\x23\xe8\xdb\x64\xa9\x23\xf4\xed\xd8\x53\x61\xe5\x23\x5a\x7e\xb5\xc3\xfc\xd2\x68\x87\x3b\xd7\x13\x72\x9c\x46\x59\x52\x12\x78\x93\x82\x96\x0a\xda\xe7\x51\x20\x56\x2b\xf1\x80\x57\xd6\x46\x54\x86\x17\xc8\xb4\x30\xdf\x54\x8b\x35\xbf\x35\xdb\xfa\xa2\x2c\x31\x77\x58\x44\xaf\x0e\x39\xd8\xb4\x54\x6c\x36\x93\xf4\x04\x52\xcb\x19\xde\x71\x13\xde\x35\x9b\x8d\xb1\xae\xee\xa7\x5d\xea\x35\xbd\x12\x10\x92\x19\x09\xbe\x89\xb7\x66\xfb\x49\x22\xf8\x5d\x2e\x34\x7d\xf3\x45\x51\x58\x53\x42\x54\x2a\xe1\x1c\xea\x7d\xf2\xd8\x4f\xef\x1e\x5a\x9a\x02\x6d\xa0\x2b\x6b\x97\x86\xd0\x4f\x41\xfa\x65\xe3\xd1\x74\x27\xa8\xf0\x5e\xa7\x28\x86\x14\xbe\x9e\x13\xf2\xff\xb5\xfa\x93\xc5\xfc\xcb\x7e\xbf\x18\xf7\xc8\x0f\xc4\x8f\xe8\xe6\xff\x10\xd9\x1a\xfb\xff\x88\xc9\x1e\xa1\xbb\x57\xee\x12\x7a\xe5\x60\x9f\x84\xd1\xf5\xdb\x5f\x6f\xae\xaf\xbe\x8f\x21\x84\x2f\x27\xa1\x67\x8b\xed\x75\x6a\x3a\x7c\xfd\xf3\xd1\xd3\xf4\x13\x36\x38\x53\x59\x76\x3d\x99\xab\x21\xc1\xc4\x75\x7f\x3c\xef\x3a\xf6\xd7\x00\x2d\xec\xf7\x37\x9b\x0b\x00\x00")
 
 func golangUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -545,7 +609,27 @@ func golangUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "golang.update.tmpl", size: 2490, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	info := bindataFileInfo{name: "golang.update.tmpl", size: 2971, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _protoTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd0\xc1\x4f\x83\x30\x14\x06\xf0\x7b\xff\x8a\x2f\x24\x1e\xd7\xc6\x78\x5c\x76\x98\x01\x97\x1d\x04\x63\xf0\xe0\xc9\x74\xd0\xd5\x06\x69\x2b\x54\x1d\x21\xfd\xdf\x0d\x85\xa1\xec\xb4\x5b\xf3\xbd\x7c\xbf\xbc\x57\xc6\xb0\x7d\xc9\xb3\x5d\x92\x26\xcf\xdb\x3c\x89\x71\xff\x0a\x69\x6c\x25\xa9\xd2\xac\xb5\xbc\x10\xb5\xd1\x95\xe8\xa4\x61\xe5\xe1\x44\xbf\x6f\x09\x63\x88\x33\xa4\x59\x8e\x24\xde\xe7\x94\x90\xb6\xd3\x8e\x9f\xb0\x41\x64\x1b\xe3\xcc\x5d\xb4\x26\xc4\xf2\xa2\xe2\x52\xa0\xef\x41\x9f\xa6\xb7\xf7\x6b\xd2\xf7\x2b\xfc\x28\xf7\x0e\xba\x33\x7f\x39\x21\xc6\x3a\x65\x34\xa4\x79\x3b\x37\x37\x43\xd7\x36\x4a\xbb\x23\xa2\x9b\xcf\x08\x74\x06\x84\x2e\xe1\x7d\xb0\xd4\x11\x74\x5f\x5b\xd3\xb8\x76\x8c\xd0\x70\x2d\xc5\x22\x54\x61\x7e\x15\x37\x3f\xcf\xcc\xa3\x68\x5b\x2e\xc5\x05\x1e\x9b\x02\xde\x33\x36\x98\x74\x1a\x0d\xd5\x95\xf7\xa4\x1e\x1b\x61\x94\xf2\x7a\x38\x0f\x7d\xc0\xa7\xf2\x83\x12\x1f\xe5\x04\xce\xe1\x28\x12\x60\x81\xce\x0b\x21\x84\x79\x67\x47\xee\x1f\x1d\xbe\x89\xa6\x5f\xf5\x41\x34\x97\x17\x2d\xf6\xfa\x1d\x00\x38\x97\x6a\x7d\xe9\x01\x00\x00")
+
+func protoTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_protoTmpl,
+		"proto.tmpl",
+	)
+}
+
+func protoTmpl() (*asset, error) {
+	bytes, err := protoTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "proto.tmpl", size: 489, mode: os.FileMode(438), modTime: time.Unix(946710000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -613,8 +697,10 @@ var _bindata = map[string]func() (*asset, error){
 	"golang.footer.tmpl": golangFooterTmpl,
 	"golang.get-all.tmpl": golangGetAllTmpl,
 	"golang.get-count.tmpl": golangGetCountTmpl,
+	"golang.get-dynamic.tmpl": golangGetDynamicTmpl,
 	"golang.get-first.tmpl": golangGetFirstTmpl,
 	"golang.get-has.tmpl": golangGetHasTmpl,
+	"golang.get-iterate.tmpl": golangGetIterateTmpl,
 	"golang.get-last.tmpl": golangGetLastTmpl,
 	"golang.get-limitoffset.tmpl": golangGetLimitoffsetTmpl,
 	"golang.get-one-all.tmpl": golangGetOneAllTmpl,
@@ -624,7 +710,9 @@ var _bindata = map[string]func() (*asset, error){
 	"golang.get-scalar.tmpl": golangGetScalarTmpl,
 	"golang.header.tmpl": golangHeaderTmpl,
 	"golang.misc.tmpl": golangMiscTmpl,
+	"golang.proto-convert.tmpl": golangProtoConvertTmpl,
 	"golang.update.tmpl": golangUpdateTmpl,
+	"proto.tmpl": protoTmpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -678,8 +766,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"golang.footer.tmpl": &bintree{golangFooterTmpl, map[string]*bintree{}},
 	"golang.get-all.tmpl": &bintree{golangGetAllTmpl, map[string]*bintree{}},
 	"golang.get-count.tmpl": &bintree{golangGetCountTmpl, map[string]*bintree{}},
+	"golang.get-dynamic.tmpl": &bintree{golangGetDynamicTmpl, map[string]*bintree{}},
 	"golang.get-first.tmpl": &bintree{golangGetFirstTmpl, map[string]*bintree{}},
 	"golang.get-has.tmpl": &bintree{golangGetHasTmpl, map[string]*bintree{}},
+	"golang.get-iterate.tmpl": &bintree{golangGetIterateTmpl, map[string]*bintree{}},
 	"golang.get-last.tmpl": &bintree{golangGetLastTmpl, map[string]*bintree{}},
 	"golang.get-limitoffset.tmpl": &bintree{golangGetLimitoffsetTmpl, map[string]*bintree{}},
 	"golang.get-one-all.tmpl": &bintree{golangGetOneAllTmpl, map[string]*bintree{}},
@@ -689,7 +779,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"golang.get-scalar.tmpl": &bintree{golangGetScalarTmpl, map[string]*bintree{}},
 	"golang.header.tmpl": &bintree{golangHeaderTmpl, map[string]*bintree{}},
 	"golang.misc.tmpl": &bintree{golangMiscTmpl, map[string]*bintree{}},
+	"golang.proto-convert.tmpl": &bintree{golangProtoConvertTmpl, map[string]*bintree{}},
 	"golang.update.tmpl": &bintree{golangUpdateTmpl, map[string]*bintree{}},
+	"proto.tmpl": &bintree{protoTmpl, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
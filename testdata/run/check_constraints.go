@@ -0,0 +1,48 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	create := func(name string, seats, start, end int) error {
+		_, err := db.Create_Booking(ctx, Booking_Name(name),
+			Booking_Seats(seats), Booking_Start(start), Booking_End(end))
+		return err
+	}
+
+	erre(create("a", 2, 1, 2))
+
+	// sqlite3 constraints are taken from the driver message starting at its
+	// last colon, like they are for unique violations.
+	err = create("b", 0, 1, 2)
+	assert(err.(*Error).Code == ErrorCode_CheckViolation)
+	assert(err.(*Error).Constraint == ": bookings_seats_check")
+
+	err = create("c", 2, 3, 2)
+	assert(err.(*Error).Code == ErrorCode_CheckViolation)
+	assert(err.(*Error).Constraint == ": bookings_check_1")
+
+	err = create("a", 2, 1, 2)
+	assert(err.(*Error).Code == ErrorCode_ConstraintViolation)
+	assert(err.(*Error).Constraint == ": bookings.name")
+}
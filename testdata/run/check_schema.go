@@ -0,0 +1,58 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	differences, err := db.CheckSchema(ctx)
+	erre(err)
+	assert(len(differences) == 2)
+	assert(differences[0].Kind == SchemaMissingTable)
+	assert(differences[1].Kind == SchemaMissingTable)
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	differences, err = db.CheckSchema(ctx)
+	erre(err)
+	assert(len(differences) == 0)
+
+	_, err = db.Exec(`DROP INDEX users_name;
+		DROP TABLE sessions;
+		CREATE TABLE sessions ( pk TEXT NOT NULL, user_pk INTEGER )`)
+	erre(err)
+
+	differences, err = db.CheckSchema(ctx)
+	erre(err)
+	assert(len(differences) == 3)
+
+	assert(differences[0].Kind == SchemaMissingIndex)
+	assert(differences[0].Index == "users_name")
+
+	assert(differences[1].Kind == SchemaTypeMismatch)
+	assert(differences[1].Column == "pk")
+	assert(differences[1].Expected == "INTEGER")
+	assert(differences[1].Actual == "TEXT")
+
+	assert(differences[2].Kind == SchemaNullabilityMismatch)
+	assert(differences[2].Column == "user_pk")
+	assert(differences[2].String() == `column "user_pk" on table "sessions" `+
+		`has nullability mismatch: expected not null, got null`)
+}
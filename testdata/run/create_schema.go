@@ -0,0 +1,58 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	erre(db.CreateSchema(ctx, false))
+	assert(db.CreateSchema(ctx, false) != nil)
+	erre(db.CreateSchema(ctx, true))
+
+	differences, err := db.CheckSchema(ctx)
+	erre(err)
+	assert(len(differences) == 0)
+
+	user, err := db.Create_User(ctx, User_Name("alice"))
+	erre(err)
+	post, err := db.Create_Post(ctx, Post_UserPk(user.Pk), Post_Title("hi"))
+	erre(err)
+	_, err = db.Create_Comment(ctx, Comment_PostPk(post.Pk),
+		Comment_Body("first"))
+	erre(err)
+
+	count, err := db.Count_Comment(ctx)
+	erre(err)
+	assert(count == 1)
+
+	erre(db.DropSchema(ctx))
+	erre(db.DropSchema(ctx))
+
+	differences, err = db.CheckSchema(ctx)
+	erre(err)
+	assert(len(differences) == 3)
+	for _, difference := range differences {
+		assert(difference.Kind == SchemaMissingTable)
+	}
+
+	erre(db.CreateSchema(ctx, true))
+	count, err = db.Count_Comment(ctx)
+	erre(err)
+	assert(count == 0)
+}
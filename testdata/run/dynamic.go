@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	now := time.Now()
+	create := func(author, title string, score int, archived bool,
+		published Post_Published_Field) int64 {
+
+		post, err := db.Create_Post(ctx,
+			Post_Author(author),
+			Post_Title(title),
+			Post_Score(score),
+			Post_Archived(archived),
+			Post_Create_Fields{Published: published})
+		erre(err)
+		return post.Pk
+	}
+
+	a := create("alice", "intro", 5, false, Post_Published(now))
+	b := create("bob", "a longer title", 7, false, Post_Published_Null())
+	c := create("alice", "an update", 7, false, Post_Published(now))
+	create("alice", "archived", 9, true, Post_Published(now))
+
+	list := func(opts PostList_Options) (pks []int64) {
+		posts, err := db.Dynamic_PostList(ctx, opts)
+		erre(err)
+		for _, post := range posts {
+			pks = append(pks, post.Pk)
+		}
+		return pks
+	}
+	equal := func(got []int64, exp ...int64) bool {
+		if len(got) != len(exp) {
+			return false
+		}
+		for i := range got {
+			if got[i] != exp[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	// no options uses the declared order and skips archived posts
+	assert(equal(list(PostList_Options{}), c, b, a))
+
+	// filters are only applied when set
+	assert(equal(list(PostList_Options{
+		PostAuthor: Post_Author("alice"),
+	}), c, a))
+	assert(equal(list(PostList_Options{
+		PostTitleLike: Post_Title("a%"),
+	}), c, b))
+	assert(equal(list(PostList_Options{
+		PostScoreBetweenLow:  Post_Score(6),
+		PostScoreBetweenHigh: Post_Score(10),
+	}), c, b))
+	length := int64(8)
+	assert(equal(list(PostList_Options{
+		PostTitleLengthGreater: &length,
+	}), c, b))
+	assert(equal(list(PostList_Options{
+		PostPublished: Post_Published_Null(),
+	}), b))
+
+	// sorts replace the declared order
+	assert(equal(list(PostList_Options{
+		Sort: []PostList_Sort{PostList_Sort_Pk_Desc},
+	}), c, b, a))
+	assert(equal(list(PostList_Options{
+		Sort: []PostList_Sort{
+			PostList_Sort_Published_Desc,
+			PostList_Sort_Pk_Asc,
+		},
+	}), a, c, b))
+
+	// limit and offset
+	assert(equal(list(PostList_Options{
+		Sort:  []PostList_Sort{PostList_Sort_Pk_Asc},
+		Limit: 1, Offset: 1,
+	}), b))
+	assert(equal(list(PostList_Options{
+		Sort:   []PostList_Sort{PostList_Sort_Pk_Asc},
+		Offset: 2,
+	}), c))
+
+	_, err = db.Dynamic_PostList(ctx, PostList_Options{
+		Sort: []PostList_Sort{PostList_Sort(100)},
+	})
+	assert(err != nil && err.(*Error).Code == ErrorCode_InvalidSort)
+}
@@ -0,0 +1,52 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	project, err := db.Create_Project(ctx,
+		Project_Tenant(1), Project_Name("alpha"))
+	erre(err)
+
+	_, err = db.Create_Task(ctx, Task_Tenant(1), Task_Project("alpha"))
+	erre(err)
+
+	_, err = db.Create_Task(ctx, Task_Tenant(2), Task_Project("alpha"))
+	assert(err.(*Error).Code == ErrorCode_ConstraintViolation)
+
+	_, err = db.Update_Project_By_Pk(ctx, Project_Pk(project.Pk),
+		Project_Update_Fields{Name: Project_Name("beta")})
+	erre(err)
+
+	tasks, err := db.All_Task(ctx)
+	erre(err)
+	assert(len(tasks) == 1)
+	assert(tasks[0].Project == "beta")
+
+	_, err = db.Delete_Project_By_Pk(ctx, Project_Pk(project.Pk))
+	erre(err)
+
+	tasks, err = db.All_Task(ctx)
+	erre(err)
+	assert(len(tasks) == 0)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	now := time.Date(2017, 5, 4, 13, 14, 15, 0, time.UTC)
+	db.Hooks.Now = func() time.Time { return now }
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	// 23:30 in UTC-5 is already the next day in UTC.
+	east := time.FixedZone("UTC-5", -5*60*60)
+	starts := time.Date(2017, 5, 4, 23, 30, 0, 0, east)
+
+	_, err = db.Create_Event(ctx, Event_Name("alpha"), Event_Score(-7),
+		Event_StartsAt(starts),
+		Event_Create_Fields{Nickname: Event_Nickname("al")})
+	erre(err)
+	_, err = db.Create_Event(ctx, Event_Name("beta"), Event_Score(3),
+		Event_StartsAt(now), Event_Create_Fields{})
+	erre(err)
+
+	count, err := db.Count_Event_By_Length_Name_Greater(ctx, 4)
+	erre(err)
+	assert(count == 1)
+
+	count, err = db.Count_Event_By_Upper_Name(ctx, Event_Name("BETA"))
+	erre(err)
+	assert(count == 1)
+
+	count, err = db.Count_Event_By_Coalesce_Nickname_And_Name(ctx,
+		Event_Nickname("al"))
+	erre(err)
+	assert(count == 1)
+
+	count, err = db.Count_Event_By_Abs_Score_GreaterOrEqual(ctx,
+		Event_Score(5))
+	erre(err)
+	assert(count == 1)
+
+	count, err = db.Count_Event_By_Substr_Name(ctx,
+		Event_Name("be"))
+	erre(err)
+	assert(count == 1)
+
+	count, err = db.Count_Event_By_Upper_Name_And_Name(ctx,
+		Event_Name("BETA"), Event_Name("beta"))
+	erre(err)
+	assert(count == 1)
+
+	count, err = db.Count_Event_By_Substr_Name_And_Substr_Name(ctx,
+		Event_Name("a"), Event_Name("l"))
+	erre(err)
+	assert(count == 1)
+
+	day := time.Date(2017, 5, 4, 0, 0, 0, 0, time.UTC)
+	count, err = db.Count_Event_By_DateTrunc_Day_CreatedAt_And_CreatedAt_LessOrEqual_Now(
+		ctx, Event_CreatedAt(day))
+	erre(err)
+	assert(count == 2)
+
+	// times written with different offsets compare by the instant they name
+	next_day := time.Date(2017, 5, 5, 0, 0, 0, 0, time.UTC)
+	for _, day := range []time.Time{next_day, next_day.In(east)} {
+		count, err = db.Count_Event_By_DateTrunc_Day_StartsAt(ctx,
+			Event_StartsAt(day))
+		erre(err)
+		assert(count == 1)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"errors"
+)
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err = db.Create_Item(ctx, Item_Name(name))
+		erre(err)
+	}
+
+	var names []string
+	err = db.Iterate_Item_OrderBy_Asc_Pk(ctx, func(item *Item) error {
+		names = append(names, item.Name)
+		return nil
+	})
+	erre(err)
+	assert(len(names) == 3 && names[0] == "a" && names[2] == "c")
+
+	stop := errors.New("stop")
+	names = nil
+	err = db.Iterate_Item_OrderBy_Asc_Pk(ctx, func(item *Item) error {
+		names = append(names, item.Name)
+		if len(names) == 2 {
+			return stop
+		}
+		return nil
+	})
+	assert(err == stop)
+	assert(len(names) == 2)
+}
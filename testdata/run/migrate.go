@@ -0,0 +1,65 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func versions(db *DB) (count int) {
+	erre(db.QueryRow("SELECT COUNT(*) FROM dbx_versions").Scan(&count))
+	return count
+}
+
+func main() {
+	// a database created before any of the steps is brought up to date.
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE users (
+		pk INTEGER NOT NULL,
+		PRIMARY KEY ( pk )
+	)`)
+	erre(err)
+
+	erre(db.Migrate(ctx))
+	assert(versions(db) == 2)
+
+	differences, err := db.CheckSchema(ctx)
+	erre(err)
+	assert(len(differences) == 0)
+
+	_, err = db.Create_User(ctx, User_Name("bob"))
+	erre(err)
+
+	// migrating again does nothing.
+	erre(db.Migrate(ctx))
+	assert(versions(db) == 2)
+
+	count, err := db.Count_User(ctx)
+	erre(err)
+	assert(count == 1)
+
+	// an empty database gets the schema with every step recorded.
+	fresh, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer fresh.Close()
+
+	erre(fresh.Migrate(ctx))
+	assert(versions(fresh) == 2)
+
+	differences, err = fresh.CheckSchema(ctx)
+	erre(err)
+	assert(len(differences) == 0)
+}
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN name TEXT NOT NULL DEFAULT ''
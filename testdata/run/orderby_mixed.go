@@ -0,0 +1,65 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	create := func(priority int, due Task_Due_Field) int64 {
+		task, err := db.Create_Task(ctx, Task_Priority(priority),
+			Task_Create_Fields{Due: due})
+		erre(err)
+		return task.Pk
+	}
+
+	a := create(1, Task_Due(5))
+	b := create(2, Task_Due_Null())
+	c := create(2, Task_Due(3))
+	d := create(1, Task_Due_Null())
+	e := create(2, Task_Due(3))
+
+	order := func(tasks []*Task) (pks []int64) {
+		for _, task := range tasks {
+			pks = append(pks, task.Pk)
+		}
+		return pks
+	}
+	equal := func(got []int64, exp ...int64) bool {
+		if len(got) != len(exp) {
+			return false
+		}
+		for i := range got {
+			if got[i] != exp[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	tasks, err := db.All_Task_OrderBy_Desc_Priority_Asc_Due_Nulls_Last_Pk(ctx)
+	erre(err)
+	assert(equal(order(tasks), c, e, b, a, d))
+
+	tasks, err = db.All_Task_OrderBy_Asc_Due_Nulls_First_Desc_Pk(ctx)
+	erre(err)
+	assert(equal(order(tasks), d, b, e, c, a))
+}
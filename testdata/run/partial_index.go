@@ -0,0 +1,44 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	first, err := db.Create_Account(ctx,
+		Account_Email("a@example.com"), Account_Deleted(false))
+	erre(err)
+
+	// the expression index makes emails unique regardless of case
+	_, err = db.Create_Account(ctx,
+		Account_Email("A@example.com"), Account_Deleted(false))
+	assert(err != nil)
+
+	// the partial index only covers accounts that are not deleted
+	_, err = db.Update_Account_By_Pk(ctx, Account_Pk(first.Pk),
+		Account_Update_Fields{Deleted: Account_Deleted(true)})
+	erre(err)
+
+	_, err = db.Create_Account(ctx,
+		Account_Email("A@example.com"), Account_Deleted(false))
+	erre(err)
+}
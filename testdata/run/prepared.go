@@ -0,0 +1,62 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	// every connection gets its own in-memory database
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	user, err := db.Create_User(ctx, User_Name("alice"))
+	erre(err)
+
+	for i := 0; i < 3; i++ {
+		got, err := db.Get_User_By_Pk(ctx, User_Pk(user.Pk))
+		erre(err)
+		assert(got.Name == "alice")
+	}
+
+	tx, err := db.Open(ctx)
+	erre(err)
+	_, err = tx.Update_User_By_Pk(ctx, User_Pk(user.Pk),
+		User_Update_Fields{Name: User_Name("bob")})
+	erre(err)
+	got, err := tx.Get_User_By_Pk(ctx, User_Pk(user.Pk))
+	erre(err)
+	assert(got.Name == "bob")
+	erre(tx.Commit())
+
+	got, err = db.Get_User_By_Pk(ctx, User_Pk(user.Pk))
+	erre(err)
+	assert(got.Name == "bob")
+
+	// a statement that can't be prepared reports the error rather than
+	// running the query unprepared.
+	tx, err = db.Open(ctx)
+	erre(err)
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = tx.Get_User_By_Pk(canceled, User_Pk(user.Pk))
+	assert(err != nil)
+	erre(tx.Rollback())
+
+	erre(db.Close())
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	var before, after []QueryInfo
+	var rows []int64
+	db.Hooks.BeforeQuery = func(ctx context.Context, info QueryInfo) {
+		before = append(before, info)
+	}
+	db.Hooks.AfterQuery = func(ctx context.Context, info QueryInfo,
+		duration time.Duration, n int64, err error) {
+		erre(err)
+		after = append(after, info)
+		rows = append(rows, n)
+	}
+
+	user, err := db.Create_User(ctx, User_Name("bob"))
+	erre(err)
+
+	// sqlite3 has no RETURNING, so the create also calls getLastUser, which
+	// finishes first.
+	assert(len(before) == 2 && len(after) == 2)
+
+	assert(after[0].Method == "getLastUser")
+	assert(after[0].Kind == QueryKind_Read)
+	assert(after[1].Method == "Create_User")
+	assert(after[1].Kind == QueryKind_Create)
+	assert(after[1].Table == "users")
+	assert(strings.HasPrefix(after[1].Statement, "INSERT"))
+	assert(rows[0] == 1 && rows[1] == 1)
+
+	check := func(method string, kind QueryKind, n int64) {
+		last := len(after) - 1
+		assert(after[last].Method == method)
+		assert(after[last].Kind == kind)
+		assert(rows[last] == n)
+	}
+
+	erre(db.CreateNoReturn_User(ctx, User_Name("alice")))
+	check("CreateNoReturn_User", QueryKind_Create, 1)
+
+	_, err = db.All_User(ctx)
+	erre(err)
+	check("All_User", QueryKind_Read, 2)
+	assert(strings.HasPrefix(after[len(after)-1].Statement, "SELECT"))
+
+	_, err = db.Count_User(ctx)
+	erre(err)
+	check("Count_User", QueryKind_Read, 1)
+
+	_, err = db.Has_User(ctx)
+	erre(err)
+	check("Has_User", QueryKind_Read, 1)
+
+	_, err = db.Get_User_By_Pk(ctx, User_Pk(user.Pk))
+	erre(err)
+	check("Get_User_By_Pk", QueryKind_Read, 1)
+
+	erre(db.UpdateNoReturn_User_By_Pk(ctx, User_Pk(user.Pk+100),
+		User_Update_Fields{Name: User_Name("carol")}))
+	check("UpdateNoReturn_User_By_Pk", QueryKind_Update, 0)
+
+	erre(db.UpdateNoReturn_User_By_Pk(ctx, User_Pk(user.Pk),
+		User_Update_Fields{Name: User_Name("carol")}))
+	check("UpdateNoReturn_User_By_Pk", QueryKind_Update, 1)
+
+	_, err = db.Delete_User_By_Pk(ctx, User_Pk(user.Pk))
+	erre(err)
+	check("Delete_User_By_Pk", QueryKind_Delete, 1)
+
+	_, err = DeleteAll(ctx, db)
+	erre(err)
+	check("deleteAll", QueryKind_Delete, 1)
+	assert(after[len(after)-1].Table == "users")
+
+	assert(len(before) == len(after))
+}
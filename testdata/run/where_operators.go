@@ -0,0 +1,60 @@
+package main
+
+import "context"
+
+func erre(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func assert(x bool) {
+	if !x {
+		panic("assertion failed")
+	}
+}
+
+var ctx = context.Background()
+
+func main() {
+	db, err := Open("sqlite3", ":memory:")
+	erre(err)
+	defer db.Close()
+
+	_, err = db.Exec(db.Schema())
+	erre(err)
+
+	_, err = db.Create_Person(ctx, Person_Name("Alice"), Person_Age(17),
+		Person_Create_Fields{Nickname: Person_Nickname("al")})
+	erre(err)
+	_, err = db.Create_Person(ctx, Person_Name("Bob"), Person_Age(30),
+		Person_Create_Fields{})
+	erre(err)
+	_, err = db.Create_Person(ctx, Person_Name("Carol"), Person_Age(45),
+		Person_Create_Fields{})
+	erre(err)
+
+	rows, err := db.All_Person_By_Name_NotLike_OrderBy_Asc_Pk(ctx,
+		Person_Name("%o%"))
+	erre(err)
+	assert(len(rows) == 1 && rows[0].Name == "Alice")
+
+	rows, err = db.All_Person_By_Name_Ilike_OrderBy_Asc_Pk(ctx,
+		Person_Name("b%"))
+	erre(err)
+	assert(len(rows) == 1 && rows[0].Name == "Bob")
+
+	rows, err = db.All_Person_By_Age_Between_OrderBy_Asc_Pk(ctx,
+		Person_Age(18), Person_Age(45))
+	erre(err)
+	assert(len(rows) == 2 && rows[0].Name == "Bob" && rows[1].Name == "Carol")
+
+	count, err := db.Count_Person_By_Nickname_IsDistinctFrom(ctx,
+		Person_Nickname_Null())
+	erre(err)
+	assert(count == 1)
+
+	count, err = db.Count_Person_By_Name_Regexp(ctx, Person_Name("^[AB]"))
+	erre(err)
+	assert(count == 2)
+}